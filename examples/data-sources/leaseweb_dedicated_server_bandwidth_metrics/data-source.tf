@@ -0,0 +1,15 @@
+# Get the hourly average bandwidth of a dedicated server
+data "leaseweb_dedicated_server_bandwidth_metrics" "example" {
+  dedicated_server_id = "12345"
+  from                = "2024-01-01T00:00:00Z"
+  to                  = "2024-01-02T00:00:00Z"
+  granularity         = "HOUR"
+}
+
+# Get the 95th percentile of the bandwidth of the last month
+data "leaseweb_dedicated_server_bandwidth_metrics" "percentile" {
+  dedicated_server_id = "12345"
+  from                = "2024-01-01T00:00:00Z"
+  to                  = "2024-02-01T00:00:00Z"
+  aggregation         = "95TH"
+}
@@ -1,2 +1,7 @@
 # List all Control panels
 data "leaseweb_dedicated_server_control_panels" "all" {}
+
+# List the cPanel control panels
+data "leaseweb_dedicated_server_control_panels" "cpanel" {
+  name = "cpanel"
+}
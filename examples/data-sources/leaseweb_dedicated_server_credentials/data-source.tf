@@ -0,0 +1,5 @@
+# List the operating system credentials of a dedicated server
+data "leaseweb_dedicated_server_credentials" "example" {
+  dedicated_server_id = "12345"
+  type                = "OPERATING_SYSTEM"
+}
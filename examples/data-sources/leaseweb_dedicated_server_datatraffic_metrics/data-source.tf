@@ -0,0 +1,7 @@
+# Get the daily datatraffic of a dedicated server
+data "leaseweb_dedicated_server_datatraffic_metrics" "example" {
+  dedicated_server_id = "12345"
+  from                = "2024-01-01T00:00:00Z"
+  to                  = "2024-02-01T00:00:00Z"
+  granularity         = "DAY"
+}
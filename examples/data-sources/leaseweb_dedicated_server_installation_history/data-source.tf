@@ -0,0 +1,6 @@
+# List the installations of a dedicated server during 2024
+data "leaseweb_dedicated_server_installation_history" "example" {
+  dedicated_server_id = "12345"
+  from_date           = "2024-01-01T00:00:00Z"
+  to_date             = "2024-12-31T23:59:59Z"
+}
@@ -0,0 +1,5 @@
+# Look up a job of a dedicated server and its tasks
+data "leaseweb_dedicated_server_job" "example" {
+  dedicated_server_id = "12345"
+  id                  = "3a867358-5b4b-44ee-88ac-4274603ef641"
+}
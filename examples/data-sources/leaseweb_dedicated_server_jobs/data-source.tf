@@ -0,0 +1,18 @@
+# List the running jobs of a dedicated server
+data "leaseweb_dedicated_server_jobs" "running" {
+  dedicated_server_id = "12345"
+  is_running          = true
+}
+
+# Only reinstall the server when no other job is running
+resource "leaseweb_dedicated_server_installation" "example" {
+  dedicated_server_id = "12345"
+  operating_system_id = "UBUNTU_22_04_64BIT"
+
+  lifecycle {
+    precondition {
+      condition     = length(data.leaseweb_dedicated_server_jobs.running.jobs) == 0
+      error_message = "The server has a running job."
+    }
+  }
+}
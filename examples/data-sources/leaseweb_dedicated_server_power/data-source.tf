@@ -0,0 +1,4 @@
+# Get the power status of a dedicated server
+data "leaseweb_dedicated_server_power" "example" {
+  dedicated_server_id = "12345678"
+}
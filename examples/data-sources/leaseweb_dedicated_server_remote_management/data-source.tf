@@ -0,0 +1,8 @@
+# Get the remote management access details of a dedicated server
+data "leaseweb_dedicated_server_remote_management" "example" {
+  dedicated_server_id = "12345678"
+}
+
+output "ipmi_host" {
+  value = data.leaseweb_dedicated_server_remote_management.example.ip
+}
@@ -0,0 +1,10 @@
+# Generate import blocks for all resource record sets of example.com
+data "leaseweb_dns_zone_import" "example" {
+  domain_name = "example.com"
+}
+
+# Write the import blocks to a file, then run
+# `terraform plan -generate-config-out=records.tf`
+output "import_blocks" {
+  value = data.leaseweb_dns_zone_import.example.import_blocks
+}
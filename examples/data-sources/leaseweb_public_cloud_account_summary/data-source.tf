@@ -0,0 +1,6 @@
+# Summarize the Public Cloud instances, load balancers and IPs in the account
+data "leaseweb_public_cloud_account_summary" "all" {}
+
+output "public_cloud_instance_count" {
+  value = data.leaseweb_public_cloud_account_summary.all.instance_count
+}
@@ -0,0 +1,17 @@
+# List all Public Cloud auto scaling groups
+data "leaseweb_public_cloud_auto_scaling_groups" "all" {}
+
+# Get the Public Cloud auto scaling group an instance belongs to
+data "leaseweb_public_cloud_auto_scaling_groups" "example" {
+  instance_id = "ace712e9-a166-47f1-9065-4af0f7e7fce1"
+}
+
+# Get Public Cloud auto scaling groups filtered by type
+data "leaseweb_public_cloud_auto_scaling_groups" "example2" {
+  type = "CPU_BASED"
+}
+
+# Get Public Cloud auto scaling groups filtered by region
+data "leaseweb_public_cloud_auto_scaling_groups" "example3" {
+  region = "eu-west-3"
+}
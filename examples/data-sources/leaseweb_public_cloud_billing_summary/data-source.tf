@@ -0,0 +1,8 @@
+# Summarize the Public Cloud charges of a month
+data "leaseweb_public_cloud_billing_summary" "november" {
+  period = "2023-11"
+}
+
+output "public_cloud_charges" {
+  value = data.leaseweb_public_cloud_billing_summary.november.total
+}
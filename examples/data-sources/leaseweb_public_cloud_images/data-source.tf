@@ -1,2 +1,15 @@
 # List all Public Cloud images
 data "leaseweb_public_cloud_images" "all" {}
+
+# List the standard Linux images available in a region
+data "leaseweb_public_cloud_images" "linux" {
+  region     = "eu-west-3"
+  os_family  = "linux"
+  visibility = "public"
+}
+
+# List the images created before 2024 that take up more than 10 GB
+data "leaseweb_public_cloud_images" "cleanup" {
+  created_before = "2024-01-01T00:00:00Z"
+  min_size       = 10
+}
@@ -0,0 +1,34 @@
+# List the instance types of all regions
+data "leaseweb_public_cloud_instance_types" "all" {}
+
+# List the instance types available in Amsterdam
+data "leaseweb_public_cloud_instance_types" "amsterdam" {
+  region = "eu-west-3"
+}
+
+# List the instance types an existing instance can be updated to
+data "leaseweb_public_cloud_instance_types" "upgrades" {
+  instance_id = "ace712e9-a166-47f1-9065-4af0f7e7fce1"
+}
+
+# Fail the plan if the instance type is not available in its region
+resource "leaseweb_public_cloud_instance" "example" {
+  contract = {
+    billing_frequency = 1
+    term              = 0
+    type              = "HOURLY"
+  }
+  image = {
+    id = "UBUNTU_22_04_64BIT"
+  }
+  region                 = "eu-west-3"
+  root_disk_storage_type = "CENTRAL"
+  type                   = "lsw.m3.large"
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.leaseweb_public_cloud_instance_types.amsterdam.instance_types[*].name, self.type)
+      error_message = "The instance type is not available in the region."
+    }
+  }
+}
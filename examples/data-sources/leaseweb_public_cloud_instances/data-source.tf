@@ -1,2 +1,9 @@
 # List all Public Cloud instances
 data "leaseweb_public_cloud_instances" "all" {}
+
+# List the running instances with a monthly contract in a region
+data "leaseweb_public_cloud_instances" "monthly" {
+  region        = "eu-west-3"
+  state         = "RUNNING"
+  contract_type = "MONTHLY"
+}
@@ -0,0 +1,12 @@
+# Read the listeners, rules and target groups of a Public Cloud load balancer
+data "leaseweb_public_cloud_load_balancer_config" "example" {
+  load_balancer_id = "695ddd91-051f-4dd6-9120-938a927a47d0"
+}
+
+output "unhealthy_members" {
+  value = flatten([
+    for target_group in data.leaseweb_public_cloud_load_balancer_config.example.target_groups : [
+      for member in target_group.members : member.id if member.health_status == "UNHEALTHY"
+    ]
+  ])
+}
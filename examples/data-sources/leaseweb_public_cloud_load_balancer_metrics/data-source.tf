@@ -0,0 +1,7 @@
+# Get the hourly request and response code metrics of a load balancer
+data "leaseweb_public_cloud_load_balancer_metrics" "example" {
+  load_balancer_id = "5fd135a9-3ff6-4794-8b92-8cd8747a3ea3"
+  from             = "2024-01-01T00:00:00Z"
+  to               = "2024-01-02T00:00:00Z"
+  granularity      = "1h"
+}
@@ -0,0 +1,7 @@
+# List all Public Cloud Market Apps
+data "leaseweb_public_cloud_market_apps" "all" {}
+
+# List the cPanel Market Apps
+data "leaseweb_public_cloud_market_apps" "cpanel" {
+  name = "cpanel"
+}
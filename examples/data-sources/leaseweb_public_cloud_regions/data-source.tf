@@ -0,0 +1,2 @@
+# List all Public Cloud regions
+data "leaseweb_public_cloud_regions" "all" {}
@@ -0,0 +1,4 @@
+# List all snapshots of a Public Cloud instance
+data "leaseweb_public_cloud_snapshots" "example" {
+  instance_id = "ace712e9-a166-47f1-9065-4af0f7e7fce1"
+}
@@ -0,0 +1,6 @@
+# Credential for dedicated server, never stored in the state
+ephemeral "leaseweb_dedicated_server_credential" "root" {
+  dedicated_server_id = "12345"
+  type                = "OPERATING_SYSTEM"
+  username            = "root"
+}
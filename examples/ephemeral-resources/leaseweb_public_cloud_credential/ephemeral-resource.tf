@@ -0,0 +1,6 @@
+# Credential for public cloud, never stored in the state
+ephemeral "leaseweb_public_cloud_credential" "root" {
+  instance_id = "12345"
+  type        = "OPERATING_SYSTEM"
+  username    = "root"
+}
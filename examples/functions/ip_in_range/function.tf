@@ -0,0 +1,9 @@
+# Only accept addresses from the range delivered with the server
+variable "ip" {
+  type = string
+
+  validation {
+    condition     = provider::leaseweb::ip_in_range(var.ip, "85.17.150.0/24")
+    error_message = "The IP address must be part of 85.17.150.0/24."
+  }
+}
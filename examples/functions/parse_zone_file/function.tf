@@ -0,0 +1,17 @@
+# Manage the records of a zone file exported from BIND
+locals {
+  record_sets = provider::leaseweb::parse_zone_file(file("${path.module}/example.com.zone"), "example.com")
+}
+
+resource "leaseweb_dns_resource_record_set" "example" {
+  for_each = {
+    for record_set in local.record_sets : "${record_set.name} ${record_set.type}" => record_set
+    if record_set.type != "SOA"
+  }
+
+  domain_name = "example.com"
+  name        = each.value.name
+  type        = each.value.type
+  content     = each.value.content
+  ttl         = each.value.ttl
+}
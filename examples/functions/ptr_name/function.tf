@@ -0,0 +1,11 @@
+# Compute the name of the PTR record of an IP address, e.g. to check the
+# reverse lookup of the address with a DNS data source
+resource "leaseweb_ipmgmt_ip" "example" {
+  ip             = "85.17.150.51"
+  reverse_lookup = "www.example.com"
+}
+
+output "ptr_name" {
+  # 51.150.17.85.in-addr.arpa.
+  value = provider::leaseweb::ptr_name(leaseweb_ipmgmt_ip.example.ip)
+}
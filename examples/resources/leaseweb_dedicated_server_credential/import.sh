@@ -0,0 +1,2 @@
+# Dedicated server credential can be imported by specifying <dedicated_server_id>/<type>/<username>.
+terraform import leaseweb_dedicated_server_credential.example 12345/OPERATING_SYSTEM/root
@@ -5,3 +5,12 @@ resource "leaseweb_dedicated_server_credential" "example" {
   type                = "OPERATING_SYSTEM"
   password            = "mys3cr3tp@ssw0rd"
 }
+
+# Keep the password out of the state, bump password_wo_version to change it
+resource "leaseweb_dedicated_server_credential" "write_only" {
+  dedicated_server_id = "12345"
+  username            = "root"
+  type                = "CONTROL_PANEL"
+  password_wo         = "mys3cr3tp@ssw0rd"
+  password_wo_version = 1
+}
@@ -0,0 +1,6 @@
+# PXE boot a dedicated server from an iPXE script
+resource "leaseweb_dedicated_server_dhcp_lease" "example" {
+  dedicated_server_id = "12345678"
+  bootfile            = "http://mirror.leaseweb.com/ipxe-files/ubuntu-18.04.ipxe"
+  hostname            = "my-server"
+}
@@ -1 +1,2 @@
-terraform import leaseweb_dedicated_server_installation.test 12345678
+# Dedicated server installation can be imported by specifying the dedicated server identifier.
+terraform import leaseweb_dedicated_server_installation.example 12345678
@@ -0,0 +1,2 @@
+# Dedicated server network interface can be imported by specifying the dedicated server id and the network interface type.
+terraform import leaseweb_dedicated_server_network_interface.example 12345678/public
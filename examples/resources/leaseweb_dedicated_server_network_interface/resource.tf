@@ -0,0 +1,6 @@
+# Close the public network interface of a dedicated server
+resource "leaseweb_dedicated_server_network_interface" "example" {
+  dedicated_server_id = "12345678"
+  type                = "public"
+  opened              = false
+}
@@ -0,0 +1,2 @@
+# Dedicated server bandwidth notification setting can be imported by specifying <dedicated_server_id>/<id>.
+terraform import leaseweb_dedicated_server_notification_setting_bandwidth.example 12345678/12345
@@ -0,0 +1,2 @@
+# Dedicated server datatraffic notification setting can be imported by specifying <dedicated_server_id>/<id>.
+terraform import leaseweb_dedicated_server_notification_setting_datatraffic.example 145406/12345
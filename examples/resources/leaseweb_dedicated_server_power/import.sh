@@ -0,0 +1,2 @@
+# Dedicated server power can be imported by specifying the dedicated server id.
+terraform import leaseweb_dedicated_server_power.example 12345678
@@ -0,0 +1,5 @@
+# Keep a dedicated server powered off
+resource "leaseweb_dedicated_server_power" "example" {
+  dedicated_server_id = "12345678"
+  power_state         = "off"
+}
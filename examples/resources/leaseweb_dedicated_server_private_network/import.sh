@@ -0,0 +1,2 @@
+# Dedicated server private network can be imported by specifying the dedicated server id and the private network id.
+terraform import leaseweb_dedicated_server_private_network.example 12345678/1238793
@@ -0,0 +1,2 @@
+# Dedicated server remote management can be imported by specifying the dedicated server id.
+terraform import leaseweb_dedicated_server_remote_management.example 12345678
@@ -0,0 +1,5 @@
+# Reset the remote management credentials of a dedicated server every quarter
+resource "leaseweb_dedicated_server_remote_management" "example" {
+  dedicated_server_id = "12345678"
+  reset_trigger       = "2024-Q1"
+}
@@ -0,0 +1,3 @@
+# Dedicated server rescue modes can be imported by specifying the dedicated server id and the rescue mode job id.
+# The job does not report the rescue image, SSH keys, password, script or callback url, so these are not imported.
+terraform import leaseweb_dedicated_server_rescue_mode.example 12345/ac99431b-640d-4282-95a9-a444eedb9309
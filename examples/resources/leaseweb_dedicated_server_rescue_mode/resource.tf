@@ -0,0 +1,12 @@
+# Boot a dedicated server into rescue mode to wipe its disks
+resource "leaseweb_dedicated_server_rescue_mode" "example" {
+  dedicated_server_id = "12345"
+  rescue_image_id     = "GRML"
+  ssh_keys            = ["ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMbS4TxyZ4WTq3Jx6Ns4J0d8fyBvBxZDu6Cw7ZMTMZWK user@example.com"]
+  post_install_script = <<-EOS
+    #!/usr/bin/env bash
+    for disk in /dev/sd?; do
+      wipefs --all "$disk"
+    done
+  EOS
+}
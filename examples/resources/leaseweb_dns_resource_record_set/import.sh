@@ -0,0 +1,4 @@
+# DNS resource record set can be imported by specifying <domain_name>/<name>/<type>.
+# The name may also be relative to the domain, with @ for the apex.
+terraform import leaseweb_dns_resource_record_set.example example.com/example.com./A
+terraform import leaseweb_dns_resource_record_set.www example.com/www/A
@@ -10,3 +10,23 @@ resource "leaseweb_dns_resource_record_set" "example" {
   type = "A"
   ttl  = 3600
 }
+
+# Manage a CAA record with structured entries
+resource "leaseweb_dns_resource_record_set" "caa" {
+  domain_name = "example.com"
+  caa = [
+    {
+      flag  = 0
+      tag   = "issue"
+      value = "letsencrypt.org"
+    },
+    {
+      flag  = 0
+      tag   = "iodef"
+      value = "mailto:security@example.com"
+    }
+  ]
+  name = "example.com."
+  type = "CAA"
+  ttl  = 3600
+}
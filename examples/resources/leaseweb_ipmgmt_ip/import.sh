@@ -1,2 +1,2 @@
 # An IP can be imported by specifying the IP address.
-terraform import leaseweb_ipmgmt.ip.example 192.0.2.1
+terraform import leaseweb_ipmgmt_ip.example 192.0.2.1
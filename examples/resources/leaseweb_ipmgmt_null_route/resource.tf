@@ -9,3 +9,15 @@ resource "leaseweb_ipmgmt_null_route" "nr" {
   comment = "this is comment"
 }
 
+
+# Remove a null route automatically after two hours
+resource "leaseweb_ipmgmt_null_route" "nr" {
+  ip       = "127.0.0.1"
+  duration = "2h"
+}
+
+# Remove a null route automatically at a fixed time
+resource "leaseweb_ipmgmt_null_route" "nr" {
+  ip        = "127.0.0.1"
+  remove_at = "2025-01-01T00:00:00Z"
+}
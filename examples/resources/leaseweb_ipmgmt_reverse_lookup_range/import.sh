@@ -0,0 +1,2 @@
+# The reverse lookups of a range can be imported by specifying the range.
+terraform import leaseweb_ipmgmt_reverse_lookup_range.example 2001:db8::/120
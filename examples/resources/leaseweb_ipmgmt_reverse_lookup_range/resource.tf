@@ -0,0 +1,5 @@
+# Manage the reverse lookups of an IPv6 range
+resource "leaseweb_ipmgmt_reverse_lookup_range" "example" {
+  range    = "2001:db8::/120"
+  template = "host-$${suffix}.example.com"
+}
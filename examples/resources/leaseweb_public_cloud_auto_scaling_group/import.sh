@@ -0,0 +1,2 @@
+# Public Cloud auto scaling group can be imported by specifying the identifier.
+terraform import leaseweb_public_cloud_auto_scaling_group.example fb769dab-3daa-47e4-89ed-06a4b6499176
@@ -0,0 +1,30 @@
+# Manage example Public Cloud manual auto scaling group
+resource "leaseweb_public_cloud_auto_scaling_group" "example" {
+  type            = "MANUAL"
+  instance_id     = "ace712e9-a166-47f1-9065-4af0f7e7fce1"
+  reference       = "web"
+  desired_amount  = 2
+  target_group_id = "c737e9e2-a1b7-4f06-af77-92fc62c0e4bd"
+}
+
+# Manage example Public Cloud scheduled auto scaling group
+resource "leaseweb_public_cloud_auto_scaling_group" "example" {
+  type           = "SCHEDULED"
+  instance_id    = "ace712e9-a166-47f1-9065-4af0f7e7fce1"
+  reference      = "batch"
+  desired_amount = 4
+  starts_at      = "2024-05-01T08:00:00Z"
+  ends_at        = "2024-05-01T18:00:00Z"
+}
+
+# Manage example Public Cloud CPU based auto scaling group
+resource "leaseweb_public_cloud_auto_scaling_group" "example" {
+  type           = "CPU_BASED"
+  instance_id    = "ace712e9-a166-47f1-9065-4af0f7e7fce1"
+  reference      = "web"
+  minimum_amount = 1
+  maximum_amount = 3
+  cpu_threshold  = 50
+  warmup_time    = 300
+  cooldown_time  = 300
+}
@@ -0,0 +1,2 @@
+# Public Cloud credential can be imported by specifying <instance_id>/<type>/<username>.
+terraform import leaseweb_public_cloud_credential.example 695ddd91-051f-4dd6-9120-938a927a47d0/OPERATING_SYSTEM/root
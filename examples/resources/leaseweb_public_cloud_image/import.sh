@@ -0,0 +1,2 @@
+# Public Cloud custom image can be imported by specifying the identifier.
+terraform import leaseweb_public_cloud_image.example abcc1630-362f-48ba-832f-c496aff24121
@@ -12,5 +12,61 @@ resource "leaseweb_public_cloud_instance" "example" {
   region                 = "eu-west-3"
   root_disk_storage_type = "CENTRAL"
   type                   = "lsw.m3.large"
-  private_network        = true
+  has_private_network    = true
+}
+
+# Register the instance as web.example.com
+resource "leaseweb_public_cloud_instance" "web" {
+  contract = {
+    billing_frequency = 1
+    term              = 0
+    type              = "HOURLY"
+  }
+  image = {
+    id = "UBUNTU_22_04_64BIT"
+  }
+  reference              = "web"
+  region                 = "eu-west-3"
+  root_disk_storage_type = "CENTRAL"
+  type                   = "lsw.m3.large"
+  auto_dns = {
+    domain_name = "example.com"
+    name        = "{reference}"
+  }
+}
+
+# Bootstrap the instance with cloud-init
+resource "leaseweb_public_cloud_instance" "bootstrapped" {
+  contract = {
+    billing_frequency = 1
+    term              = 0
+    type              = "HOURLY"
+  }
+  image = {
+    id = "UBUNTU_22_04_64BIT"
+  }
+  region                 = "eu-west-3"
+  root_disk_storage_type = "CENTRAL"
+  type                   = "lsw.m3.large"
+  user_data              = <<-EOT
+    #cloud-config
+    packages:
+      - nginx
+  EOT
+}
+
+# Log in to the instance with an SSH key instead of a password
+resource "leaseweb_public_cloud_instance" "keyed" {
+  contract = {
+    billing_frequency = 1
+    term              = 0
+    type              = "HOURLY"
+  }
+  image = {
+    id = "UBUNTU_22_04_64BIT"
+  }
+  region                 = "eu-west-3"
+  root_disk_storage_type = "CENTRAL"
+  type                   = "lsw.m3.large"
+  ssh_key                = file("~/.ssh/id_ed25519.pub")
 }
@@ -1,2 +1,2 @@
-# Public Cloud instance_iso can be imported by specifying <instance_id>
+# Public Cloud instance_iso can be imported by specifying <instance_id>.
 terraform import leaseweb_public_cloud_instance_iso.example 695ddd91-051f-4dd6-9120-938a927a47d0
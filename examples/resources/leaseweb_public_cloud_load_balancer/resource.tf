@@ -5,7 +5,13 @@ resource "leaseweb_public_cloud_load_balancer" "example" {
     term              = 0
     type              = "HOURLY"
   }
-  reference = "my webserver"
-  region    = "eu-west-3"
-  type      = "lsw.m3.large"
+  reference       = "my webserver"
+  region          = "eu-west-3"
+  type            = "lsw.m3.large"
+  x_forwarded_for = true
+  idle_timeout    = 120
+  sticky_session = {
+    enabled      = true
+    max_lifetime = 3600
+  }
 }
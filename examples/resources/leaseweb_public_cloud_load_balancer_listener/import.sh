@@ -1,2 +1,2 @@
-# Public Cloud load balancer listener can be imported by passing "load_balancer_id,listener_id".
-terraform import leaseweb_public_cloud_load_balancer_listener.example 695ddd91-051f-4dd6-9120-938a927a47d0,695ddd91-051f-4dd6-9120-938a927a47d0
+# Public Cloud load balancer listener can be imported by specifying <load_balancer_id>/<listener_id>.
+terraform import leaseweb_public_cloud_load_balancer_listener.example 695ddd91-051f-4dd6-9120-938a927a47d0/695ddd91-051f-4dd6-9120-938a927a47d0
@@ -0,0 +1,2 @@
+# Public Cloud notification setting can be imported by specifying <instance_id>/<id>.
+terraform import leaseweb_public_cloud_notification_setting.example ace712e9-a166-47f1-9065-4af0f7e7fce1/12345
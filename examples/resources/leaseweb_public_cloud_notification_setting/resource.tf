@@ -0,0 +1,8 @@
+# Manage example Public Cloud instance data traffic notification
+resource "leaseweb_public_cloud_notification_setting" "example" {
+  instance_id = "ace712e9-a166-47f1-9065-4af0f7e7fce1"
+  frequency   = "WEEK"
+  threshold   = 12
+  unit        = "GB"
+  action      = "POWER_OFF"
+}
@@ -0,0 +1,2 @@
+# Public Cloud snapshot can be imported by specifying the instance id and the snapshot id.
+terraform import leaseweb_public_cloud_snapshot.example ace712e9-a166-47f1-9065-4af0f7e7fce1/624c53c3-48e9-41d1-833f-90a9abf5fd95
@@ -0,0 +1,5 @@
+# Manage example Public Cloud instance snapshot
+resource "leaseweb_public_cloud_snapshot" "example" {
+  instance_id = "ace712e9-a166-47f1-9065-4af0f7e7fce1"
+  name        = "before upgrade"
+}
@@ -33,3 +33,14 @@ resource "leaseweb_public_cloud_target_group" "example" {
   port     = 80
   region   = "eu-west-3"
 }
+
+# Manage example Public Cloud target group registering all instances whose
+# reference starts with "web-"
+resource "leaseweb_public_cloud_target_group" "example" {
+  name              = "test"
+  protocol          = "HTTP"
+  port              = 80
+  region            = "eu-west-3"
+  auto_register     = true
+  instance_selector = "web-*"
+}
@@ -0,0 +1,2 @@
+# A Public Cloud target group attachment can be imported by specifying the target group and the instance identifiers.
+terraform import leaseweb_public_cloud_target_group_attachment.example fb769dab-3daa-47e4-89ed-06a4b6499176/8be7f8c6-e8c0-4321-a01e-ac754e2f6872
@@ -0,0 +1,13 @@
+# Register an instance in a target group
+resource "leaseweb_public_cloud_target_group_attachment" "example" {
+  target_group_id = "fb769dab-3daa-47e4-89ed-06a4b6499176"
+  instance_id     = "8be7f8c6-e8c0-4321-a01e-ac754e2f6872"
+}
+
+# Register every web server
+resource "leaseweb_public_cloud_target_group_attachment" "web" {
+  for_each = toset(["ace712e9-a166-47f1-9065-4af0f7e7fce1", "8be7f8c6-e8c0-4321-a01e-ac754e2f6872"])
+
+  target_group_id = "fb769dab-3daa-47e4-89ed-06a4b6499176"
+  instance_id     = each.value
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// ErrorClass describes whether a failed API request can be retried.
+type ErrorClass int
+
+const (
+	// ErrorClassNone means the request succeeded.
+	ErrorClassNone ErrorClass = iota
+	// ErrorClassTransient covers network errors, rate limiting and gateway errors.
+	ErrorClassTransient
+	// ErrorClassMaintenance means the API is in a maintenance window.
+	ErrorClassMaintenance
+	// ErrorClassValidation means the API rejected the request as invalid.
+	ErrorClassValidation
+	// ErrorClassAuthentication means the token is invalid or lacks access.
+	ErrorClassAuthentication
+	// ErrorClassNotFound means the requested resource does not exist.
+	ErrorClassNotFound
+	// ErrorClassFatal covers all other errors that a retry will not fix.
+	ErrorClassFatal
+)
+
+// ClassifyResponse categorizes the outcome of an API request.
+func ClassifyResponse(resp *http.Response, err error) ErrorClass {
+	if resp == nil {
+		if err == nil {
+			return ErrorClassNone
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return ErrorClassFatal
+		}
+		return ErrorClassTransient
+	}
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest,
+		http.StatusConflict,
+		http.StatusUnprocessableEntity:
+		return ErrorClassValidation
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrorClassAuthentication
+	case http.StatusNotFound:
+		return ErrorClassNotFound
+	case http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusGatewayTimeout:
+		return ErrorClassTransient
+	case http.StatusServiceUnavailable:
+		if IsMaintenanceResponse(resp) {
+			return ErrorClassMaintenance
+		}
+		return ErrorClassTransient
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return ErrorClassNone
+	}
+
+	return ErrorClassFatal
+}
+
+// Retryable reports whether a request with this outcome may be retried.
+func (e ErrorClass) Retryable() bool {
+	return e == ErrorClassTransient || e == ErrorClassMaintenance
+}
+
+// String describes the class in a way that completes the sentence
+// "The request was not retried because ...".
+func (e ErrorClass) String() string {
+	switch e {
+	case ErrorClassNone:
+		return "the request succeeded"
+	case ErrorClassTransient:
+		return "the error is transient"
+	case ErrorClassMaintenance:
+		return "the API is in a maintenance window"
+	case ErrorClassValidation:
+		return "the API rejected the request as invalid"
+	case ErrorClassAuthentication:
+		return "the API token is invalid or lacks access"
+	case ErrorClassNotFound:
+		return "the requested resource does not exist"
+	default:
+		return "the error is not transient"
+	}
+}
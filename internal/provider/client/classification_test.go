@@ -0,0 +1,84 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyResponse(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		want       ErrorClass
+	}{
+		{name: "success", statusCode: http.StatusOK, want: ErrorClassNone},
+		{name: "no content", statusCode: http.StatusNoContent, want: ErrorClassNone},
+		{name: "bad request", statusCode: http.StatusBadRequest, want: ErrorClassValidation},
+		{name: "conflict", statusCode: http.StatusConflict, want: ErrorClassValidation},
+		{name: "unprocessable entity", statusCode: http.StatusUnprocessableEntity, want: ErrorClassValidation},
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, want: ErrorClassAuthentication},
+		{name: "forbidden", statusCode: http.StatusForbidden, want: ErrorClassAuthentication},
+		{name: "not found", statusCode: http.StatusNotFound, want: ErrorClassNotFound},
+		{name: "request timeout", statusCode: http.StatusRequestTimeout, want: ErrorClassTransient},
+		{name: "too many requests", statusCode: http.StatusTooManyRequests, want: ErrorClassTransient},
+		{name: "bad gateway", statusCode: http.StatusBadGateway, want: ErrorClassTransient},
+		{name: "gateway timeout", statusCode: http.StatusGatewayTimeout, want: ErrorClassTransient},
+		{name: "service unavailable", statusCode: http.StatusServiceUnavailable, want: ErrorClassTransient},
+		{
+			name:       "maintenance",
+			statusCode: http.StatusServiceUnavailable,
+			body:       maintenanceBody,
+			want:       ErrorClassMaintenance,
+		},
+		{name: "internal server error", statusCode: http.StatusInternalServerError, want: ErrorClassFatal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: tt.statusCode,
+				Body:       io.NopCloser(bytes.NewReader([]byte(tt.body))),
+			}
+
+			assert.Equal(t, tt.want, ClassifyResponse(resp, nil))
+		})
+	}
+
+	t.Run("network errors are transient", func(t *testing.T) {
+		assert.Equal(
+			t,
+			ErrorClassTransient,
+			ClassifyResponse(nil, errors.New("connection reset by peer")),
+		)
+	})
+
+	t.Run("cancelled requests are fatal", func(t *testing.T) {
+		assert.Equal(
+			t,
+			ErrorClassFatal,
+			ClassifyResponse(nil, fmt.Errorf("request: %w", context.Canceled)),
+		)
+	})
+
+	t.Run("no response and no error is a success", func(t *testing.T) {
+		assert.Equal(t, ErrorClassNone, ClassifyResponse(nil, nil))
+	})
+}
+
+func TestErrorClass_Retryable(t *testing.T) {
+	assert.True(t, ErrorClassTransient.Retryable())
+	assert.True(t, ErrorClassMaintenance.Retryable())
+	assert.False(t, ErrorClassNone.Retryable())
+	assert.False(t, ErrorClassValidation.Retryable())
+	assert.False(t, ErrorClassAuthentication.Retryable())
+	assert.False(t, ErrorClassNotFound.Retryable())
+	assert.False(t, ErrorClassFatal.Retryable())
+}
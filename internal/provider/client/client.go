@@ -2,13 +2,24 @@
 package client
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
 	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/leaseweb-go-sdk/dns"
 	"github.com/leaseweb/leaseweb-go-sdk/ipmgmt"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
 )
 
-const userAgentBase = "leaseweb-terraform"
+const userAgentBase = "terraform-provider-leaseweb"
+
+// DefaultPaginationConcurrency is how many pages of a list are fetched in
+// parallel when no concurrency is configured.
+const DefaultPaginationConcurrency = 4
 
 // The Client handles instantiation of the SDK.
 type Client struct {
@@ -16,11 +27,167 @@ type Client struct {
 	DedicatedserverAPI dedicatedserver.DedicatedserverAPI
 	DNSAPI             dns.DnsAPI
 	IPmgmtAPI          ipmgmt.IpmgmtAPI
+	// DefaultDNSTTL is applied to DNS records without a TTL, 0 if unset.
+	DefaultDNSTTL int32
+	// DefaultReverseLookupSuffix names the reverse lookup of IPs without
+	// one, empty if unset.
+	DefaultReverseLookupSuffix string
+	// RateLimiter is shared by all requests of the provider, nil if requests
+	// are not limited.
+	RateLimiter *RateLimiter
+	// InstanceTypes caches the instance types offered per region, nil if
+	// instance types are not validated during planning.
+	InstanceTypes *InstanceTypeCache
+	// UnavailableSubsystems holds why subsystems could not be reached when
+	// the provider was configured, nil if they were not checked.
+	UnavailableSubsystems map[Subsystem]error
+	// PaginationConcurrency is how many pages of a list are fetched in
+	// parallel.
+	PaginationConcurrency int
 }
 
 type Optional struct {
 	Host   *string
 	Scheme *string
+	// WaitForMaintenance retries requests while the API is in a maintenance
+	// window instead of failing immediately.
+	WaitForMaintenance bool
+	// MaintenanceTimeout bounds how long requests wait for maintenance to end.
+	MaintenanceTimeout time.Duration
+	// DefaultDNSTTL is applied to DNS records without a TTL.
+	DefaultDNSTTL int32
+	// DefaultReverseLookupSuffix names the reverse lookup of IPs without
+	// one.
+	DefaultReverseLookupSuffix string
+	// MaxRetries is how often transient errors are retried, DefaultMaxRetries
+	// if unset. Zero disables retries.
+	MaxRetries *int
+	// RefreshMaxRetries is how often reads are retried, MaxRetries if unset.
+	RefreshMaxRetries *int
+	// RetryWaitMax caps the wait between retries.
+	RetryWaitMax time.Duration
+	// Timeout aborts a single HTTP request that takes longer, 0 if unset.
+	Timeout time.Duration
+	// RequestsPerSecond limits the rate of requests, 0 if unlimited.
+	RequestsPerSecond float64
+	// TerraformVersion is the version of Terraform running the provider.
+	TerraformVersion string
+	// UserAgentSuffix is appended to the User-Agent header.
+	UserAgentSuffix string
+	// DebugHTTP logs every request and response at debug level.
+	DebugHTTP bool
+	// ValidateInstanceType checks during planning that the type of an
+	// instance is offered in its region.
+	ValidateInstanceType bool
+	// ProxyURL is the proxy all requests are sent through. If unset, the
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.
+	ProxyURL *url.URL
+	// PaginationConcurrency is how many pages of a list are fetched in
+	// parallel, DefaultPaginationConcurrency if unset.
+	PaginationConcurrency int
+	// RootCAs are the certificate authorities TLS connections are verified
+	// against, the system pool if unset.
+	RootCAs *x509.CertPool
+	// InsecureSkipVerify disables the verification of TLS certificates.
+	InsecureSkipVerify bool
+}
+
+// newUserAgent identifies the provider and the Terraform version running it,
+// so support can tell requests of this provider apart.
+func newUserAgent(version string, terraformVersion string, suffix string) string {
+	if terraformVersion == "" {
+		terraformVersion = "unknown"
+	}
+
+	userAgent := fmt.Sprintf(
+		"%s/%s (terraform/%s; +https://github.com/leaseweb/terraform-provider-leaseweb)",
+		userAgentBase,
+		version,
+		terraformVersion,
+	)
+	if suffix != "" {
+		userAgent += " " + suffix
+	}
+
+	return userAgent
+}
+
+func newHTTPClient(optional Optional, limiter *RateLimiter) *http.Client {
+	// The default transport already honors the proxy environment variables.
+	transport := http.DefaultTransport
+	if optional.ProxyURL != nil || optional.RootCAs != nil || optional.InsecureSkipVerify {
+		baseTransport := http.DefaultTransport.(*http.Transport).Clone()
+		if optional.ProxyURL != nil {
+			baseTransport.Proxy = http.ProxyURL(optional.ProxyURL)
+		}
+		if optional.RootCAs != nil || optional.InsecureSkipVerify {
+			baseTransport.TLSClientConfig = &tls.Config{
+				MinVersion:         tls.VersionTLS12,
+				RootCAs:            optional.RootCAs,
+				InsecureSkipVerify: optional.InsecureSkipVerify,
+			}
+		}
+		transport = baseTransport
+	}
+
+	// Tracing sits closest to the wire, so every retry is logged.
+	if optional.DebugHTTP {
+		transport = debugTransport{next: transport}
+	}
+
+	if optional.Timeout > 0 {
+		transport = timeoutTransport{
+			next:    transport,
+			timeout: optional.Timeout,
+		}
+	}
+
+	// Retries pass through the limiter as well, waiting for it does not
+	// count towards the timeout.
+	if limiter != nil {
+		transport = rateLimitTransport{
+			next:    transport,
+			limiter: limiter,
+		}
+	}
+
+	maxRetries := DefaultMaxRetries
+	if optional.MaxRetries != nil {
+		maxRetries = *optional.MaxRetries
+	}
+	readMaxRetries := maxRetries
+	if optional.RefreshMaxRetries != nil {
+		readMaxRetries = *optional.RefreshMaxRetries
+	}
+	if maxRetries > 0 || readMaxRetries > 0 {
+		waitMax := optional.RetryWaitMax
+		if waitMax == 0 {
+			waitMax = DefaultRetryWaitMax
+		}
+
+		transport = retryTransport{
+			next:           transport,
+			maxRetries:     maxRetries,
+			readMaxRetries: readMaxRetries,
+			waitMin:        min(defaultRetryWaitMin, waitMax),
+			waitMax:        waitMax,
+		}
+	}
+
+	if optional.WaitForMaintenance {
+		timeout := optional.MaintenanceTimeout
+		if timeout == 0 {
+			timeout = DefaultMaintenanceTimeout
+		}
+
+		transport = maintenanceTransport{
+			next:     transport,
+			timeout:  timeout,
+			interval: defaultMaintenanceInterval,
+		}
+	}
+
+	return &http.Client{Transport: transport}
 }
 
 func NewClient(token string, optional Optional, version string) Client {
@@ -42,18 +209,29 @@ func NewClient(token string, optional Optional, version string) Client {
 		ipmgmtCFG.Scheme = *optional.Scheme
 	}
 
-	userAgent := userAgentBase + "-" + version
+	var limiter *RateLimiter
+	if optional.RequestsPerSecond > 0 {
+		limiter = NewRateLimiter(optional.RequestsPerSecond)
+	}
+
+	httpClient := newHTTPClient(optional, limiter)
+	publiccloudCFG.HTTPClient = httpClient
+	dedicatedserverCFG.HTTPClient = httpClient
+	dnsCFG.HTTPClient = httpClient
+	ipmgmtCFG.HTTPClient = httpClient
+
+	userAgent := newUserAgent(version, optional.TerraformVersion, optional.UserAgentSuffix)
 
-	publiccloudCFG.AddDefaultHeader("X-LSW-Auth", token)
+	publiccloudCFG.AddDefaultHeader(authHeader, token)
 	publiccloudCFG.UserAgent = userAgent
 
-	dedicatedserverCFG.AddDefaultHeader("X-LSW-Auth", token)
+	dedicatedserverCFG.AddDefaultHeader(authHeader, token)
 	dedicatedserverCFG.UserAgent = userAgent
 
-	dnsCFG.AddDefaultHeader("X-LSW-Auth", token)
+	dnsCFG.AddDefaultHeader(authHeader, token)
 	dnsCFG.UserAgent = userAgent
 
-	ipmgmtCFG.AddDefaultHeader("X-LSW-Auth", token)
+	ipmgmtCFG.AddDefaultHeader(authHeader, token)
 	ipmgmtCFG.UserAgent = userAgent
 
 	publiccloudAPI := publiccloud.NewAPIClient(publiccloudCFG)
@@ -61,10 +239,25 @@ func NewClient(token string, optional Optional, version string) Client {
 	dnsAPI := dns.NewAPIClient(dnsCFG)
 	ipmgmtAPI := ipmgmt.NewAPIClient(ipmgmtCFG)
 
+	var instanceTypes *InstanceTypeCache
+	if optional.ValidateInstanceType {
+		instanceTypes = NewInstanceTypeCache()
+	}
+
+	paginationConcurrency := optional.PaginationConcurrency
+	if paginationConcurrency <= 0 {
+		paginationConcurrency = DefaultPaginationConcurrency
+	}
+
 	return Client{
-		PubliccloudAPI:     publiccloudAPI.PubliccloudAPI,
-		DedicatedserverAPI: dedicatedserverAPI.DedicatedserverAPI,
-		DNSAPI:             dnsAPI.DnsAPI,
-		IPmgmtAPI:          ipmgmtAPI.IpmgmtAPI,
+		PubliccloudAPI:             publiccloudAPI.PubliccloudAPI,
+		DedicatedserverAPI:         dedicatedserverAPI.DedicatedserverAPI,
+		DNSAPI:                     dnsAPI.DnsAPI,
+		IPmgmtAPI:                  ipmgmtAPI.IpmgmtAPI,
+		DefaultDNSTTL:              optional.DefaultDNSTTL,
+		DefaultReverseLookupSuffix: optional.DefaultReverseLookupSuffix,
+		RateLimiter:                limiter,
+		InstanceTypes:              instanceTypes,
+		PaginationConcurrency:      paginationConcurrency,
 	}
 }
@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newUserAgent(t *testing.T) {
+	t.Run("identifies the provider and terraform versions", func(t *testing.T) {
+		got := newUserAgent("1.2.3", "1.9.0", "")
+
+		assert.Equal(
+			t,
+			"terraform-provider-leaseweb/1.2.3 (terraform/1.9.0; +https://github.com/leaseweb/terraform-provider-leaseweb)",
+			got,
+		)
+	})
+
+	t.Run("suffix is appended", func(t *testing.T) {
+		got := newUserAgent("1.2.3", "1.9.0", "reseller/42")
+
+		assert.Equal(
+			t,
+			"terraform-provider-leaseweb/1.2.3 (terraform/1.9.0; +https://github.com/leaseweb/terraform-provider-leaseweb) reseller/42",
+			got,
+		)
+	})
+
+	t.Run("unknown terraform version is marked as such", func(t *testing.T) {
+		got := newUserAgent("dev", "", "")
+
+		assert.Contains(t, got, "(terraform/unknown;")
+	})
+}
+
+func TestNewClient(t *testing.T) {
+	t.Run("the limiter is shared by the client", func(t *testing.T) {
+		got := NewClient("token", Optional{RequestsPerSecond: 5}, "test")
+
+		require.NotNil(t, got.RateLimiter)
+		assert.Equal(t, float64(5), got.RateLimiter.rate)
+	})
+
+	t.Run("requests are not limited by default", func(t *testing.T) {
+		got := NewClient("token", Optional{}, "test")
+
+		assert.Nil(t, got.RateLimiter)
+	})
+
+	t.Run("pages are fetched in parallel by default", func(t *testing.T) {
+		got := NewClient("token", Optional{}, "test")
+
+		assert.Equal(t, DefaultPaginationConcurrency, got.PaginationConcurrency)
+	})
+
+	t.Run("pagination concurrency is configurable", func(t *testing.T) {
+		got := NewClient("token", Optional{PaginationConcurrency: 8}, "test")
+
+		assert.Equal(t, 8, got.PaginationConcurrency)
+	})
+
+	t.Run("user agent is sent by every API", func(t *testing.T) {
+		var userAgents []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userAgents = append(userAgents, r.UserAgent())
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		maxRetries := 0
+		client := NewClient(
+			"token",
+			Optional{
+				Host:             &serverURL.Host,
+				Scheme:           &serverURL.Scheme,
+				MaxRetries:       &maxRetries,
+				TerraformVersion: "1.9.0",
+				UserAgentSuffix:  "suffix",
+			},
+			"1.2.3",
+		)
+
+		ctx := context.Background()
+		_, _, _ = client.PubliccloudAPI.GetInstanceList(ctx).Execute()
+		_, _, _ = client.DedicatedserverAPI.GetServerList(ctx).Execute()
+		_, _, _ = client.DNSAPI.GetResourceRecordSetList(ctx, "example.com").Execute()
+		_, _, _ = client.IPmgmtAPI.GetIPList(ctx).Execute()
+
+		want := newUserAgent("1.2.3", "1.9.0", "suffix")
+		assert.Equal(t, []string{want, want, want, want}, userAgents)
+	})
+	t.Run("requests and their retries are sent through the proxy", func(t *testing.T) {
+		var hosts []string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hosts = append(hosts, r.URL.Host)
+			if len(hosts) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"instances":[]}`))
+		}))
+		defer proxy.Close()
+
+		proxyURL, err := url.Parse(proxy.URL)
+		require.NoError(t, err)
+		host := "api.example.invalid"
+		scheme := "http"
+		maxRetries := 1
+		client := NewClient(
+			"token",
+			Optional{
+				Host:         &host,
+				Scheme:       &scheme,
+				MaxRetries:   &maxRetries,
+				RetryWaitMax: time.Millisecond,
+				ProxyURL:     proxyURL,
+			},
+			"test",
+		)
+
+		_, _, err = client.PubliccloudAPI.GetInstanceList(context.Background()).Execute()
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{host, host}, hosts)
+	})
+	t.Run("TLS certificates are verified against the configured CAs", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"instances":[]}`))
+		}))
+		defer server.Close()
+
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		rootCAs := x509.NewCertPool()
+		rootCAs.AddCert(server.Certificate())
+		maxRetries := 0
+
+		tests := []struct {
+			name     string
+			optional Optional
+			wantErr  bool
+		}{
+			{
+				name:     "unknown CA is rejected",
+				optional: Optional{},
+				wantErr:  true,
+			},
+			{
+				name:     "configured CA is trusted",
+				optional: Optional{RootCAs: rootCAs},
+			},
+			{
+				name:     "verification can be skipped",
+				optional: Optional{InsecureSkipVerify: true},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				optional := tt.optional
+				optional.Host = &serverURL.Host
+				optional.MaxRetries = &maxRetries
+				client := NewClient("token", optional, "test")
+
+				_, _, err := client.PubliccloudAPI.GetInstanceList(context.Background()).Execute()
+
+				if tt.wantErr {
+					assert.ErrorContains(t, err, "certificate")
+					return
+				}
+				assert.NoError(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrAuthenticationFailed means the API rejected the token.
+	ErrAuthenticationFailed = errors.New("authentication failed")
+	// ErrAPIUnreachable means the API could not be reached or did not
+	// respond in time.
+	ErrAPIUnreachable = errors.New("the Leaseweb API is unreachable")
+)
+
+// CheckCredentials sends a single small authenticated request, so an invalid
+// token or unreachable API is reported once instead of by every resource.
+// It only fails with ErrAuthenticationFailed or ErrAPIUnreachable, other
+// errors are left to the requests that follow.
+func (c Client) CheckCredentials(ctx context.Context) error {
+	_, httpResponse, err := c.IPmgmtAPI.GetIPList(ctx).Limit(1).Execute()
+
+	// A 403 only means the token cannot use IP management, it may still be
+	// valid for the rest of the API.
+	if httpResponse != nil && httpResponse.StatusCode == http.StatusUnauthorized {
+		return fmt.Errorf("%w: the API responded with %s", ErrAuthenticationFailed, httpResponse.Status)
+	}
+	// The request was cut off by the provider timeout, not by ctx.
+	if httpResponse == nil && attemptTimedOut(ctx, err) {
+		return fmt.Errorf("%w: %w", ErrAPIUnreachable, err)
+	}
+
+	switch ClassifyResponse(httpResponse, err) {
+	case ErrorClassTransient, ErrorClassMaintenance:
+		if httpResponse != nil {
+			return fmt.Errorf("%w: the API responded with %s", ErrAPIUnreachable, httpResponse.Status)
+		}
+		return fmt.Errorf("%w: %w", ErrAPIUnreachable, err)
+	}
+
+	return nil
+}
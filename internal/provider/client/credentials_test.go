@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCredentialsTestClient(t *testing.T, handler http.HandlerFunc) Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	maxRetries := 0
+
+	return NewClient(
+		"token",
+		Optional{
+			Host:       &serverURL.Host,
+			Scheme:     &serverURL.Scheme,
+			MaxRetries: &maxRetries,
+		},
+		"test",
+	)
+}
+
+func TestClient_CheckCredentials(t *testing.T) {
+	t.Run("valid credentials pass", func(t *testing.T) {
+		var authHeaders []string
+		client := newCredentialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			authHeaders = append(authHeaders, r.Header.Get(authHeader))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"ips":[]}`))
+		})
+
+		err := client.CheckCredentials(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"token"}, authHeaders)
+	})
+
+	t.Run("a rejected token fails authentication", func(t *testing.T) {
+		client := newCredentialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+
+		err := client.CheckCredentials(context.Background())
+
+		assert.ErrorIs(t, err, ErrAuthenticationFailed)
+	})
+
+	t.Run("a token without access to IP management passes", func(t *testing.T) {
+		client := newCredentialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		})
+
+		err := client.CheckCredentials(context.Background())
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("requests cut off by the timeout mean the API is unreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		t.Cleanup(server.Close)
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		maxRetries := 0
+		client := NewClient(
+			"token",
+			Optional{
+				Host:       &serverURL.Host,
+				Scheme:     &serverURL.Scheme,
+				MaxRetries: &maxRetries,
+				Timeout:    10 * time.Millisecond,
+			},
+			"test",
+		)
+
+		err = client.CheckCredentials(context.Background())
+
+		assert.ErrorIs(t, err, ErrAPIUnreachable)
+	})
+
+	t.Run("gateway errors mean the API is unreachable", func(t *testing.T) {
+		client := newCredentialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		})
+
+		err := client.CheckCredentials(context.Background())
+
+		assert.ErrorIs(t, err, ErrAPIUnreachable)
+	})
+
+	t.Run("network errors mean the API is unreachable", func(t *testing.T) {
+		host := "127.0.0.1:1"
+		scheme := "http"
+		maxRetries := 0
+		client := NewClient(
+			"token",
+			Optional{Host: &host, Scheme: &scheme, MaxRetries: &maxRetries},
+			"test",
+		)
+
+		err := client.CheckCredentials(context.Background())
+
+		assert.ErrorIs(t, err, ErrAPIUnreachable)
+	})
+
+	t.Run("other errors are left to later requests", func(t *testing.T) {
+		client := newCredentialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		err := client.CheckCredentials(context.Background())
+
+		assert.NoError(t, err)
+	})
+}
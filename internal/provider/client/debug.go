@@ -0,0 +1,107 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxDebugBodySize is how many bytes of a body are logged, the rest is
+// truncated.
+const maxDebugBodySize = 4096
+
+// authHeader carries the API token.
+const authHeader = "X-LSW-Auth"
+
+// debugTransport logs every request attempt and its response at debug level.
+// Header values holding the token, and the token wherever else it shows up,
+// are masked.
+type debugTransport struct {
+	next http.RoundTripper
+}
+
+// headerFieldKey returns the log field key of a header.
+func headerFieldKey(name string) string {
+	return "http_header_" + strings.ToLower(name)
+}
+
+// truncateBody cuts the body off after maxDebugBodySize bytes.
+func truncateBody(body []byte) string {
+	if len(body) <= maxDebugBodySize {
+		return string(body)
+	}
+
+	return string(body[:maxDebugBodySize]) + "... (truncated)"
+}
+
+// maskedContext masks the credentials of the request in all debug logs.
+func maskedContext(req *http.Request) context.Context {
+	ctx := tflog.MaskFieldValuesWithFieldKeys(
+		req.Context(),
+		headerFieldKey(authHeader),
+		headerFieldKey("Authorization"),
+	)
+	if token := req.Header.Get(authHeader); token != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, token)
+	}
+
+	return ctx
+}
+
+func headerFields(header http.Header) map[string]any {
+	fields := map[string]any{}
+	for name, values := range header {
+		fields[headerFieldKey(name)] = strings.Join(values, ", ")
+	}
+
+	return fields
+}
+
+func (d debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := maskedContext(req)
+
+	requestFields := headerFields(req.Header)
+	requestFields["http_method"] = req.Method
+	requestFields["http_url"] = req.URL.String()
+	// The body is read from a copy, so the original is still sent.
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			content, _ := io.ReadAll(body)
+			_ = body.Close()
+			requestFields["http_req_body"] = truncateBody(content)
+		}
+	}
+	tflog.Debug(ctx, "Sending HTTP request", requestFields)
+
+	resp, err := d.next.RoundTrip(req)
+	if err != nil {
+		tflog.Debug(ctx, "HTTP request failed", map[string]any{
+			"http_method": req.Method,
+			"http_url":    req.URL.String(),
+			"error":       err.Error(),
+		})
+		return resp, err
+	}
+
+	responseFields := headerFields(resp.Header)
+	responseFields["http_method"] = req.Method
+	responseFields["http_url"] = req.URL.String()
+	responseFields["http_status_code"] = resp.StatusCode
+	if resp.Body != nil {
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		responseFields["http_res_body"] = truncateBody(body)
+	}
+	tflog.Debug(ctx, "Received HTTP response", responseFields)
+
+	return resp, nil
+}
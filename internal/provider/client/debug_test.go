@@ -0,0 +1,90 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_debugTransport_RoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write([]byte("echo " + string(body)))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		server.URL+"/publicCloud/v1/instances",
+		strings.NewReader(`{"token":"secret"}`),
+	)
+	require.NoError(t, err)
+	req.Header.Set(authHeader, "secret")
+
+	httpClient := http.Client{Transport: debugTransport{next: http.DefaultTransport}}
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	logs := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	t.Run("the response body can still be read", func(t *testing.T) {
+		assert.Equal(t, `echo {"token":"secret"}`, string(body))
+	})
+
+	t.Run("the request is logged", func(t *testing.T) {
+		assert.Equal(t, "Sending HTTP request", entries[0]["@message"])
+		assert.Equal(t, http.MethodPost, entries[0]["http_method"])
+		assert.Equal(t, server.URL+"/publicCloud/v1/instances", entries[0]["http_url"])
+	})
+
+	t.Run("the response is logged", func(t *testing.T) {
+		assert.Equal(t, "Received HTTP response", entries[1]["@message"])
+		assert.Equal(t, float64(http.StatusOK), entries[1]["http_status_code"])
+	})
+
+	t.Run("the token is masked", func(t *testing.T) {
+		assert.Equal(t, "***", entries[0]["http_header_x-lsw-auth"])
+		assert.Equal(t, `{"token":"***"}`, entries[0]["http_req_body"])
+		assert.Equal(t, `echo {"token":"***"}`, entries[1]["http_res_body"])
+		assert.NotContains(t, logs, "secret")
+	})
+}
+
+func Test_truncateBody(t *testing.T) {
+	t.Run("small bodies are kept", func(t *testing.T) {
+		assert.Equal(t, "body", truncateBody([]byte("body")))
+	})
+
+	t.Run("large bodies are truncated", func(t *testing.T) {
+		got := truncateBody(bytes.Repeat([]byte("a"), maxDebugBodySize+1))
+
+		assert.Equal(t, strings.Repeat("a", maxDebugBodySize)+"... (truncated)", got)
+	})
+}
+
+func Test_newHTTPClient_debug(t *testing.T) {
+	maxRetries := 0
+
+	got := newHTTPClient(Optional{MaxRetries: &maxRetries, DebugHTTP: true}, nil)
+
+	assert.Equal(t, debugTransport{next: http.DefaultTransport}, got.Transport)
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"sync"
+)
+
+// InstanceTypeCache remembers the instance types offered in each region, so
+// they are fetched once per provider run however many instances are planned.
+type InstanceTypeCache struct {
+	mu      sync.Mutex
+	regions map[string][]string
+}
+
+// NewInstanceTypeCache returns an empty cache.
+func NewInstanceTypeCache() *InstanceTypeCache {
+	return &InstanceTypeCache{regions: map[string][]string{}}
+}
+
+// Get returns the instance types offered in the region. They are fetched on
+// first use, failed fetches are not cached.
+func (c *InstanceTypeCache) Get(
+	region string,
+	fetch func() ([]string, error),
+) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if instanceTypes, ok := c.regions[region]; ok {
+		return instanceTypes, nil
+	}
+
+	instanceTypes, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	c.regions[region] = instanceTypes
+
+	return instanceTypes, nil
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstanceTypeCache_Get(t *testing.T) {
+	t.Run("instance types are fetched once per region", func(t *testing.T) {
+		cache := NewInstanceTypeCache()
+		calls := 0
+		fetch := func() ([]string, error) {
+			calls++
+			return []string{"lsw.m3.large"}, nil
+		}
+
+		_, err := cache.Get("eu-west-3", fetch)
+		require.NoError(t, err)
+		got, err := cache.Get("eu-west-3", fetch)
+		require.NoError(t, err)
+		_, err = cache.Get("eu-central-1", fetch)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"lsw.m3.large"}, got)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		cache := NewInstanceTypeCache()
+		calls := 0
+		fetch := func() ([]string, error) {
+			calls++
+			if calls == 1 {
+				return nil, errors.New("tralala")
+			}
+			return []string{"lsw.m3.large"}, nil
+		}
+
+		_, err := cache.Get("eu-west-3", fetch)
+		require.Error(t, err)
+		got, err := cache.Get("eu-west-3", fetch)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"lsw.m3.large"}, got)
+	})
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaintenanceTimeout is how long requests wait for a maintenance
+	// window to end when no timeout is configured.
+	DefaultMaintenanceTimeout = 30 * time.Minute
+
+	defaultMaintenanceInterval = 30 * time.Second
+)
+
+// IsMaintenanceResponse reports whether the API responded that it is
+// currently in a maintenance window. The response body is left readable.
+func IsMaintenanceResponse(resp *http.Response) bool {
+	if resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		return false
+	}
+	if resp.Body == nil {
+		return false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(string(body)), "maintenance")
+}
+
+// maintenanceTransport retries requests until the API maintenance window
+// ends or the timeout is reached.
+type maintenanceTransport struct {
+	next     http.RoundTripper
+	timeout  time.Duration
+	interval time.Duration
+}
+
+func (m maintenanceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	deadline := time.Now().Add(m.timeout)
+
+	for {
+		resp, err := m.next.RoundTrip(req)
+		if ClassifyResponse(resp, err) != ErrorClassMaintenance {
+			return resp, err
+		}
+
+		wait := retryAfter(resp, m.interval)
+		if time.Now().Add(wait).After(deadline) {
+			return resp, nil
+		}
+
+		if !rewindBody(req) {
+			return resp, nil
+		}
+		_ = resp.Body.Close()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// retryAfter returns the delay requested by the Retry-After header, falling
+// back to fallback if the header is missing or invalid.
+func retryAfter(resp *http.Response, fallback time.Duration) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return fallback
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// rewindBody replaces the consumed body of req with a fresh copy so it can be
+// sent again. It reports false if the body cannot be recreated.
+func rewindBody(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = body
+
+	return true
+}
@@ -0,0 +1,179 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const maintenanceBody = `{"errorCode": "503", "errorMessage": "The API is currently under maintenance"}`
+
+func TestIsMaintenanceResponse(t *testing.T) {
+	t.Run("detects maintenance response", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(bytes.NewReader([]byte(maintenanceBody))),
+		}
+
+		assert.True(t, IsMaintenanceResponse(resp))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, maintenanceBody, string(body), "body is still readable")
+	})
+
+	t.Run("ignores other 503 responses", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(bytes.NewReader([]byte(`{"errorMessage": "Service unavailable"}`))),
+		}
+
+		assert.False(t, IsMaintenanceResponse(resp))
+	})
+
+	t.Run("ignores other status codes", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Body:       io.NopCloser(bytes.NewReader([]byte(maintenanceBody))),
+		}
+
+		assert.False(t, IsMaintenanceResponse(resp))
+	})
+
+	t.Run("handles nil response", func(t *testing.T) {
+		assert.False(t, IsMaintenanceResponse(nil))
+	})
+}
+
+func Test_maintenanceTransport_RoundTrip(t *testing.T) {
+	t.Run("retries until maintenance ends", func(t *testing.T) {
+		calls := 0
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			calls++
+			if calls < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(maintenanceBody))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: maintenanceTransport{
+				next:     http.DefaultTransport,
+				timeout:  time.Second,
+				interval: time.Millisecond,
+			},
+		}
+
+		resp, err := httpClient.Post(server.URL, "application/json", strings.NewReader("payload"))
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, []string{"payload", "payload", "payload"}, bodies)
+	})
+
+	t.Run("retries requests without a body until maintenance ends", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(maintenanceBody))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: maintenanceTransport{
+				next:     http.DefaultTransport,
+				timeout:  time.Second,
+				interval: time.Millisecond,
+			},
+		}
+
+		req, err := http.NewRequest(http.MethodPost, server.URL, http.NoBody)
+		require.NoError(t, err)
+		require.Nil(t, req.GetBody)
+
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("returns maintenance response once timeout is reached", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(maintenanceBody))
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: maintenanceTransport{
+				next:     http.DefaultTransport,
+				timeout:  10 * time.Millisecond,
+				interval: 5 * time.Millisecond,
+			},
+		}
+
+		resp, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.True(t, IsMaintenanceResponse(resp))
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: maintenanceTransport{
+				next:     http.DefaultTransport,
+				timeout:  time.Second,
+				interval: time.Millisecond,
+			},
+		}
+
+		resp, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func Test_retryAfter(t *testing.T) {
+	t.Run("uses Retry-After header", func(t *testing.T) {
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+
+		assert.Equal(t, 2*time.Minute, retryAfter(resp, time.Second))
+	})
+
+	t.Run("falls back if header is missing", func(t *testing.T) {
+		assert.Equal(t, time.Second, retryAfter(&http.Response{}, time.Second))
+	})
+}
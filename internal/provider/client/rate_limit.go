@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket that spreads requests so that on average no
+// more than the configured number of requests per second is sent.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a limiter allowing requestsPerSecond requests per
+// second, with bursts of up to one second worth of requests.
+func NewRateLimiter(requestsPerSecond float64) *RateLimiter {
+	burst := max(requestsPerSecond, 1)
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token if one is available. Otherwise it returns how long
+// to wait until the next token is available.
+func (r *RateLimiter) reserve(now time.Time) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens = min(r.burst, r.tokens+now.Sub(r.last).Seconds()*r.rate)
+	r.last = now
+
+	if r.tokens >= 1 {
+		r.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - r.tokens) / r.rate * float64(time.Second))
+}
+
+// Wait blocks until a request may be sent or the context is done.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait := r.reserve(time.Now())
+		if wait == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// rateLimitTransport delays requests until the shared limiter allows them.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *RateLimiter
+}
+
+func (r rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := r.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return r.next.RoundTrip(req)
+}
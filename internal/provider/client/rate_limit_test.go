@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	t.Run("bursts are allowed up to the rate", func(t *testing.T) {
+		limiter := NewRateLimiter(2)
+		now := limiter.last
+
+		assert.Zero(t, limiter.reserve(now))
+		assert.Zero(t, limiter.reserve(now))
+		assert.Equal(t, 500*time.Millisecond, limiter.reserve(now))
+	})
+
+	t.Run("tokens are refilled over time", func(t *testing.T) {
+		limiter := NewRateLimiter(2)
+		now := limiter.last
+		limiter.reserve(now)
+		limiter.reserve(now)
+
+		assert.Zero(t, limiter.reserve(now.Add(500*time.Millisecond)))
+	})
+
+	t.Run("rates below one request per second are supported", func(t *testing.T) {
+		limiter := NewRateLimiter(0.5)
+		now := limiter.last
+
+		assert.Zero(t, limiter.reserve(now))
+		assert.Equal(t, 2*time.Second, limiter.reserve(now))
+	})
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	t.Run("blocks until a token is available", func(t *testing.T) {
+		limiter := NewRateLimiter(100)
+		limiter.tokens = 0
+
+		start := time.Now()
+		require.NoError(t, limiter.Wait(context.TODO()))
+
+		assert.GreaterOrEqual(t, time.Since(start), 5*time.Millisecond)
+	})
+
+	t.Run("stops waiting once the context is done", func(t *testing.T) {
+		limiter := NewRateLimiter(0.01)
+		limiter.tokens = 0
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		assert.ErrorIs(t, limiter.Wait(ctx), context.Canceled)
+	})
+}
+
+func Test_rateLimitTransport_RoundTrip(t *testing.T) {
+	t.Run("retries count against the budget", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		limiter := NewRateLimiter(DefaultMaxRetries + 1)
+		httpClient := newHTTPClient(
+			Optional{RetryWaitMax: time.Millisecond},
+			limiter,
+		)
+
+		resp, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, DefaultMaxRetries+1, calls)
+		assert.Positive(t, limiter.reserve(time.Now()), "the budget is used up")
+	})
+}
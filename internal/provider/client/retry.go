@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is how often transient errors are retried when no
+	// maximum is configured.
+	DefaultMaxRetries = 3
+	// DefaultRetryWaitMax caps the wait between retries when no maximum is
+	// configured.
+	DefaultRetryWaitMax = 30 * time.Second
+
+	defaultRetryWaitMin = time.Second
+)
+
+// notProcessedReason explains why a mutation with a transient error is not
+// retried.
+const notProcessedReason = "the API may already have processed the request"
+
+// retryDecision reports whether a request with the given context and method
+// that ended with resp and err is worth retrying and, if not, why.
+// ClassifyResponse decides whether the error is transient. Mutations are only
+// retried when the API certainly did not process them, which 429 and 503
+// guarantee, so a retry cannot create a resource twice.
+func retryDecision(
+	ctx context.Context,
+	method string,
+	resp *http.Response,
+	err error,
+) (bool, string) {
+	errorClass := ClassifyResponse(resp, err)
+	// Unlike a request whose own deadline has passed, a timed out attempt
+	// may succeed when it is sent again.
+	if resp == nil && attemptTimedOut(ctx, err) {
+		errorClass = ErrorClassTransient
+	}
+	// There is nothing to explain for a request that succeeded.
+	if errorClass == ErrorClassNone {
+		return false, ""
+	}
+	// Maintenance windows are handled by the maintenanceTransport.
+	if !errorClass.Retryable() || errorClass == ErrorClassMaintenance {
+		return false, errorClass.String()
+	}
+
+	if isIdempotent(method) {
+		return true, ""
+	}
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode == http.StatusServiceUnavailable) {
+		return true, ""
+	}
+
+	return false, notProcessedReason
+}
+
+// shouldRetry reports whether a request with the given context and method
+// that ended with resp and err is worth retrying.
+func shouldRetry(
+	ctx context.Context,
+	method string,
+	resp *http.Response,
+	err error,
+) bool {
+	retry, _ := retryDecision(ctx, method, resp, err)
+
+	return retry
+}
+
+// NotRetriedReason explains why the request of resp was not retried, in a way
+// that completes the sentence "The request was not retried because ...". It
+// is empty if requests are retried on such responses or the response is not
+// an error.
+func NotRetriedReason(resp *http.Response) string {
+	method := ""
+	if resp.Request != nil {
+		method = resp.Request.Method
+	}
+	_, reason := retryDecision(context.Background(), method, resp, nil)
+
+	return reason
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return false
+}
+
+// retryTransport retries requests that failed with a transient error using
+// exponential backoff with jitter.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	// readMaxRetries is how often reads are retried, so refreshes can be
+	// retried more often than mutations.
+	readMaxRetries int
+	waitMin        time.Duration
+	waitMax        time.Duration
+}
+
+// retriesFor returns how often a request with the given method is retried.
+func (r retryTransport) retriesFor(method string) int {
+	if isIdempotent(method) {
+		return r.readMaxRetries
+	}
+
+	return r.maxRetries
+}
+
+// backoff returns the wait before the given retry, doubling with every
+// attempt up to waitMax. The jitter spreads retries of parallel requests.
+func (r retryTransport) backoff(attempt int) time.Duration {
+	wait := r.waitMin << attempt
+	if wait <= 0 || wait > r.waitMax {
+		wait = r.waitMax
+	}
+
+	return wait/2 + rand.N(wait/2+1)
+}
+
+func (r retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	maxRetries := r.retriesFor(req.Method)
+	for attempt := 0; ; attempt++ {
+		resp, err := r.next.RoundTrip(req)
+		if attempt >= maxRetries || !shouldRetry(req.Context(), req.Method, resp, err) {
+			return resp, err
+		}
+
+		wait := r.backoff(attempt)
+		statusCode := 0
+		if resp != nil {
+			wait = retryAfter(resp, wait)
+			statusCode = resp.StatusCode
+		}
+		if wait > r.waitMax {
+			wait = r.waitMax
+		}
+
+		if !rewindBody(req) {
+			return resp, err
+		}
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+
+		tflog.Debug(req.Context(), "Retrying request after transient error", map[string]any{
+			"method":      req.Method,
+			"url":         req.URL.String(),
+			"status_code": statusCode,
+			"attempt":     attempt + 1,
+			"max_retries": maxRetries,
+			"wait":        wait.String(),
+		})
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,420 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func Test_shouldRetry(t *testing.T) {
+	t.Run("idempotent requests are retried on transient errors", func(t *testing.T) {
+		for _, statusCode := range []int{
+			http.StatusRequestTimeout,
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		} {
+			assert.True(
+				t,
+				shouldRetry(context.Background(), http.MethodGet, &http.Response{StatusCode: statusCode}, nil),
+				"GET is retried on %d",
+				statusCode,
+			)
+		}
+	})
+
+	t.Run("mutations are only retried if they were not processed", func(t *testing.T) {
+		for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodDelete} {
+			assert.True(t, shouldRetry(context.Background(), method, &http.Response{StatusCode: http.StatusTooManyRequests}, nil))
+			assert.True(t, shouldRetry(context.Background(), method, &http.Response{StatusCode: http.StatusServiceUnavailable}, nil))
+			assert.False(t, shouldRetry(context.Background(), method, &http.Response{StatusCode: http.StatusRequestTimeout}, nil))
+			assert.False(t, shouldRetry(context.Background(), method, &http.Response{StatusCode: http.StatusBadGateway}, nil))
+			assert.False(t, shouldRetry(context.Background(), method, &http.Response{StatusCode: http.StatusGatewayTimeout}, nil))
+		}
+	})
+
+	t.Run("maintenance responses are not retried", func(t *testing.T) {
+		resp := &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(bytes.NewReader([]byte(maintenanceBody))),
+		}
+
+		assert.False(t, shouldRetry(context.Background(), http.MethodGet, resp, nil))
+	})
+
+	t.Run("other responses are not retried", func(t *testing.T) {
+		assert.False(t, shouldRetry(context.Background(), http.MethodGet, &http.Response{StatusCode: http.StatusInternalServerError}, nil))
+		assert.False(t, shouldRetry(context.Background(), http.MethodGet, &http.Response{StatusCode: http.StatusOK}, nil))
+		assert.False(t, shouldRetry(context.Background(), http.MethodGet, nil, nil))
+	})
+
+	t.Run("reads are retried on network errors", func(t *testing.T) {
+		assert.True(t, shouldRetry(context.Background(), http.MethodGet, nil, errors.New("connection reset by peer")))
+	})
+
+	t.Run("mutations are not retried on network errors", func(t *testing.T) {
+		assert.False(t, shouldRetry(context.Background(), http.MethodPost, nil, errors.New("connection reset by peer")))
+	})
+
+	t.Run("cancelled requests are not retried", func(t *testing.T) {
+		assert.False(t, shouldRetry(context.Background(), http.MethodGet, nil, context.Canceled))
+	})
+
+	t.Run("reads are retried if only the attempt timed out", func(t *testing.T) {
+		assert.True(t, shouldRetry(context.Background(), http.MethodGet, nil, context.DeadlineExceeded))
+		assert.False(t, shouldRetry(context.Background(), http.MethodPost, nil, context.DeadlineExceeded))
+	})
+
+	t.Run("requests are not retried once their own deadline has passed", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 0)
+		defer cancel()
+
+		assert.False(t, shouldRetry(ctx, http.MethodGet, nil, context.DeadlineExceeded))
+	})
+}
+
+func Test_retryDecision(t *testing.T) {
+	t.Run("the error class explains why a request is not retried", func(t *testing.T) {
+		retry, reason := retryDecision(context.Background(), http.MethodGet, &http.Response{StatusCode: http.StatusBadRequest}, nil)
+
+		assert.False(t, retry)
+		assert.Equal(t, ErrorClassValidation.String(), reason)
+	})
+
+	t.Run("transient errors of mutations may have been processed", func(t *testing.T) {
+		retry, reason := retryDecision(context.Background(), http.MethodPost, &http.Response{StatusCode: http.StatusBadGateway}, nil)
+
+		assert.False(t, retry)
+		assert.Equal(t, "the API may already have processed the request", reason)
+	})
+
+	t.Run("retried requests have no reason", func(t *testing.T) {
+		retry, reason := retryDecision(context.Background(), http.MethodGet, &http.Response{StatusCode: http.StatusBadGateway}, nil)
+
+		assert.True(t, retry)
+		assert.Empty(t, reason)
+	})
+}
+
+func Test_retryTransport_backoff(t *testing.T) {
+	transport := retryTransport{waitMin: time.Second, waitMax: 5 * time.Second}
+
+	t.Run("wait grows exponentially", func(t *testing.T) {
+		got := transport.backoff(1)
+
+		assert.GreaterOrEqual(t, got, time.Second)
+		assert.LessOrEqual(t, got, 2*time.Second)
+	})
+
+	t.Run("wait is capped", func(t *testing.T) {
+		got := transport.backoff(10)
+
+		assert.GreaterOrEqual(t, got, 2500*time.Millisecond)
+		assert.LessOrEqual(t, got, 5*time.Second)
+	})
+}
+
+func Test_retryTransport_RoundTrip(t *testing.T) {
+	t.Run("retries until the request succeeds", func(t *testing.T) {
+		calls := 0
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			calls++
+			if calls < 3 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: retryTransport{
+				next:       http.DefaultTransport,
+				maxRetries: 3,
+				waitMin:    time.Millisecond,
+				waitMax:    time.Millisecond,
+			},
+		}
+
+		resp, err := httpClient.Post(server.URL, "application/json", strings.NewReader("payload"))
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, []string{"payload", "payload", "payload"}, bodies)
+	})
+
+	t.Run("stops waiting once the context is done", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: retryTransport{
+				next:           http.DefaultTransport,
+				maxRetries:     3,
+				readMaxRetries: 3,
+				waitMin:        time.Minute,
+				waitMax:        time.Minute,
+			},
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		_, err = httpClient.Do(request)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("returns the last response once retries are exhausted", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: retryTransport{
+				next:           http.DefaultTransport,
+				maxRetries:     2,
+				readMaxRetries: 2,
+				waitMin:        time.Millisecond,
+				waitMax:        time.Millisecond,
+			},
+		}
+
+		resp, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Retry-After is capped by waitMax", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("Retry-After", "120")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: retryTransport{
+				next:           http.DefaultTransport,
+				maxRetries:     1,
+				readMaxRetries: 1,
+				waitMin:        time.Millisecond,
+				waitMax:        10 * time.Millisecond,
+			},
+		}
+
+		start := time.Now()
+		resp, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("does not retry mutations on gateway errors", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: retryTransport{
+				next:       http.DefaultTransport,
+				maxRetries: 3,
+				waitMin:    time.Millisecond,
+				waitMax:    time.Millisecond,
+			},
+		}
+
+		resp, err := httpClient.Post(server.URL, "application/json", strings.NewReader("payload"))
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func Test_retryTransport_readRetries(t *testing.T) {
+	t.Run("reads use their own retry budget", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: retryTransport{
+				next:           http.DefaultTransport,
+				maxRetries:     1,
+				readMaxRetries: 4,
+				waitMin:        time.Millisecond,
+				waitMax:        time.Millisecond,
+			},
+		}
+
+		resp, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, 5, calls)
+
+		calls = 0
+		resp, err = httpClient.Post(server.URL, "application/json", strings.NewReader("payload"))
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("reads are retried after a network error", func(t *testing.T) {
+		calls := 0
+		transport := retryTransport{
+			next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls++
+				if calls == 1 {
+					return nil, errors.New("connection reset by peer")
+				}
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+			}),
+			readMaxRetries: 1,
+			waitMin:        time.Millisecond,
+			waitMax:        time.Millisecond,
+		}
+
+		resp, err := transport.RoundTrip(httptest.NewRequest(http.MethodGet, "http://localhost", nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("mutations are not retried after a network error", func(t *testing.T) {
+		calls := 0
+		transport := retryTransport{
+			next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls++
+				return nil, errors.New("connection reset by peer")
+			}),
+			maxRetries:     3,
+			readMaxRetries: 3,
+			waitMin:        time.Millisecond,
+			waitMax:        time.Millisecond,
+		}
+
+		_, err := transport.RoundTrip(httptest.NewRequest(http.MethodDelete, "http://localhost", nil))
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func Test_newHTTPClient(t *testing.T) {
+	t.Run("retries by default", func(t *testing.T) {
+		got := newHTTPClient(Optional{}, nil)
+
+		transport, ok := got.Transport.(retryTransport)
+		require.True(t, ok)
+		assert.Equal(t, DefaultMaxRetries, transport.maxRetries)
+		assert.Equal(t, DefaultMaxRetries, transport.readMaxRetries)
+		assert.Equal(t, DefaultRetryWaitMax, transport.waitMax)
+	})
+
+	t.Run("reads can be retried more often than mutations", func(t *testing.T) {
+		maxRetries := 0
+		refreshMaxRetries := 10
+
+		got := newHTTPClient(Optional{MaxRetries: &maxRetries, RefreshMaxRetries: &refreshMaxRetries}, nil)
+
+		transport, ok := got.Transport.(retryTransport)
+		require.True(t, ok)
+		assert.Equal(t, 0, transport.maxRetries)
+		assert.Equal(t, 10, transport.readMaxRetries)
+	})
+
+	t.Run("retries can be disabled", func(t *testing.T) {
+		maxRetries := 0
+
+		got := newHTTPClient(Optional{MaxRetries: &maxRetries}, nil)
+
+		assert.Equal(t, http.DefaultTransport, got.Transport)
+	})
+
+	t.Run("maintenance is waited for around retries", func(t *testing.T) {
+		got := newHTTPClient(Optional{WaitForMaintenance: true, RetryWaitMax: time.Minute}, nil)
+
+		transport, ok := got.Transport.(maintenanceTransport)
+		require.True(t, ok)
+		retry, ok := transport.next.(retryTransport)
+		require.True(t, ok)
+		assert.Equal(t, time.Minute, retry.waitMax)
+	})
+}
+
+func TestNotRetriedReason(t *testing.T) {
+	t.Run("explains why a response was not retried", func(t *testing.T) {
+		got := NotRetriedReason(&http.Response{
+			StatusCode: http.StatusBadGateway,
+			Request:    &http.Request{Method: http.MethodPost},
+		})
+
+		assert.Equal(t, "the API may already have processed the request", got)
+	})
+
+	t.Run("is empty for responses that are retried", func(t *testing.T) {
+		got := NotRetriedReason(&http.Response{
+			StatusCode: http.StatusRequestTimeout,
+			Request:    &http.Request{Method: http.MethodGet},
+		})
+
+		assert.Empty(t, got)
+	})
+
+	t.Run("is empty for successful responses", func(t *testing.T) {
+		got := NotRetriedReason(&http.Response{
+			StatusCode: http.StatusOK,
+			Request:    &http.Request{Method: http.MethodPost},
+		})
+
+		assert.Empty(t, got)
+	})
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// A Subsystem is a part of the Leaseweb API that can be unavailable on its
+// own. Its value is the prefix of the names of its resources and data
+// sources.
+type Subsystem string
+
+const (
+	SubsystemPublicCloud     Subsystem = "public_cloud"
+	SubsystemDedicatedServer Subsystem = "dedicated_server"
+	SubsystemDNS             Subsystem = "dns"
+	SubsystemIPmgmt          Subsystem = "ipmgmt"
+)
+
+// Subsystems lists every subsystem in the order they are checked.
+var Subsystems = []Subsystem{
+	SubsystemPublicCloud,
+	SubsystemDedicatedServer,
+	SubsystemDNS,
+	SubsystemIPmgmt,
+}
+
+// healthCheckDomain is looked up to check the DNS API, which cannot list
+// anything without a domain. The API has no endpoint to list the domains of
+// the account, so the check only covers whether the API responds. The
+// reserved .invalid top-level domain guarantees that no one's domain is
+// looked up.
+const healthCheckDomain = "health-check.invalid"
+
+// SubsystemForName returns the subsystem a resource or data source belongs
+// to, based on the prefix of its name.
+func SubsystemForName(name string) (Subsystem, bool) {
+	for _, subsystem := range Subsystems {
+		if strings.HasPrefix(name, string(subsystem)) {
+			return subsystem, true
+		}
+	}
+
+	return "", false
+}
+
+// CheckSubsystems sends a single small request to every subsystem and returns
+// the ones that could not be reached, each with an error wrapping
+// ErrAPIUnreachable. Other errors, including rejected tokens, are left to
+// CheckCredentials and the requests that follow.
+func (c Client) CheckSubsystems(ctx context.Context) map[Subsystem]error {
+	unavailable := map[Subsystem]error{}
+
+	for _, subsystem := range Subsystems {
+		httpResponse, err := c.checkSubsystem(ctx, subsystem)
+
+		// The request was cut off by the provider timeout, not by ctx.
+		if httpResponse == nil && attemptTimedOut(ctx, err) {
+			unavailable[subsystem] = fmt.Errorf("%w: %w", ErrAPIUnreachable, err)
+			continue
+		}
+
+		switch ClassifyResponse(httpResponse, err) {
+		case ErrorClassTransient, ErrorClassMaintenance:
+			if httpResponse != nil {
+				unavailable[subsystem] = fmt.Errorf("%w: the API responded with %s", ErrAPIUnreachable, httpResponse.Status)
+				continue
+			}
+			unavailable[subsystem] = fmt.Errorf("%w: %w", ErrAPIUnreachable, err)
+		}
+	}
+
+	return unavailable
+}
+
+func (c Client) checkSubsystem(
+	ctx context.Context,
+	subsystem Subsystem,
+) (*http.Response, error) {
+	var httpResponse *http.Response
+	var err error
+
+	switch subsystem {
+	case SubsystemPublicCloud:
+		_, httpResponse, err = c.PubliccloudAPI.GetInstanceList(ctx).Limit(1).Execute()
+	case SubsystemDedicatedServer:
+		_, httpResponse, err = c.DedicatedserverAPI.GetServerList(ctx).Limit(1).Execute()
+	case SubsystemDNS:
+		_, httpResponse, err = c.DNSAPI.GetResourceRecordSetList(ctx, healthCheckDomain).Execute()
+	case SubsystemIPmgmt:
+		_, httpResponse, err = c.IPmgmtAPI.GetIPList(ctx).Limit(1).Execute()
+	}
+
+	return httpResponse, err
+}
+
+// SubsystemError returns why the subsystem of the named resource or data
+// source is unavailable, nil if it is available or was not checked.
+func (c Client) SubsystemError(name string) error {
+	subsystem, ok := SubsystemForName(name)
+	if !ok {
+		return nil
+	}
+
+	return c.UnavailableSubsystems[subsystem]
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubsystemForName(t *testing.T) {
+	tests := []struct {
+		name      string
+		want      Subsystem
+		wantFound bool
+	}{
+		{name: "public_cloud_instance", want: SubsystemPublicCloud, wantFound: true},
+		{name: "dedicated_server", want: SubsystemDedicatedServer, wantFound: true},
+		{name: "dedicated_servers", want: SubsystemDedicatedServer, wantFound: true},
+		{name: "dns_resource_record_set", want: SubsystemDNS, wantFound: true},
+		{name: "ipmgmt_ip", want: SubsystemIPmgmt, wantFound: true},
+		{name: "tralala", wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := SubsystemForName(tt.name)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantFound, found)
+		})
+	}
+}
+
+func TestClient_CheckSubsystems(t *testing.T) {
+	t.Run("all subsystems are available", func(t *testing.T) {
+		var paths []string
+		client := newCredentialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		got := client.CheckSubsystems(context.Background())
+
+		assert.Empty(t, got)
+		assert.Equal(
+			t,
+			[]string{
+				"/publicCloud/v1/instances",
+				"/bareMetals/v2/servers",
+				"/hosting/v2/domains/health-check.invalid/resourceRecordSets",
+				"/ipMgmt/v2/ips",
+			},
+			paths,
+		)
+	})
+
+	t.Run("an unavailable subsystem is reported", func(t *testing.T) {
+		client := newCredentialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, "/bareMetals") {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		got := client.CheckSubsystems(context.Background())
+
+		assert.Len(t, got, 1)
+		assert.ErrorIs(t, got[SubsystemDedicatedServer], ErrAPIUnreachable)
+		assert.ErrorContains(t, got[SubsystemDedicatedServer], "503")
+	})
+
+	t.Run("a subsystem that does not respond in time is reported", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, "/hosting") {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(time.Second):
+				}
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		t.Cleanup(server.Close)
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		maxRetries := 0
+		client := NewClient(
+			"token",
+			Optional{
+				Host:       &serverURL.Host,
+				Scheme:     &serverURL.Scheme,
+				MaxRetries: &maxRetries,
+				Timeout:    10 * time.Millisecond,
+			},
+			"test",
+		)
+
+		got := client.CheckSubsystems(context.Background())
+
+		assert.Len(t, got, 1)
+		assert.ErrorIs(t, got[SubsystemDNS], ErrAPIUnreachable)
+	})
+
+	t.Run("rejected tokens are left to CheckCredentials", func(t *testing.T) {
+		client := newCredentialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+
+		got := client.CheckSubsystems(context.Background())
+
+		assert.Empty(t, got)
+	})
+}
+
+func TestClient_SubsystemError(t *testing.T) {
+	unavailableErr := errors.New("tralala")
+	client := Client{
+		UnavailableSubsystems: map[Subsystem]error{
+			SubsystemDNS: unavailableErr,
+		},
+	}
+
+	t.Run("unavailable subsystem returns its error", func(t *testing.T) {
+		assert.Equal(t, unavailableErr, client.SubsystemError("dns_resource_record_set"))
+	})
+
+	t.Run("available subsystem returns nil", func(t *testing.T) {
+		assert.NoError(t, client.SubsystemError("ipmgmt_ip"))
+	})
+
+	t.Run("unknown name returns nil", func(t *testing.T) {
+		assert.NoError(t, client.SubsystemError("tralala"))
+	})
+
+	t.Run("unchecked subsystems return nil", func(t *testing.T) {
+		assert.NoError(t, Client{}.SubsystemError("ipmgmt_ip"))
+	})
+}
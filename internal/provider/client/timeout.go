@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// timeoutTransport aborts a single request attempt that takes longer than
+// the timeout. A deadline of the request context, such as the timeout of a
+// resource operation, still applies if it is reached first. Unlike
+// http.Client.Timeout it applies per attempt, so waits between retries do not
+// count towards it.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+// attemptTimedOut reports whether err is the timeout of a single attempt, as
+// set by the timeoutTransport, rather than the deadline or cancellation of
+// ctx, the context of the request as a whole.
+func attemptTimedOut(ctx context.Context, err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil
+}
+
+func (t timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return resp, err
+	}
+
+	// The timeout also covers reading the body, so it is only released once
+	// the body is closed.
+	resp.Body = cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+
+	return err
+}
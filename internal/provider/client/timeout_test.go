@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_timeoutTransport_RoundTrip(t *testing.T) {
+	t.Run("aborts requests that take too long", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: timeoutTransport{
+				next:    http.DefaultTransport,
+				timeout: 10 * time.Millisecond,
+			},
+		}
+
+		_, err := httpClient.Get(server.URL)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("a later deadline of the context does not extend the timeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: timeoutTransport{
+				next:    http.DefaultTransport,
+				timeout: 10 * time.Millisecond,
+			},
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		_, err = httpClient.Do(request)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("the deadline of the context is applied", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: timeoutTransport{
+				next:    http.DefaultTransport,
+				timeout: time.Minute,
+			},
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		_, err = httpClient.Do(request)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("the body can be read after a fast response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("payload"))
+		}))
+		defer server.Close()
+
+		httpClient := http.Client{
+			Transport: timeoutTransport{
+				next:    http.DefaultTransport,
+				timeout: time.Second,
+			},
+		}
+
+		resp, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "payload", string(body))
+	})
+}
+
+func Test_newHTTPClient_timeout(t *testing.T) {
+	t.Run("requests do not time out by default", func(t *testing.T) {
+		maxRetries := 0
+
+		got := newHTTPClient(Optional{MaxRetries: &maxRetries}, nil)
+
+		assert.Equal(t, http.DefaultTransport, got.Transport)
+		assert.Zero(t, got.Timeout)
+	})
+
+	t.Run("the retry transport wraps the timeout transport", func(t *testing.T) {
+		got := newHTTPClient(Optional{Timeout: time.Minute}, nil)
+
+		retry, ok := got.Transport.(retryTransport)
+		require.True(t, ok)
+		transport, ok := retry.next.(timeoutTransport)
+		require.True(t, ok)
+		assert.Equal(t, time.Minute, transport.timeout)
+	})
+
+	t.Run("reads that time out are retried", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(time.Second):
+				}
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		httpClient := newHTTPClient(
+			Optional{Timeout: 50 * time.Millisecond, RetryWaitMax: time.Millisecond},
+			nil,
+		)
+
+		resp, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("mutations that time out are not retried", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		defer server.Close()
+
+		httpClient := newHTTPClient(
+			Optional{Timeout: 50 * time.Millisecond, RetryWaitMax: time.Millisecond},
+			nil,
+		)
+
+		_, err := httpClient.Post(server.URL, "application/json", nil)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"crypto/x509"
+	"errors"
+)
+
+// NewRootCAs returns the system certificate pool extended with the PEM
+// encoded certificates, e.g. of a proxy that intercepts TLS traffic.
+func NewRootCAs(pemCerts ...[]byte) (*x509.CertPool, error) {
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+
+	for _, pemCert := range pemCerts {
+		if !rootCAs.AppendCertsFromPEM(pemCert) {
+			return nil, errors.New("no PEM encoded certificates found")
+		}
+	}
+
+	return rootCAs, nil
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRootCAs(t *testing.T) {
+	t.Run("certificates are added to the pool", func(t *testing.T) {
+		server := httptest.NewTLSServer(nil)
+		defer server.Close()
+
+		rootCAs, err := NewRootCAs(pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: server.Certificate().Raw,
+		}))
+
+		require.NoError(t, err)
+		_, err = server.Certificate().Verify(x509.VerifyOptions{Roots: rootCAs})
+		assert.NoError(t, err)
+	})
+
+	t.Run("input without certificates is rejected", func(t *testing.T) {
+		_, err := NewRootCAs([]byte("not a certificate"))
+
+		assert.EqualError(t, err, "no PEM encoded certificates found")
+	})
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is the profile of the shared credentials file that is used
+// when no profile is configured.
+const DefaultProfile = "default"
+
+// ErrProfileNotFound means the shared credentials file has no token for the
+// profile.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// DefaultSharedCredentialsFile returns ~/.leaseweb/credentials, or an empty
+// string if the home directory is unknown.
+func DefaultSharedCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".leaseweb", "credentials")
+}
+
+// expandHome replaces a leading ~ with the home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// ReadTokenFile returns the token stored in a file, such as a secret mounted
+// by Vault or Kubernetes. Surrounding whitespace is removed, as most tools
+// write a trailing newline.
+func ReadTokenFile(path string) (string, error) {
+	content, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimSpace(content)), nil
+}
+
+// ReadSharedCredentials returns the token of a profile in an INI style
+// shared credentials file:
+//
+//	[default]
+//	token = 527070ca-8449-4f06-b609-ec6797bd8222
+//
+//	[us]
+//	token = 416fa444-5e96-4198-a4f7-297cbbc3cc70
+func ReadSharedCredentials(path string, profile string) (string, error) {
+	content, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return "", err
+	}
+
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return "", fmt.Errorf("line %d: expected key = value, got %q", lineNumber, line)
+		}
+		if section == profile && strings.TrimSpace(key) == "token" {
+			return strings.TrimSpace(value), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrProfileNotFound, profile)
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestReadTokenFile(t *testing.T) {
+	t.Run("surrounding whitespace is removed", func(t *testing.T) {
+		got, err := ReadTokenFile(writeFile(t, "  tralala\n"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "tralala", got)
+	})
+
+	t.Run("missing file is reported", func(t *testing.T) {
+		_, err := ReadTokenFile(filepath.Join(t.TempDir(), "missing"))
+
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestReadSharedCredentials(t *testing.T) {
+	path := writeFile(t, `
+# Leaseweb API tokens
+[default]
+token = default-token
+
+[us]
+; the token of the US account
+token=us-token
+`)
+
+	t.Run("token of the profile is returned", func(t *testing.T) {
+		got, err := ReadSharedCredentials(path, "us")
+
+		require.NoError(t, err)
+		assert.Equal(t, "us-token", got)
+	})
+
+	t.Run("default profile", func(t *testing.T) {
+		got, err := ReadSharedCredentials(path, DefaultProfile)
+
+		require.NoError(t, err)
+		assert.Equal(t, "default-token", got)
+	})
+
+	t.Run("unknown profile is reported", func(t *testing.T) {
+		_, err := ReadSharedCredentials(path, "eu")
+
+		assert.ErrorIs(t, err, ErrProfileNotFound)
+	})
+
+	t.Run("malformed lines are reported", func(t *testing.T) {
+		_, err := ReadSharedCredentials(writeFile(t, "[default]\ntoken\n"), DefaultProfile)
+
+		assert.EqualError(t, err, `line 2: expected key = value, got "token"`)
+	})
+}
+
+func Test_expandHome(t *testing.T) {
+	t.Setenv("HOME", "/home/user")
+
+	assert.Equal(t, "/home/user/.leaseweb/credentials", expandHome("~/.leaseweb/credentials"))
+	assert.Equal(t, "/etc/leaseweb/token", expandHome("/etc/leaseweb/token"))
+}
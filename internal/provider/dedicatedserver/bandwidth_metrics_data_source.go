@@ -0,0 +1,124 @@
+package dedicatedserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure      = &bandwidthMetricsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &bandwidthMetricsDataSource{}
+)
+
+const (
+	bandwidthAggregationAverage      = "AVG"
+	bandwidthAggregation95Percentile = "95TH"
+)
+
+var bandwidthGranularities = []string{"5MIN", "HOUR", "DAY", "WEEK", "MONTH", "YEAR"}
+
+type bandwidthMetricsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (b *bandwidthMetricsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	aggregations := []string{bandwidthAggregationAverage, bandwidthAggregation95Percentile}
+
+	attributes := metricsSchemaAttributes("The unit of the values, e.g. `bps`")
+	attributes["granularity"] = granularityAttribute(bandwidthGranularities)
+	attributes["aggregation"] = schema.StringAttribute{
+		Optional: true,
+		Description: "How the values are aggregated, defaults to `AVG`. `95TH` returns a single value and cannot be combined with `granularity`. Valid options are " + utils.StringTypeArrayToMarkdown(
+			aggregations,
+		),
+		Validators: []validator.String{
+			stringvalidator.OneOf(aggregations...),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Description: "Reports the bandwidth of the public interface of a dedicated server over an interval.",
+		Attributes:  attributes,
+	}
+}
+
+func (b *bandwidthMetricsDataSource) ValidateConfig(
+	ctx context.Context,
+	request datasource.ValidateConfigRequest,
+	response *datasource.ValidateConfigResponse,
+) {
+	validateMetricsInterval(ctx, request.Config, &response.Diagnostics)
+
+	var granularity, aggregation types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("granularity"), &granularity)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("aggregation"), &aggregation)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !granularity.IsNull() && aggregation.ValueString() == bandwidthAggregation95Percentile {
+		response.Diagnostics.AddAttributeError(
+			path.Root("granularity"),
+			"Invalid Attribute Combination",
+			"granularity cannot be set when aggregation is 95TH.",
+		)
+	}
+}
+
+func (b *bandwidthMetricsDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config metricsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	from, to := parseMetricsInterval(config, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	aggregation := bandwidthAggregationAverage
+	if !config.Aggregation.IsNull() {
+		aggregation = config.Aggregation.ValueString()
+	}
+
+	metricsRequest := b.DedicatedserverAPI.GetBandwidthMetrics(
+		ctx,
+		config.DedicatedServerID.ValueString(),
+	).From(from).To(to).Aggregation(aggregation)
+	if !config.Granularity.IsNull() {
+		metricsRequest = metricsRequest.Granularity(config.Granularity.ValueString())
+	}
+
+	metrics, httpResponse, err := metricsRequest.Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptMetricsToMetricsDataSource(*metrics, config)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func NewBandwidthMetricsDataSource() datasource.DataSource {
+	return &bandwidthMetricsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_bandwidth_metrics",
+		},
+	}
+}
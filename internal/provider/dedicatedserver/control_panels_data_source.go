@@ -3,6 +3,7 @@ package dedicatedserver
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -29,6 +30,19 @@ type controlPanelDataSourceModel struct {
 type controlPanelsDataSourceModel struct {
 	ControlPanels     []controlPanelDataSourceModel `tfsdk:"control_panels"`
 	OperatingSystemId types.String                  `tfsdk:"operating_system_id"`
+	Name              types.String                  `tfsdk:"name"`
+}
+
+// matches reports whether the control panel passes the name filter.
+func (c controlPanelsDataSourceModel) matches(controlPanel dedicatedserver.ControlPanel) bool {
+	if c.Name.IsNull() || c.Name.IsUnknown() {
+		return true
+	}
+
+	return strings.Contains(
+		strings.ToLower(controlPanel.GetName()),
+		strings.ToLower(c.Name.ValueString()),
+	)
 }
 
 func (c *controlPanelsDataSource) Read(
@@ -62,6 +76,10 @@ func (c *controlPanelsDataSource) Read(
 	}
 
 	for _, cp := range result.GetControlPanels() {
+		if !config.matches(cp) {
+			continue
+		}
+
 		controlPanels = append(controlPanels, controlPanelDataSourceModel{
 			ID:   basetypes.NewStringValue(cp.GetId()),
 			Name: basetypes.NewStringValue(cp.GetName()),
@@ -74,6 +92,7 @@ func (c *controlPanelsDataSource) Read(
 			controlPanelsDataSourceModel{
 				ControlPanels:     controlPanels,
 				OperatingSystemId: config.OperatingSystemId,
+				Name:              config.Name,
 			},
 		)...,
 	)
@@ -105,6 +124,10 @@ func (c *controlPanelsDataSource) Schema(
 				Optional:    true,
 				Description: "Filter control panels by operating system id.",
 			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only control panels whose name contains this value, ignoring case. The API does not list versions separately, pin a control panel by its `id` with `control_panel_id` of `leaseweb_dedicated_server_installation`.",
+			},
 		},
 	}
 }
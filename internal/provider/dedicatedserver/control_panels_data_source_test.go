@@ -0,0 +1,30 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_controlPanelsDataSourceModel_matches(t *testing.T) {
+	controlPanel := dedicatedserver.ControlPanel{
+		Id:   "CPANEL_PREMIER_100",
+		Name: "cPanel Premier 100",
+	}
+
+	t.Run("matches without filter", func(t *testing.T) {
+		filters := controlPanelsDataSourceModel{Name: basetypes.NewStringNull()}
+
+		assert.True(t, filters.matches(controlPanel))
+	})
+
+	t.Run("filters by part of the name ignoring case", func(t *testing.T) {
+		filters := controlPanelsDataSourceModel{Name: basetypes.NewStringValue("CPANEL premier")}
+		assert.True(t, filters.matches(controlPanel))
+
+		filters.Name = basetypes.NewStringValue("plesk")
+		assert.False(t, filters.matches(controlPanel))
+	})
+}
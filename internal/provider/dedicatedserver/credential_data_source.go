@@ -34,6 +34,8 @@ func (c *credentialDataSource) Schema(
 	resp *datasource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		DeprecationMessage: "This data source stores the password in the Terraform state. " +
+			"Use the leaseweb_dedicated_server_credential ephemeral resource instead, which does not.",
 		Attributes: map[string]schema.Attribute{
 			"dedicated_server_id": schema.StringAttribute{
 				Description: "The ID of a server",
@@ -0,0 +1,96 @@
+package dedicatedserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &credentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &credentialEphemeralResource{}
+)
+
+type credentialEphemeralResource struct {
+	utils.EphemeralResourceAPI
+}
+
+type credentialEphemeralResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	Type              types.String `tfsdk:"type"`
+}
+
+func (c *credentialEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a credential of a dedicated server without storing it in the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Description: "The ID of a server",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the credential. Valid options are " + utils.StringTypeArrayToMarkdown(dedicatedserver.AllowedCredentialTypeEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(dedicatedserver.AllowedCredentialTypeEnumValues)...),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The username for the credentials",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password for the credentials",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (c *credentialEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var config credentialEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, response, err := c.DedicatedserverAPI.GetCredential(
+		ctx,
+		config.DedicatedServerID.ValueString(),
+		dedicatedserver.CredentialType(config.Type.ValueString()),
+		config.Username.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	config.Password = types.StringValue(credential.GetPassword())
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+func NewCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &credentialEphemeralResource{
+		EphemeralResourceAPI: utils.EphemeralResourceAPI{
+			Name: "dedicated_server_credential",
+		},
+	}
+}
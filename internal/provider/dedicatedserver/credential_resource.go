@@ -3,7 +3,9 @@ package dedicatedserver
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,8 +17,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &credentialResource{}
-	_ resource.ResourceWithConfigure = &credentialResource{}
+	_ resource.Resource                = &credentialResource{}
+	_ resource.ResourceWithConfigure   = &credentialResource{}
+	_ resource.ResourceWithImportState = &credentialResource{}
 )
 
 type credentialResource struct {
@@ -28,6 +31,42 @@ type credentialResourceModel struct {
 	Username          types.String `tfsdk:"username"`
 	Type              types.String `tfsdk:"type"`
 	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int32  `tfsdk:"password_wo_version"`
+}
+
+// adaptCredentialToCredentialResource leaves the password out of the state
+// when it is set through the write-only password_wo attribute.
+func adaptCredentialToCredentialResource(
+	dedicatedServerID types.String,
+	credentialType string,
+	username string,
+	password string,
+	passwordWOVersion types.Int32,
+) credentialResourceModel {
+	state := credentialResourceModel{
+		DedicatedServerID: dedicatedServerID,
+		Type:              types.StringValue(credentialType),
+		Username:          types.StringValue(username),
+		Password:          types.StringValue(password),
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: passwordWOVersion,
+	}
+	if !passwordWOVersion.IsNull() {
+		state.Password = types.StringNull()
+	}
+
+	return state
+}
+
+// password returns the password to send to the API, which comes from the
+// configuration when the write-only password_wo attribute is used.
+func (c credentialResourceModel) password(config credentialResourceModel) string {
+	if c.Password.IsNull() {
+		return config.PasswordWO.ValueString()
+	}
+
+	return c.Password.ValueString()
 }
 
 func NewCredentialResource() resource.Resource {
@@ -38,6 +77,19 @@ func NewCredentialResource() resource.Resource {
 	}
 }
 
+func (c *credentialResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"dedicated_server_id", "type", "username"},
+		req,
+		resp,
+	)
+}
+
 func (c *credentialResource) Schema(
 	_ context.Context,
 	_ resource.SchemaRequest,
@@ -70,8 +122,28 @@ func (c *credentialResource) Schema(
 				},
 			},
 			"password": schema.StringAttribute{
-				Required:    true,
-				Description: `The password for the credentials`,
+				Optional:    true,
+				Description: "The password for the credentials. Either `password` or `password_wo` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The password for the credentials. Unlike `password`, it is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Version of `password_wo`. As Terraform cannot detect changes to write-only attributes, change this value to update the password.",
+				Validators: []validator.Int32{
+					int32validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 		},
 	}
@@ -82,14 +154,15 @@ func (c *credentialResource) Create(
 	req resource.CreateRequest,
 	resp *resource.CreateResponse,
 ) {
-	var plan credentialResourceModel
+	var plan, config credentialResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	opts := dedicatedserver.NewCreateCredentialOpts(
-		plan.Password.ValueString(),
+		plan.password(config),
 		dedicatedserver.CredentialType(plan.Type.ValueString()),
 		plan.Username.ValueString(),
 	)
@@ -106,12 +179,13 @@ func (c *credentialResource) Create(
 	resp.Diagnostics.Append(
 		resp.State.Set(
 			ctx,
-			credentialResourceModel{
-				DedicatedServerID: plan.DedicatedServerID,
-				Type:              types.StringValue(string(result.GetType())),
-				Password:          types.StringValue(result.GetPassword()),
-				Username:          types.StringValue(result.GetUsername()),
-			},
+			adaptCredentialToCredentialResource(
+				plan.DedicatedServerID,
+				string(result.GetType()),
+				result.GetUsername(),
+				result.GetPassword(),
+				plan.PasswordWOVersion,
+			),
 		)...,
 	)
 }
@@ -142,12 +216,13 @@ func (c *credentialResource) Read(
 	resp.Diagnostics.Append(
 		resp.State.Set(
 			ctx,
-			credentialResourceModel{
-				DedicatedServerID: state.DedicatedServerID,
-				Type:              types.StringValue(string(result.GetType())),
-				Password:          types.StringValue(result.GetPassword()),
-				Username:          types.StringValue(result.GetUsername()),
-			},
+			adaptCredentialToCredentialResource(
+				state.DedicatedServerID,
+				string(result.GetType()),
+				result.GetUsername(),
+				result.GetPassword(),
+				state.PasswordWOVersion,
+			),
 		)...,
 	)
 }
@@ -157,14 +232,15 @@ func (c *credentialResource) Update(
 	req resource.UpdateRequest,
 	resp *resource.UpdateResponse,
 ) {
-	var plan credentialResourceModel
+	var plan, config credentialResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	opts := dedicatedserver.NewUpdateCredentialOpts(
-		plan.Password.ValueString(),
+		plan.password(config),
 	)
 	request := c.DedicatedserverAPI.UpdateCredential(
 		ctx,
@@ -181,12 +257,13 @@ func (c *credentialResource) Update(
 	resp.Diagnostics.Append(
 		resp.State.Set(
 			ctx,
-			credentialResourceModel{
-				DedicatedServerID: plan.DedicatedServerID,
-				Type:              types.StringValue(string(result.GetType())),
-				Password:          types.StringValue(result.GetPassword()),
-				Username:          types.StringValue(result.GetUsername()),
-			},
+			adaptCredentialToCredentialResource(
+				plan.DedicatedServerID,
+				string(result.GetType()),
+				result.GetUsername(),
+				result.GetPassword(),
+				plan.PasswordWOVersion,
+			),
 		)...,
 	)
 }
@@ -0,0 +1,185 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &credentialsDataSource{}
+	_ datasource.DataSourceWithConfigure = &credentialsDataSource{}
+)
+
+type credentialsDataSource struct {
+	utils.DataSourceAPI
+}
+
+type credentialsCredentialDataSourceModel struct {
+	Type     types.String `tfsdk:"type"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+type credentialsDataSourceModel struct {
+	DedicatedServerID types.String                           `tfsdk:"dedicated_server_id"`
+	Type              types.String                           `tfsdk:"type"`
+	Credentials       []credentialsCredentialDataSourceModel `tfsdk:"credentials"`
+}
+
+func adaptCredentialToCredentialsCredentialDataSource(
+	credential dedicatedserver.Credential,
+) credentialsCredentialDataSourceModel {
+	return credentialsCredentialDataSourceModel{
+		Type:     types.StringValue(string(credential.GetType())),
+		Username: types.StringValue(credential.GetUsername()),
+		Password: types.StringValue(credential.GetPassword()),
+	}
+}
+
+func (c *credentialsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		DeprecationMessage: "This data source stores the passwords in the Terraform state. " +
+			"Use the leaseweb_dedicated_server_credential ephemeral resource instead, which does not.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Description: "The ID of a server",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only credentials of this type. Valid options are " + utils.StringTypeArrayToMarkdown(dedicatedserver.AllowedCredentialTypeEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(dedicatedserver.AllowedCredentialTypeEnumValues)...),
+				},
+			},
+			"credentials": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the credential",
+						},
+						"username": schema.StringAttribute{
+							Computed:    true,
+							Description: "The username for the credentials",
+						},
+						"password": schema.StringAttribute{
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The password for the credentials",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *credentialsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config credentialsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.DedicatedServerID.ValueString()
+
+	credentials, response, err := c.getCredentialList(ctx, serverID, config.Type)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	// The list does not contain the passwords, those are retrieved one by one.
+	config.Credentials = []credentialsCredentialDataSourceModel{}
+	for _, credentialWithoutPassword := range credentials {
+		credential, response, err := c.DedicatedserverAPI.GetCredential(
+			ctx,
+			serverID,
+			credentialWithoutPassword.GetType(),
+			credentialWithoutPassword.GetUsername(),
+		).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+
+		config.Credentials = append(
+			config.Credentials,
+			adaptCredentialToCredentialsCredentialDataSource(*credential),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (c *credentialsDataSource) getCredentialList(
+	ctx context.Context,
+	serverID string,
+	credentialType types.String,
+) ([]dedicatedserver.CredentialWithoutPassword, *http.Response, error) {
+	var credentials []dedicatedserver.CredentialWithoutPassword
+	var offset int32
+
+	for {
+		var result *dedicatedserver.CredentialList
+		var response *http.Response
+		var err error
+
+		if credentialType.IsNull() {
+			result, response, err = c.DedicatedserverAPI.
+				GetCredentialList(ctx, serverID).
+				Offset(offset).
+				Execute()
+		} else {
+			result, response, err = c.DedicatedserverAPI.
+				GetCredentialListByType(ctx, serverID, dedicatedserver.CredentialType(credentialType.ValueString())).
+				Offset(offset).
+				Execute()
+		}
+		if err != nil {
+			return nil, response, err
+		}
+
+		credentials = append(credentials, result.GetCredentials()...)
+
+		metadata := result.GetMetadata()
+
+		newOffset := utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+
+		if newOffset == nil {
+			return credentials, response, nil
+		}
+
+		offset = *newOffset
+	}
+}
+
+func NewCredentialsDataSource() datasource.DataSource {
+	return &credentialsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_credentials",
+		},
+	}
+}
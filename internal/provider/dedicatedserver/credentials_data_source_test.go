@@ -0,0 +1,22 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptCredentialToCredentialsCredentialDataSource(t *testing.T) {
+	got := adaptCredentialToCredentialsCredentialDataSource(
+		dedicatedserver.Credential{
+			Type:     dedicatedserver.CREDENTIALTYPE_REMOTE_MANAGEMENT,
+			Username: "admin",
+			Password: "secret",
+		},
+	)
+
+	assert.Equal(t, "REMOTE_MANAGEMENT", got.Type.ValueString())
+	assert.Equal(t, "admin", got.Username.ValueString())
+	assert.Equal(t, "secret", got.Password.ValueString())
+}
@@ -0,0 +1,104 @@
+package dedicatedserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure      = &datatrafficMetricsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &datatrafficMetricsDataSource{}
+)
+
+const datatrafficAggregationSum = "SUM"
+
+var datatrafficGranularities = []string{"DAY", "WEEK", "MONTH", "YEAR"}
+
+type datatrafficMetricsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (d *datatrafficMetricsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	aggregations := []string{datatrafficAggregationSum}
+
+	attributes := metricsSchemaAttributes("The unit of the values, e.g. `B`")
+	attributes["granularity"] = granularityAttribute(datatrafficGranularities)
+	attributes["aggregation"] = schema.StringAttribute{
+		Optional: true,
+		Description: "How the values are aggregated, defaults to `SUM`. Valid options are " + utils.StringTypeArrayToMarkdown(
+			aggregations,
+		),
+		Validators: []validator.String{
+			stringvalidator.OneOf(aggregations...),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Description: "Reports the datatraffic of the public interface of a dedicated server over an interval.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *datatrafficMetricsDataSource) ValidateConfig(
+	ctx context.Context,
+	request datasource.ValidateConfigRequest,
+	response *datasource.ValidateConfigResponse,
+) {
+	validateMetricsInterval(ctx, request.Config, &response.Diagnostics)
+}
+
+func (d *datatrafficMetricsDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config metricsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	from, to := parseMetricsInterval(config, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	aggregation := datatrafficAggregationSum
+	if !config.Aggregation.IsNull() {
+		aggregation = config.Aggregation.ValueString()
+	}
+
+	metricsRequest := d.DedicatedserverAPI.GetDataTrafficMetrics(
+		ctx,
+		config.DedicatedServerID.ValueString(),
+	).From(from).To(to).Aggregation(aggregation)
+	if !config.Granularity.IsNull() {
+		metricsRequest = metricsRequest.Granularity(config.Granularity.ValueString())
+	}
+
+	metrics, httpResponse, err := metricsRequest.Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptMetricsToMetricsDataSource(*metrics, config)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func NewDatatrafficMetricsDataSource() datasource.DataSource {
+	return &datatrafficMetricsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_datatraffic_metrics",
+		},
+	}
+}
@@ -0,0 +1,226 @@
+package dedicatedserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &dhcpLeaseResource{}
+	_ resource.ResourceWithConfigure   = &dhcpLeaseResource{}
+	_ resource.ResourceWithImportState = &dhcpLeaseResource{}
+)
+
+type dhcpLeaseResource struct {
+	utils.ResourceAPI
+}
+
+type dhcpLeaseResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	Bootfile          types.String `tfsdk:"bootfile"`
+	Hostname          types.String `tfsdk:"hostname"`
+	IP                types.String `tfsdk:"ip"`
+	MAC               types.String `tfsdk:"mac"`
+}
+
+func adaptLeaseToDHCPLeaseResource(
+	lease dedicatedserver.Lease,
+	dedicatedServerID types.String,
+) dhcpLeaseResourceModel {
+	return dhcpLeaseResourceModel{
+		DedicatedServerID: dedicatedServerID,
+		Bootfile:          types.StringValue(lease.GetBootfile()),
+		Hostname:          types.StringPointerValue(lease.Hostname),
+		IP:                types.StringPointerValue(lease.Ip),
+		MAC:               types.StringPointerValue(lease.Mac),
+	}
+}
+
+func NewDHCPLeaseResource() resource.Resource {
+	return &dhcpLeaseResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_dhcp_lease",
+		},
+	}
+}
+
+func (d *dhcpLeaseResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	warningError := "**WARNING!** Changing this value once running will cause this lease to be removed and a new one to be created."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DHCP lease of a dedicated server, which makes the server PXE boot from `bootfile`, e.g. to install a custom operating system. Destroying the resource removes the lease. Do not also set `dhcp_lease` on the `leaseweb_dedicated_server` resource of the same server.\n\n",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bootfile": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of the PXE boot file, e.g. an iPXE script.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The hostname handed out with the lease.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Computed:    true,
+				Description: "The IP address handed out with the lease.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mac": schema.StringAttribute{
+				Computed:    true,
+				Description: "The MAC address the lease is handed out to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (d *dhcpLeaseResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan dhcpLeaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := dedicatedserver.NewCreateDhcpReservationOpts(plan.Bootfile.ValueString())
+	opts.Hostname = plan.Hostname.ValueStringPointer()
+
+	response, err := d.DedicatedserverAPI.CreateDhcpReservation(
+		ctx,
+		plan.DedicatedServerID.ValueString(),
+	).CreateDhcpReservationOpts(*opts).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	lease := d.readLease(ctx, plan.DedicatedServerID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lease == nil {
+		utils.ReportError("the DHCP lease was not found after creating it", &resp.Diagnostics)
+		return
+	}
+
+	state := adaptLeaseToDHCPLeaseResource(*lease, plan.DedicatedServerID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (d *dhcpLeaseResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state dhcpLeaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lease := d.readLease(ctx, state.DedicatedServerID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The lease has been removed outside of Terraform.
+	if lease == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := adaptLeaseToDHCPLeaseResource(*lease, state.DedicatedServerID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+// Update is never called, as every configurable attribute requires
+// replacement.
+func (d *dhcpLeaseResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+func (d *dhcpLeaseResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state dhcpLeaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := d.DedicatedserverAPI.DeleteDhcpReservation(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+	}
+}
+
+func (d *dhcpLeaseResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("dedicated_server_id"), req, resp)
+}
+
+// readLease returns the DHCP lease of the server, or nil if it has none.
+func (d *dhcpLeaseResource) readLease(
+	ctx context.Context,
+	dedicatedServerID types.String,
+	diags *diag.Diagnostics,
+) *dedicatedserver.Lease {
+	result, response, err := d.DedicatedserverAPI.GetDhcpReservationList(
+		ctx,
+		dedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, diags, err, response)
+		return nil
+	}
+
+	leases := result.GetLeases()
+	if len(leases) == 0 {
+		return nil
+	}
+
+	return &leases[0]
+}
@@ -0,0 +1,42 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptLeaseToDHCPLeaseResource(t *testing.T) {
+	t.Run("all attributes are adapted", func(t *testing.T) {
+		lease := dedicatedserver.Lease{
+			Bootfile: dedicatedserver.PtrString("http://mirror.leaseweb.com/ipxe-files/ubuntu-18.04.ipxe"),
+			Hostname: dedicatedserver.PtrString("my-server"),
+			Ip:       dedicatedserver.PtrString("192.168.0.100"),
+			Mac:      dedicatedserver.PtrString("AA:BB:CC:DD:EE:FF"),
+		}
+
+		got := adaptLeaseToDHCPLeaseResource(lease, types.StringValue("12345"))
+
+		assert.Equal(t, "12345", got.DedicatedServerID.ValueString())
+		assert.Equal(
+			t,
+			"http://mirror.leaseweb.com/ipxe-files/ubuntu-18.04.ipxe",
+			got.Bootfile.ValueString(),
+		)
+		assert.Equal(t, "my-server", got.Hostname.ValueString())
+		assert.Equal(t, "192.168.0.100", got.IP.ValueString())
+		assert.Equal(t, "AA:BB:CC:DD:EE:FF", got.MAC.ValueString())
+	})
+
+	t.Run("missing hostname is null", func(t *testing.T) {
+		lease := dedicatedserver.Lease{
+			Bootfile: dedicatedserver.PtrString("http://mirror.leaseweb.com/ipxe-files/ubuntu-18.04.ipxe"),
+		}
+
+		got := adaptLeaseToDHCPLeaseResource(lease, types.StringValue("12345"))
+
+		assert.True(t, got.Hostname.IsNull())
+	})
+}
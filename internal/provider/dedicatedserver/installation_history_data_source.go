@@ -0,0 +1,242 @@
+package dedicatedserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &installationHistoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &installationHistoryDataSource{}
+)
+
+type installationHistoryDataSource struct {
+	utils.DataSourceAPI
+}
+
+type installationHistoryInstallationDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	OperatingSystemID   types.String `tfsdk:"operating_system_id"`
+	OperatingSystemName types.String `tfsdk:"operating_system_name"`
+	Hostname            types.String `tfsdk:"hostname"`
+	Status              types.String `tfsdk:"status"`
+	IsRunning           types.Bool   `tfsdk:"is_running"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+}
+
+type installationHistoryDataSourceModel struct {
+	DedicatedServerID types.String                                     `tfsdk:"dedicated_server_id"`
+	FromDate          types.String                                     `tfsdk:"from_date"`
+	ToDate            types.String                                     `tfsdk:"to_date"`
+	Installations     []installationHistoryInstallationDataSourceModel `tfsdk:"installations"`
+}
+
+func adaptServerJobToInstallationHistoryInstallationDataSource(
+	job dedicatedserver.ServerJob,
+) installationHistoryInstallationDataSourceModel {
+	installation := installationHistoryInstallationDataSourceModel{
+		ID:                  basetypes.NewStringValue(job.GetUuid()),
+		OperatingSystemID:   basetypes.NewStringNull(),
+		OperatingSystemName: basetypes.NewStringNull(),
+		Hostname:            basetypes.NewStringNull(),
+		Status:              basetypes.NewStringPointerValue(job.Status),
+		IsRunning:           basetypes.NewBoolPointerValue(job.IsRunning),
+		CreatedAt:           utils.AdaptNullableTimeToStringValue(job.CreatedAt),
+		UpdatedAt:           utils.AdaptNullableTimeToStringValue(job.UpdatedAt),
+	}
+
+	if payload, ok := job.GetPayloadOk(); ok {
+		installation.OperatingSystemID = basetypes.NewStringPointerValue(payload.OperatingSystemId)
+		installation.Hostname = basetypes.NewStringPointerValue(payload.Hostname)
+		if os, ok := payload.GetOsOk(); ok {
+			installation.OperatingSystemName = basetypes.NewStringPointerValue(os.Name)
+		}
+	}
+
+	return installation
+}
+
+// isInTimeRange reports whether the job was created within the given range.
+// Either boundary may be nil to leave that side of the range open.
+func isInTimeRange(job dedicatedserver.ServerJob, from *time.Time, to *time.Time) bool {
+	if from == nil && to == nil {
+		return true
+	}
+
+	createdAt, ok := job.GetCreatedAtOk()
+	if !ok {
+		return false
+	}
+	if from != nil && createdAt.Before(*from) {
+		return false
+	}
+	if to != nil && createdAt.After(*to) {
+		return false
+	}
+
+	return true
+}
+
+func parseDate(
+	attribute string,
+	value types.String,
+	diags *diag.Diagnostics,
+) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid date",
+			fmt.Sprintf(
+				"The date must be specified using the RFC3339 format (`yyyy-mm-ddThh:mm:ssZ`). Got: %q",
+				value.ValueString(),
+			),
+		)
+		return nil
+	}
+
+	return &date
+}
+
+func (i *installationHistoryDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the installations and reinstallations of a dedicated server.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Description: "The ID of a server",
+				Required:    true,
+			},
+			"from_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only installations started at or after this date and time, in the RFC3339 format",
+			},
+			"to_date": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only installations started at or before this date and time, in the RFC3339 format",
+			},
+			"installations": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the installation job",
+						},
+						"operating_system_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the installed operating system",
+						},
+						"operating_system_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the installed operating system",
+						},
+						"hostname": schema.StringAttribute{
+							Computed:    true,
+							Description: "The hostname the server was installed with",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The outcome of the installation, e.g. `FINISHED`, `FAILED` or `CANCELED`",
+						},
+						"is_running": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the installation is still running",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time when the installation started",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time when the installation was last updated",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (i *installationHistoryDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config installationHistoryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from := parseDate("from_date", config.FromDate, &resp.Diagnostics)
+	to := parseDate("to_date", config.ToDate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := i.DedicatedserverAPI.
+		GetJobList(ctx, config.DedicatedServerID.ValueString()).
+		Type_(string(dedicatedserver.JOBTYPE_INSTALL))
+
+	config.Installations = []installationHistoryInstallationDataSourceModel{}
+	for {
+		result, response, err := request.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+
+		for _, job := range result.GetJobs() {
+			if !isInTimeRange(job, from, to) {
+				continue
+			}
+			config.Installations = append(
+				config.Installations,
+				adaptServerJobToInstallationHistoryInstallationDataSource(job),
+			)
+		}
+
+		metadata := result.GetMetadata()
+
+		offset := utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+
+		if offset == nil {
+			break
+		}
+
+		request = request.Offset(*offset)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func NewInstallationHistoryDataSource() datasource.DataSource {
+	return &installationHistoryDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_installation_history",
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package dedicatedserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptServerJobToInstallationHistoryInstallationDataSource(t *testing.T) {
+	t.Run("expected values are returned", func(t *testing.T) {
+		uuid := "bcf2bedf-8450-4b22-86a8-f30aeb3a38f9"
+		status := "FINISHED"
+		isRunning := false
+		createdAt, _ := time.Parse(time.RFC3339, "2018-01-09T10:38:12Z")
+		operatingSystemID := "UBUNTU_22_04_64BIT"
+		operatingSystemName := "Ubuntu 22.04 LTS (Jammy Jellyfish) (amd64)"
+		hostname := "server.leaseweb.com"
+
+		got := adaptServerJobToInstallationHistoryInstallationDataSource(
+			dedicatedserver.ServerJob{
+				Uuid:      &uuid,
+				Status:    &status,
+				IsRunning: &isRunning,
+				CreatedAt: &createdAt,
+				Payload: &dedicatedserver.ServerJobPayload{
+					OperatingSystemId: &operatingSystemID,
+					Hostname:          &hostname,
+					Os: &dedicatedserver.Os{
+						Name: &operatingSystemName,
+					},
+				},
+			},
+		)
+
+		assert.Equal(t, uuid, got.ID.ValueString())
+		assert.Equal(t, "FINISHED", got.Status.ValueString())
+		assert.False(t, got.IsRunning.ValueBool())
+		assert.Equal(t, "2018-01-09 10:38:12 +0000 UTC", got.CreatedAt.ValueString())
+		assert.True(t, got.UpdatedAt.IsNull())
+		assert.Equal(t, operatingSystemID, got.OperatingSystemID.ValueString())
+		assert.Equal(t, operatingSystemName, got.OperatingSystemName.ValueString())
+		assert.Equal(t, hostname, got.Hostname.ValueString())
+	})
+
+	t.Run("payload fields are null if the payload is not set", func(t *testing.T) {
+		got := adaptServerJobToInstallationHistoryInstallationDataSource(
+			dedicatedserver.ServerJob{},
+		)
+
+		assert.True(t, got.OperatingSystemID.IsNull())
+		assert.True(t, got.OperatingSystemName.IsNull())
+		assert.True(t, got.Hostname.IsNull())
+	})
+}
+
+func Test_isInTimeRange(t *testing.T) {
+	createdAt, _ := time.Parse(time.RFC3339, "2018-01-09T10:38:12Z")
+	before, _ := time.Parse(time.RFC3339, "2018-01-01T00:00:00Z")
+	after, _ := time.Parse(time.RFC3339, "2018-02-01T00:00:00Z")
+	job := dedicatedserver.ServerJob{CreatedAt: &createdAt}
+
+	t.Run("all jobs match without a range", func(t *testing.T) {
+		assert.True(t, isInTimeRange(dedicatedserver.ServerJob{}, nil, nil))
+	})
+
+	t.Run("jobs within the range match", func(t *testing.T) {
+		assert.True(t, isInTimeRange(job, &before, &after))
+		assert.True(t, isInTimeRange(job, &createdAt, &createdAt))
+	})
+
+	t.Run("jobs outside of the range do not match", func(t *testing.T) {
+		assert.False(t, isInTimeRange(job, &after, nil))
+		assert.False(t, isInTimeRange(job, nil, &before))
+	})
+
+	t.Run("jobs without a creation date do not match a range", func(t *testing.T) {
+		assert.False(t, isInTimeRange(dedicatedserver.ServerJob{}, &before, nil))
+	})
+}
+
+func Test_parseDate(t *testing.T) {
+	t.Run("null values are ignored", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		assert.Nil(t, parseDate("from_date", basetypes.NewStringNull(), &diags))
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("invalid dates return an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		assert.Nil(t, parseDate("from_date", basetypes.NewStringValue("yesterday"), &diags))
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("valid dates are parsed", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		got := parseDate("from_date", basetypes.NewStringValue("2018-01-09T10:38:12Z"), &diags)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, 2018, got.Year())
+	})
+}
@@ -3,12 +3,12 @@ package dedicatedserver
 import (
 	"context"
 	"encoding/base64"
-	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/cenkalti/backoff/v5"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -28,6 +28,13 @@ import (
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
 
+const (
+	swapFilesystem = "swap"
+
+	defaultInstallTimeout      = 60 * time.Minute
+	defaultInstallPollInterval = 30 * time.Second
+)
+
 var (
 	_ resource.ResourceWithConfigure   = &installationResource{}
 	_ resource.ResourceWithImportState = &installationResource{}
@@ -59,7 +66,11 @@ type installationResourceModel struct {
 	PowerCycle        types.Bool     `tfsdk:"power_cycle"`
 	Raid              types.Object   `tfsdk:"raid"`
 	SSHKeys           []types.String `tfsdk:"ssh_keys"`
+	SwapSize          types.Int32    `tfsdk:"swap_size"`
 	Timezone          types.String   `tfsdk:"timezone"`
+	InstallTimeout    types.String   `tfsdk:"install_timeout"`
+	PollInterval      types.String   `tfsdk:"install_poll_interval"`
+	Status            types.String   `tfsdk:"status"`
 }
 
 type raidResourceModel struct {
@@ -158,6 +169,13 @@ func (i *installationResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: "Status of the installation job, `FINISHED` once the operating system has been installed",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"dedicated_server_id": schema.StringAttribute{
 				Description: "The ID of a server",
 				Required:    true,
@@ -226,6 +244,20 @@ func (i *installationResource) Schema(
 				},
 			},
 			"raid": raid(),
+			"install_timeout": schema.StringAttribute{
+				Description: "How long to wait for the installation to finish, as a duration string such as \"90m\". Changing this value does not reinstall the operating system. Defaults to \"60m\".",
+				Optional:    true,
+				Validators: []validator.String{
+					utils.DurationValidator(),
+				},
+			},
+			"install_poll_interval": schema.StringAttribute{
+				Description: "How often the installation job is checked while waiting for it to finish, as a duration string such as \"1m\". Checks that fail with a gateway or network error are retried at this interval until `install_timeout` is reached. Changing this value does not reinstall the operating system. Defaults to \"30s\".",
+				Optional:    true,
+				Validators: []validator.String{
+					utils.DurationValidator(),
+				},
+			},
 			"ssh_keys": schema.SetAttribute{
 				Description: "List of public sshKeys to be setup in your installation",
 				Optional:    true,
@@ -234,6 +266,16 @@ func (i *installationResource) Schema(
 					setplanmodifier.RequiresReplace(),
 				},
 			},
+			"swap_size": schema.Int32Attribute{
+				Description: "Size of the swap partition in MB. A swap partition of this size is added to the installation and must not also be defined in `partitions`. Changing this value reinstalls the operating system.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.Between(128, 131072),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
 			"timezone": schema.StringAttribute{
 				Description: "Timezone represented as Geographical_Area/City",
 				Optional:    true,
@@ -283,6 +325,15 @@ func (i *installationResource) Create(
 				continue
 			}
 
+			if p.Filesystem.ValueString() == swapFilesystem && !plan.SwapSize.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("swap_size"),
+					"Conflicting swap configuration",
+					"The swap partition is configured by swap_size and must not also be defined in partitions.",
+				)
+				return
+			}
+
 			partitions = append(partitions, dedicatedserver.Partition{
 				Filesystem: utils.AdaptStringPointerValueToNullableString(p.Filesystem),
 				Size:       utils.AdaptStringPointerValueToNullableString(p.Size),
@@ -292,6 +343,15 @@ func (i *installationResource) Create(
 
 	}
 
+	if !plan.SwapSize.IsNull() && !plan.SwapSize.IsUnknown() {
+		filesystem := swapFilesystem
+		size := strconv.Itoa(int(plan.SwapSize.ValueInt32()))
+		partitions = append(partitions, dedicatedserver.Partition{
+			Filesystem: &filesystem,
+			Size:       &size,
+		})
+	}
+
 	// Preparing RAID configuration for the installation options
 	var raid *dedicatedserver.Raid
 	// Check that at least one RAID field is set before initializing the RAID struct.
@@ -339,12 +399,23 @@ func (i *installationResource) Create(
 		return
 	}
 
-	job, err := i.waitForJobAndRetrieveUntilFinished(serverID, installationJob.GetUuid(), ctx, resp)
+	timeout := parseDuration(plan.InstallTimeout, defaultInstallTimeout)
+	interval := parseDuration(plan.PollInterval, defaultInstallPollInterval)
+	jobID := installationJob.GetUuid()
+	job, err := waitForJob(
+		ctx,
+		func(ctx context.Context) (*dedicatedserver.CurrentJob, *http.Response, error) {
+			return i.DedicatedserverAPI.GetJob(ctx, serverID, jobID).Execute()
+		},
+		interval,
+		timeout,
+	)
 	if err != nil {
 		utils.ReportError(err.Error(), &resp.Diagnostics)
 		return
 	}
 	plan.ID = types.StringValue(job.GetUuid())
+	plan.Status = types.StringValue(job.GetStatus())
 
 	diags := i.syncResourceModelWithSDK(&plan, job.GetPayload(), ctx)
 	resp.Diagnostics.Append(diags...)
@@ -393,6 +464,7 @@ func (i *installationResource) Read(
 	}
 	job := jobs[0]
 	state.ID = types.StringValue(job.GetUuid())
+	state.Status = types.StringValue(job.GetStatus())
 
 	diags := i.syncResourceModelWithSDK(&state, job.GetPayload(), ctx)
 	resp.Diagnostics.Append(diags...)
@@ -403,11 +475,20 @@ func (i *installationResource) Read(
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
+// Update only stores the waiting settings, every other change reinstalls the
+// operating system.
 func (i *installationResource) Update(
-	_ context.Context,
-	_ resource.UpdateRequest,
-	_ *resource.UpdateResponse,
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
 ) {
+	var plan installationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (i *installationResource) Delete(
@@ -417,50 +498,78 @@ func (i *installationResource) Delete(
 ) {
 }
 
-func (i *installationResource) getJob(serverID, jobID string, ctx context.Context, resp *resource.CreateResponse) *dedicatedserver.CurrentJob {
-	// Fetch the job
-	result, response, err := i.DedicatedserverAPI.GetJob(ctx, serverID, jobID).Execute()
+// parseDuration returns the duration of value, or fallback if it is not set.
+// The value has already been validated by the schema.
+func parseDuration(value types.String, fallback time.Duration) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+
+	parsed, err := time.ParseDuration(value.ValueString())
 	if err != nil {
-		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return fallback
 	}
 
-	return result
+	return parsed
 }
 
-// waitForJobAndRetrieveUntilFinished handles polling with retry and timeout.
-func (i *installationResource) waitForJobAndRetrieveUntilFinished(serverID, jobID string, ctx context.Context, resp *resource.CreateResponse) (*dedicatedserver.CurrentJob, error) {
-	var job *dedicatedserver.CurrentJob
-	// Create a constant backoff with a 30-second retry interval
-	bo := backoff.NewConstantBackOff(30 * time.Second)
-
-	// Set the retry limit to 120 retries (60 minutes total)
-	retryCount := 0
-	maxRetries := 120
-
-	// Start polling and retrying
-	for {
-		if retryCount >= maxRetries {
-			return nil, errors.New("timed out waiting for job to finish after 60 minutes")
-		}
-
-		// Call the function to get job
-		job = i.getJob(serverID, jobID, ctx, resp)
-
-		// check if the job is finished
-		if job.GetStatus() == "FINISHED" {
-			// Job is finished, exit the loop
-			return job, nil
-		}
+// waitForJob polls the job with getJob until it is finished, logging its
+// progress.
+func waitForJob(
+	ctx context.Context,
+	getJob func(ctx context.Context) (*dedicatedserver.CurrentJob, *http.Response, error),
+	interval time.Duration,
+	timeout time.Duration,
+) (*dedicatedserver.CurrentJob, error) {
+	return utils.PollUntilDone(
+		ctx,
+		"job",
+		getJob,
+		func(job dedicatedserver.CurrentJob) (bool, error) {
+			switch job.GetStatus() {
+			case "FINISHED":
+				return true, nil
+			case "FAILED", "CANCELED":
+				return false, newJobError(job)
+			}
+			return false, nil
+		},
+		func(job dedicatedserver.CurrentJob) map[string]any {
+			progress := job.GetProgress()
+			return map[string]any{
+				"job_id":     job.GetUuid(),
+				"status":     job.GetStatus(),
+				"percentage": progress.GetPercentage(),
+			}
+		},
+		interval,
+		timeout,
+	)
+}
 
-		if job.GetStatus() == "FAILED" {
-			// Job has failed, return an error
-			return nil, fmt.Errorf("job %s for server %s has failed or was canceled", jobID, serverID)
+// newJobError describes a job that has failed or was canceled, along with the
+// errors of its tasks.
+func newJobError(job dedicatedserver.CurrentJob) error {
+	var taskErrors []string
+	for _, task := range job.GetTasks() {
+		errorMessage := task.GetErrorMessage()
+		if errorMessage == "" {
+			continue
 		}
+		taskErrors = append(taskErrors, fmt.Sprintf("%s: %s", task.GetDescription(), errorMessage))
+	}
 
-		// Sleep for the backoff interval before retrying
-		time.Sleep(bo.NextBackOff())
-		retryCount++
+	err := fmt.Errorf(
+		"job %s for server %s has failed or was canceled with status %s",
+		job.GetUuid(),
+		job.GetServerId(),
+		job.GetStatus(),
+	)
+	if len(taskErrors) == 0 {
+		return err
 	}
+
+	return fmt.Errorf("%w: %s", err, strings.Join(taskErrors, "; "))
 }
 
 func (i *installationResource) syncResourceModelWithSDK(
@@ -484,6 +593,15 @@ func (i *installationResource) syncResourceModelWithSDK(
 	// Preparing and converting partitions into types.Object to store in the state
 	var partitionsObjects []attr.Value
 	for _, p := range payload.GetPartitions() {
+		// A swap partition managed by swap_size is not part of partitions.
+		if p.GetFilesystem() == swapFilesystem && !state.SwapSize.IsNull() {
+			size, err := strconv.ParseInt(p.GetSize(), 10, 32)
+			if err == nil {
+				state.SwapSize = types.Int32Value(int32(size))
+			}
+			continue
+		}
+
 		partition := partitionsResourceModel{
 			Filesystem: types.StringValue(p.GetFilesystem()),
 			Mountpoint: types.StringValue(p.GetMountpoint()),
@@ -0,0 +1,101 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestJob(status string) *dedicatedserver.CurrentJob {
+	return &dedicatedserver.CurrentJob{
+		Uuid:     dedicatedserver.PtrString("jobId"),
+		ServerId: dedicatedserver.PtrString("serverId"),
+		Status:   dedicatedserver.PtrString(status),
+	}
+}
+
+func Test_parseDuration(t *testing.T) {
+	t.Run("returns the fallback if the value is not set", func(t *testing.T) {
+		got := parseDuration(basetypes.NewStringNull(), time.Minute)
+
+		assert.Equal(t, time.Minute, got)
+	})
+
+	t.Run("returns the parsed value", func(t *testing.T) {
+		got := parseDuration(basetypes.NewStringValue("90m"), time.Minute)
+
+		assert.Equal(t, 90*time.Minute, got)
+	})
+}
+
+func Test_waitForJob(t *testing.T) {
+	t.Run("waits for a long running installation to finish", func(t *testing.T) {
+		polls := 0
+		getJob := func(_ context.Context) (*dedicatedserver.CurrentJob, *http.Response, error) {
+			polls++
+			if polls < 50 {
+				return newTestJob("ACTIVE"), nil, nil
+			}
+			return newTestJob("FINISHED"), nil, nil
+		}
+
+		job, err := waitForJob(context.TODO(), getJob, time.Millisecond, time.Minute)
+
+		require.NoError(t, err)
+		assert.Equal(t, "FINISHED", job.GetStatus())
+		assert.Equal(t, 50, polls)
+	})
+
+	t.Run("returns an error when the job fails", func(t *testing.T) {
+		getJob := func(_ context.Context) (*dedicatedserver.CurrentJob, *http.Response, error) {
+			return newTestJob("FAILED"), nil, nil
+		}
+
+		_, err := waitForJob(context.TODO(), getJob, time.Millisecond, time.Minute)
+
+		assert.ErrorContains(t, err, "job jobId for server serverId has failed or was canceled with status FAILED")
+	})
+
+	t.Run("returns the errors of the failed tasks", func(t *testing.T) {
+		getJob := func(_ context.Context) (*dedicatedserver.CurrentJob, *http.Response, error) {
+			job := newTestJob("FAILED")
+			job.Tasks = []dedicatedserver.Task{
+				{
+					Description: dedicatedserver.PtrString("Power cycle the server"),
+					Status:      dedicatedserver.PtrString("FINISHED"),
+				},
+				{
+					Description:  dedicatedserver.PtrString("Partition the disks"),
+					ErrorMessage: *dedicatedserver.NewNullableString(dedicatedserver.PtrString("disk not found")),
+					Status:       dedicatedserver.PtrString("FAILED"),
+				},
+			}
+			return job, nil, nil
+		}
+
+		_, err := waitForJob(context.TODO(), getJob, time.Millisecond, time.Minute)
+
+		assert.EqualError(
+			t,
+			err,
+			"job jobId for server serverId has failed or was canceled with status FAILED: Partition the disks: disk not found",
+		)
+	})
+
+	t.Run("times out if the job does not finish", func(t *testing.T) {
+		getJob := func(_ context.Context) (*dedicatedserver.CurrentJob, *http.Response, error) {
+			return newTestJob("ACTIVE"), nil, nil
+		}
+
+		_, err := waitForJob(context.TODO(), getJob, time.Millisecond, 10*time.Millisecond)
+
+		assert.ErrorContains(t, err, "timed out waiting for the job after 10ms")
+	})
+
+}
@@ -0,0 +1,172 @@
+package dedicatedserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &jobDataSource{}
+	_ datasource.DataSourceWithConfigure = &jobDataSource{}
+)
+
+type jobDataSource struct {
+	utils.DataSourceAPI
+}
+
+type jobTaskDataSourceModel struct {
+	Description  types.String `tfsdk:"description"`
+	Status       types.String `tfsdk:"status"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+}
+
+type jobDataSourceModel struct {
+	DedicatedServerID  types.String             `tfsdk:"dedicated_server_id"`
+	ID                 types.String             `tfsdk:"id"`
+	Type               types.String             `tfsdk:"type"`
+	Status             types.String             `tfsdk:"status"`
+	IsRunning          types.Bool               `tfsdk:"is_running"`
+	ProgressPercentage types.Int32              `tfsdk:"progress_percentage"`
+	CreatedAt          types.String             `tfsdk:"created_at"`
+	UpdatedAt          types.String             `tfsdk:"updated_at"`
+	Tasks              []jobTaskDataSourceModel `tfsdk:"tasks"`
+}
+
+func adaptCurrentJobToJobDataSource(
+	job dedicatedserver.CurrentJob,
+	dedicatedServerID types.String,
+) jobDataSourceModel {
+	progressPercentage := basetypes.NewInt32Null()
+	if progress, ok := job.GetProgressOk(); ok {
+		progressPercentage = basetypes.NewInt32PointerValue(progress.Percentage)
+	}
+
+	jobType := basetypes.NewStringNull()
+	if job.Type != nil {
+		jobType = basetypes.NewStringValue(string(job.GetType()))
+	}
+
+	tasks := []jobTaskDataSourceModel{}
+	for _, task := range job.GetTasks() {
+		tasks = append(tasks, jobTaskDataSourceModel{
+			Description:  basetypes.NewStringPointerValue(task.Description),
+			Status:       basetypes.NewStringPointerValue(task.Status),
+			ErrorMessage: basetypes.NewStringPointerValue(task.ErrorMessage.Get()),
+		})
+	}
+
+	return jobDataSourceModel{
+		DedicatedServerID:  dedicatedServerID,
+		ID:                 basetypes.NewStringValue(job.GetUuid()),
+		Type:               jobType,
+		Status:             basetypes.NewStringPointerValue(job.Status),
+		IsRunning:          basetypes.NewBoolPointerValue(job.IsRunning),
+		ProgressPercentage: progressPercentage,
+		CreatedAt:          utils.AdaptNullableTimeToStringValue(job.CreatedAt),
+		UpdatedAt:          utils.AdaptNullableTimeToStringValue(job.UpdatedAt),
+		Tasks:              tasks,
+	}
+}
+
+func (j *jobDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single job of a dedicated server, along with its tasks.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Description: "The ID of a server",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the job",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the job, e.g. `install` or `rescueMode`",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the job",
+			},
+			"is_running": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the job is still running",
+			},
+			"progress_percentage": schema.Int32Attribute{
+				Computed:    true,
+				Description: "How far the job has progressed, in percent",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time when the job was created",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time when the job was last updated",
+			},
+			"tasks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The tasks the job consists of, in the order they run",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "What the task does",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the task",
+						},
+						"error_message": schema.StringAttribute{
+							Computed:    true,
+							Description: "Why the task failed, if it did",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (j *jobDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config jobDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, response, err := j.DedicatedserverAPI.GetJob(
+		ctx,
+		config.DedicatedServerID.ValueString(),
+		config.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	state := adaptCurrentJobToJobDataSource(*job, config.DedicatedServerID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func NewJobDataSource() datasource.DataSource {
+	return &jobDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_job",
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package dedicatedserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptCurrentJobToJobDataSource(t *testing.T) {
+	t.Run("expected values are returned", func(t *testing.T) {
+		createdAt, _ := time.Parse(time.RFC3339, "2021-01-09T10:38:12Z")
+		jobType := dedicatedserver.JOBTYPE_RESCUE_MODE
+
+		got := adaptCurrentJobToJobDataSource(
+			dedicatedserver.CurrentJob{
+				Uuid:      dedicatedserver.PtrString("3a867358-5b4b-44ee-88ac-4274603ef641"),
+				Type:      &jobType,
+				Status:    dedicatedserver.PtrString("FAILED"),
+				IsRunning: dedicatedserver.PtrBool(false),
+				CreatedAt: &createdAt,
+				Progress: &dedicatedserver.Progress{
+					Percentage: dedicatedserver.PtrInt32(50),
+				},
+				Tasks: []dedicatedserver.Task{
+					{
+						Description:  dedicatedserver.PtrString("Boot the rescue image"),
+						Status:       dedicatedserver.PtrString("FAILED"),
+						ErrorMessage: *dedicatedserver.NewNullableString(dedicatedserver.PtrString("no response")),
+					},
+				},
+			},
+			basetypes.NewStringValue("12345"),
+		)
+
+		assert.Equal(t, "12345", got.DedicatedServerID.ValueString())
+		assert.Equal(t, "3a867358-5b4b-44ee-88ac-4274603ef641", got.ID.ValueString())
+		assert.Equal(t, "rescueMode", got.Type.ValueString())
+		assert.Equal(t, "FAILED", got.Status.ValueString())
+		assert.False(t, got.IsRunning.ValueBool())
+		assert.Equal(t, int32(50), got.ProgressPercentage.ValueInt32())
+		assert.Equal(t, "2021-01-09 10:38:12 +0000 UTC", got.CreatedAt.ValueString())
+		assert.True(t, got.UpdatedAt.IsNull())
+		assert.Equal(
+			t,
+			[]jobTaskDataSourceModel{
+				{
+					Description:  basetypes.NewStringValue("Boot the rescue image"),
+					Status:       basetypes.NewStringValue("FAILED"),
+					ErrorMessage: basetypes.NewStringValue("no response"),
+				},
+			},
+			got.Tasks,
+		)
+	})
+
+	t.Run("missing values are null", func(t *testing.T) {
+		got := adaptCurrentJobToJobDataSource(
+			dedicatedserver.CurrentJob{},
+			basetypes.NewStringValue("12345"),
+		)
+
+		assert.True(t, got.Type.IsNull())
+		assert.True(t, got.ProgressPercentage.IsNull())
+		assert.Empty(t, got.Tasks)
+		assert.NotNil(t, got.Tasks)
+	})
+}
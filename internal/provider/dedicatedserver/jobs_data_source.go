@@ -0,0 +1,194 @@
+package dedicatedserver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &jobsDataSource{}
+	_ datasource.DataSourceWithConfigure = &jobsDataSource{}
+)
+
+type jobsDataSource struct {
+	utils.DataSourceAPI
+}
+
+type jobsJobDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Type               types.String `tfsdk:"type"`
+	Status             types.String `tfsdk:"status"`
+	IsRunning          types.Bool   `tfsdk:"is_running"`
+	ProgressPercentage types.Int32  `tfsdk:"progress_percentage"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+type jobsDataSourceModel struct {
+	DedicatedServerID types.String             `tfsdk:"dedicated_server_id"`
+	Type              types.String             `tfsdk:"type"`
+	Status            types.String             `tfsdk:"status"`
+	IsRunning         types.Bool               `tfsdk:"is_running"`
+	Jobs              []jobsJobDataSourceModel `tfsdk:"jobs"`
+}
+
+func adaptServerJobToJobsJobDataSource(job dedicatedserver.ServerJob) jobsJobDataSourceModel {
+	progressPercentage := basetypes.NewInt32Null()
+	if progress, ok := job.GetProgressOk(); ok {
+		progressPercentage = basetypes.NewInt32PointerValue(progress.Percentage)
+	}
+
+	jobType := basetypes.NewStringNull()
+	if job.Type != nil {
+		jobType = basetypes.NewStringValue(string(job.GetType()))
+	}
+
+	return jobsJobDataSourceModel{
+		ID:                 basetypes.NewStringValue(job.GetUuid()),
+		Type:               jobType,
+		Status:             basetypes.NewStringPointerValue(job.Status),
+		IsRunning:          basetypes.NewBoolPointerValue(job.IsRunning),
+		ProgressPercentage: progressPercentage,
+		CreatedAt:          utils.AdaptNullableTimeToStringValue(job.CreatedAt),
+		UpdatedAt:          utils.AdaptNullableTimeToStringValue(job.UpdatedAt),
+	}
+}
+
+func (j *jobsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the jobs of a dedicated server, such as installations, rescue mode launches and IPMI resets.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Description: "The ID of a server",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Optional: true,
+				Description: "Return only jobs of this type. Valid options are " + utils.StringTypeArrayToMarkdown(
+					dedicatedserver.AllowedJobTypeEnumValues,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						utils.AdaptStringTypeArrayToStringArray(dedicatedserver.AllowedJobTypeEnumValues)...,
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only jobs with this status, e.g. `ACTIVE`, `FINISHED`, `FAILED` or `CANCELED`",
+			},
+			"is_running": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Return only jobs that are running, or only jobs that are not",
+			},
+			"jobs": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the job",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the job",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the job",
+						},
+						"is_running": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the job is still running",
+						},
+						"progress_percentage": schema.Int32Attribute{
+							Computed:    true,
+							Description: "How far the job has progressed, in percent",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time when the job was created",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time when the job was last updated",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (j *jobsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config jobsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := j.DedicatedserverAPI.GetJobList(ctx, config.DedicatedServerID.ValueString())
+	if !config.Type.IsNull() && !config.Type.IsUnknown() {
+		request = request.Type_(config.Type.ValueString())
+	}
+	if !config.Status.IsNull() && !config.Status.IsUnknown() {
+		request = request.Status(config.Status.ValueString())
+	}
+	if !config.IsRunning.IsNull() && !config.IsRunning.IsUnknown() {
+		request = request.IsRunning(strconv.FormatBool(config.IsRunning.ValueBool()))
+	}
+
+	config.Jobs = []jobsJobDataSourceModel{}
+	for {
+		result, response, err := request.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+
+		for _, job := range result.GetJobs() {
+			config.Jobs = append(config.Jobs, adaptServerJobToJobsJobDataSource(job))
+		}
+
+		metadata := result.GetMetadata()
+
+		offset := utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+
+		if offset == nil {
+			break
+		}
+
+		request = request.Offset(*offset)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func NewJobsDataSource() datasource.DataSource {
+	return &jobsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_jobs",
+		},
+	}
+}
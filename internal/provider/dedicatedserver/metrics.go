@@ -0,0 +1,189 @@
+package dedicatedserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+type metricValueDataSourceModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	Value     types.Int64  `tfsdk:"value"`
+}
+
+type metricsDataSourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	From              types.String `tfsdk:"from"`
+	To                types.String `tfsdk:"to"`
+	Granularity       types.String `tfsdk:"granularity"`
+	Aggregation       types.String `tfsdk:"aggregation"`
+
+	Unit       types.String                 `tfsdk:"unit"`
+	UpPublic   []metricValueDataSourceModel `tfsdk:"up_public"`
+	DownPublic []metricValueDataSourceModel `tfsdk:"down_public"`
+}
+
+// adaptMetricToMetricValuesDataSource returns an empty list when the API has
+// no values for the requested interval.
+func adaptMetricToMetricValuesDataSource(
+	metric *dedicatedserver.Metric,
+) []metricValueDataSourceModel {
+	values := []metricValueDataSourceModel{}
+
+	for _, metricValue := range metric.GetValues() {
+		value := basetypes.NewInt64Null()
+		if metricValue.Value != nil {
+			value = basetypes.NewInt64Value(int64(metricValue.GetValue()))
+		}
+
+		values = append(values, metricValueDataSourceModel{
+			Timestamp: utils.AdaptNullableTimeToStringValue(metricValue.Timestamp),
+			Value:     value,
+		})
+	}
+
+	return values
+}
+
+// adaptMetricsToMetricsDataSource keeps the arguments of the configuration
+// and adds the metrics of the public interface.
+func adaptMetricsToMetricsDataSource(
+	metrics dedicatedserver.Metrics,
+	config metricsDataSourceModel,
+) metricsDataSourceModel {
+	metricValues := metrics.GetMetrics()
+
+	config.Unit = types.StringNull()
+	if metricValues.UP_PUBLIC != nil && metricValues.UP_PUBLIC.Unit != nil {
+		config.Unit = types.StringValue(metricValues.UP_PUBLIC.GetUnit())
+	} else if metricValues.DOWN_PUBLIC != nil && metricValues.DOWN_PUBLIC.Unit != nil {
+		config.Unit = types.StringValue(metricValues.DOWN_PUBLIC.GetUnit())
+	}
+
+	config.UpPublic = adaptMetricToMetricValuesDataSource(metricValues.UP_PUBLIC)
+	config.DownPublic = adaptMetricToMetricValuesDataSource(metricValues.DOWN_PUBLIC)
+
+	return config
+}
+
+// metricsSchemaAttributes returns the attributes shared by the metrics data
+// sources. The granularity and aggregation attributes differ per metric and
+// are added by the data sources.
+func metricsSchemaAttributes(unitDescription string) map[string]schema.Attribute {
+	valuesAttribute := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			Computed:    true,
+			Description: description,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"timestamp": schema.StringAttribute{
+						Computed: true,
+					},
+					"value": schema.Int64Attribute{
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"dedicated_server_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of a server",
+		},
+		"from": schema.StringAttribute{
+			Required:    true,
+			Description: "The start of the interval, as an RFC 3339 timestamp. The values include the start.",
+			Validators: []validator.String{
+				utils.TimestampValidator(),
+			},
+		},
+		"to": schema.StringAttribute{
+			Required:    true,
+			Description: "The end of the interval, as an RFC 3339 timestamp. The values do not include the end.",
+			Validators: []validator.String{
+				utils.TimestampValidator(),
+			},
+		},
+		"unit":        schema.StringAttribute{Computed: true, Description: unitDescription},
+		"up_public":   valuesAttribute("Outgoing traffic of the public interface"),
+		"down_public": valuesAttribute("Incoming traffic of the public interface"),
+	}
+}
+
+// granularityAttribute returns the granularity attribute for the given
+// options.
+func granularityAttribute(granularities []string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Description: "The interval of each value. If omitted, a single value is returned for the whole interval. Valid options are " + utils.StringTypeArrayToMarkdown(
+			granularities,
+		),
+		Validators: []validator.String{
+			stringvalidator.OneOf(granularities...),
+		},
+	}
+}
+
+// validateMetricsInterval ensures that to is later than from.
+func validateMetricsInterval(
+	ctx context.Context,
+	config tfsdk.Config,
+	diags *diag.Diagnostics,
+) {
+	var from, to types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("from"), &from)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("to"), &to)...)
+	if diags.HasError() {
+		return
+	}
+
+	if from.IsNull() || from.IsUnknown() || to.IsNull() || to.IsUnknown() {
+		return
+	}
+
+	fromTime, err := time.Parse(time.RFC3339, from.ValueString())
+	if err != nil {
+		return
+	}
+	toTime, err := time.Parse(time.RFC3339, to.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !fromTime.Before(toTime) {
+		diags.AddAttributeError(
+			path.Root("to"),
+			"Invalid Interval",
+			"to must be later than from.",
+		)
+	}
+}
+
+// parseMetricsInterval parses the validated from and to attributes.
+func parseMetricsInterval(
+	config metricsDataSourceModel,
+	diags *diag.Diagnostics,
+) (time.Time, time.Time) {
+	from, err := time.Parse(time.RFC3339, config.From.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("from"), "Invalid Timestamp", err.Error())
+	}
+	to, err := time.Parse(time.RFC3339, config.To.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("to"), "Invalid Timestamp", err.Error())
+	}
+
+	return from, to
+}
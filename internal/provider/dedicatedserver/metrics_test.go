@@ -0,0 +1,61 @@
+package dedicatedserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptMetricsToMetricsDataSource(t *testing.T) {
+	t.Run("metrics are adapted", func(t *testing.T) {
+		timestamp, _ := time.Parse(time.RFC3339, "2016-10-20T09:00:00Z")
+		metrics := dedicatedserver.Metrics{
+			Metrics: &dedicatedserver.MetricValues{
+				UP_PUBLIC: &dedicatedserver.Metric{
+					Unit: dedicatedserver.PtrString("bps"),
+					Values: []dedicatedserver.MetricValue{
+						{Timestamp: &timestamp, Value: dedicatedserver.PtrInt32(43212393)},
+					},
+				},
+				DOWN_PUBLIC: &dedicatedserver.Metric{
+					Unit: dedicatedserver.PtrString("bps"),
+					Values: []dedicatedserver.MetricValue{
+						{Timestamp: &timestamp},
+					},
+				},
+			},
+		}
+		config := metricsDataSourceModel{
+			DedicatedServerID: types.StringValue("12345"),
+			Granularity:       types.StringValue("HOUR"),
+		}
+
+		got := adaptMetricsToMetricsDataSource(metrics, config)
+
+		assert.Equal(t, "12345", got.DedicatedServerID.ValueString())
+		assert.Equal(t, "HOUR", got.Granularity.ValueString())
+		assert.Equal(t, "bps", got.Unit.ValueString())
+		assert.Equal(t, []metricValueDataSourceModel{
+			{
+				Timestamp: types.StringValue("2016-10-20 09:00:00 +0000 UTC"),
+				Value:     types.Int64Value(43212393),
+			},
+		}, got.UpPublic)
+		assert.True(t, got.DownPublic[0].Value.IsNull())
+	})
+
+	t.Run("missing metrics are empty", func(t *testing.T) {
+		got := adaptMetricsToMetricsDataSource(
+			dedicatedserver.Metrics{},
+			metricsDataSourceModel{},
+		)
+
+		assert.True(t, got.Unit.IsNull())
+		assert.Empty(t, got.UpPublic)
+		assert.NotNil(t, got.UpPublic)
+		assert.Empty(t, got.DownPublic)
+	})
+}
@@ -0,0 +1,212 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &networkInterfaceResource{}
+	_ resource.ResourceWithConfigure   = &networkInterfaceResource{}
+	_ resource.ResourceWithImportState = &networkInterfaceResource{}
+)
+
+type networkInterfaceResource struct {
+	utils.ResourceAPI
+}
+
+type networkInterfaceResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	Type              types.String `tfsdk:"type"`
+	Opened            types.Bool   `tfsdk:"opened"`
+}
+
+// isNetworkInterfaceOpened reports whether the administrative status of the
+// network interface is open. The API does not use a consistent case for it.
+func isNetworkInterfaceOpened(
+	networkInterface dedicatedserver.OperationNetworkInterface,
+) bool {
+	return strings.EqualFold(networkInterface.GetStatus(), "open")
+}
+
+func NewNetworkInterfaceResource() resource.Resource {
+	return &networkInterfaceResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_network_interface",
+		},
+	}
+}
+
+func (n *networkInterfaceResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	warningError := "**WARNING!** Changing this value once running will cause this resource to be destroyed and a new one to be created."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Opens or closes a network interface of a dedicated server, e.g. to close the public network interface once the server is provisioned. Destroying the resource leaves the network interface in its last state. Do not also set `public_network_interface_opened` on the `leaseweb_dedicated_server` resource when managing its public network interface.\n\n",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The network interface to manage. Valid options are " + utils.StringTypeArrayToMarkdown(dedicatedserver.AllowedNetworkTypeURLEnumValues) + "\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(dedicatedserver.AllowedNetworkTypeURLEnumValues)...),
+				},
+			},
+			"opened": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the network interface is opened or closed.",
+			},
+		},
+	}
+
+	utils.AddUnsupportedActionsNotation(
+		resp,
+		[]utils.Action{utils.DeleteAction},
+	)
+}
+
+func (n *networkInterfaceResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opened, response, err := n.getOpened(ctx, plan)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	// Only open or close the network interface when needed, so adopting it is
+	// harmless.
+	if opened != plan.Opened.ValueBool() {
+		response, err = n.setOpened(ctx, plan)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (n *networkInterfaceResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opened, response, err := n.getOpened(ctx, state)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+	state.Opened = types.BoolValue(opened)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (n *networkInterfaceResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Opened.Equal(state.Opened) {
+		response, err := n.setOpened(ctx, plan)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (n *networkInterfaceResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
+
+func (n *networkInterfaceResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"dedicated_server_id", "type"},
+		req,
+		resp,
+	)
+}
+
+func (n *networkInterfaceResource) getOpened(
+	ctx context.Context,
+	model networkInterfaceResourceModel,
+) (bool, *http.Response, error) {
+	networkInterface, response, err := n.DedicatedserverAPI.GetNetworkInterface(
+		ctx,
+		model.DedicatedServerID.ValueString(),
+		dedicatedserver.NetworkTypeURL(model.Type.ValueString()),
+	).Execute()
+	if err != nil {
+		return false, response, err
+	}
+
+	return isNetworkInterfaceOpened(*networkInterface), response, nil
+}
+
+func (n *networkInterfaceResource) setOpened(
+	ctx context.Context,
+	plan networkInterfaceResourceModel,
+) (*http.Response, error) {
+	serverID := plan.DedicatedServerID.ValueString()
+	networkType := dedicatedserver.NetworkTypeURL(plan.Type.ValueString())
+	if plan.Opened.ValueBool() {
+		return n.DedicatedserverAPI.OpenNetworkInterface(ctx, serverID, networkType).Execute()
+	}
+
+	return n.DedicatedserverAPI.CloseNetworkInterface(ctx, serverID, networkType).Execute()
+}
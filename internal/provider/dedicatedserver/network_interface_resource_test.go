@@ -0,0 +1,34 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isNetworkInterfaceOpened(t *testing.T) {
+	t.Run("network interface is opened if its status is open", func(t *testing.T) {
+		networkInterface := dedicatedserver.OperationNetworkInterface{
+			Status: dedicatedserver.PtrString("OPEN"),
+		}
+
+		assert.True(t, isNetworkInterfaceOpened(networkInterface))
+	})
+
+	t.Run("status is compared case insensitively", func(t *testing.T) {
+		networkInterface := dedicatedserver.OperationNetworkInterface{
+			Status: dedicatedserver.PtrString("open"),
+		}
+
+		assert.True(t, isNetworkInterfaceOpened(networkInterface))
+	})
+
+	t.Run("network interface is closed otherwise", func(t *testing.T) {
+		networkInterface := dedicatedserver.OperationNetworkInterface{
+			Status: dedicatedserver.PtrString("CLOSED"),
+		}
+
+		assert.False(t, isNetworkInterfaceOpened(networkInterface))
+	})
+}
@@ -0,0 +1,38 @@
+package dedicatedserver
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// adaptThresholdToStateValue keeps the configured threshold if the API
+// reports the same number in another notation, e.g. "1.00" for "1", so plans
+// stay empty.
+func adaptThresholdToStateValue(current types.String, threshold string) types.String {
+	if current.IsNull() || current.IsUnknown() {
+		return types.StringValue(threshold)
+	}
+
+	currentValue, err := strconv.ParseFloat(current.ValueString(), 64)
+	if err != nil {
+		return types.StringValue(threshold)
+	}
+	value, err := strconv.ParseFloat(threshold, 64)
+	if err != nil || value != currentValue {
+		return types.StringValue(threshold)
+	}
+
+	return current
+}
+
+// adaptEnumToStateValue keeps the configured value if the API reports it in
+// another case.
+func adaptEnumToStateValue(current types.String, value string) types.String {
+	if !current.IsNull() && !current.IsUnknown() && strings.EqualFold(current.ValueString(), value) {
+		return current
+	}
+
+	return types.StringValue(value)
+}
@@ -15,8 +15,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &notificationSettingBandwidthResource{}
-	_ resource.ResourceWithConfigure = &notificationSettingBandwidthResource{}
+	_ resource.Resource                = &notificationSettingBandwidthResource{}
+	_ resource.ResourceWithConfigure   = &notificationSettingBandwidthResource{}
+	_ resource.ResourceWithImportState = &notificationSettingBandwidthResource{}
 )
 
 type notificationSettingBandwidthResource struct {
@@ -39,6 +40,19 @@ func NewNotificationSettingBandwidthResource() resource.Resource {
 	}
 }
 
+func (n *notificationSettingBandwidthResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"dedicated_server_id", "id"},
+		req,
+		resp,
+	)
+}
+
 func (n *notificationSettingBandwidthResource) Schema(
 	_ context.Context,
 	_ resource.SchemaRequest,
@@ -55,7 +69,10 @@ func (n *notificationSettingBandwidthResource) Schema(
 			},
 			"dedicated_server_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The server unique identifier",
+				Description: "The server unique identifier. Changing it creates the notification setting on the new server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"frequency": schema.StringAttribute{
 				Required:    true,
@@ -113,9 +130,9 @@ func (n *notificationSettingBandwidthResource) Create(
 			ctx,
 			notificationSettingBandwidthResourceModel{
 				ID:                types.StringValue(result.GetId()),
-				Frequency:         types.StringValue(result.GetFrequency()),
-				Threshold:         types.StringValue(result.GetThreshold()),
-				Unit:              types.StringValue(result.GetUnit()),
+				Frequency:         adaptEnumToStateValue(plan.Frequency, result.GetFrequency()),
+				Threshold:         adaptThresholdToStateValue(plan.Threshold, result.GetThreshold()),
+				Unit:              adaptEnumToStateValue(plan.Unit, result.GetUnit()),
 				DedicatedServerID: plan.DedicatedServerID,
 			},
 		)...,
@@ -149,9 +166,9 @@ func (n *notificationSettingBandwidthResource) Read(
 			ctx,
 			notificationSettingBandwidthResourceModel{
 				ID:                types.StringValue(result.GetId()),
-				Frequency:         types.StringValue(result.GetFrequency()),
-				Threshold:         types.StringValue(result.GetThreshold()),
-				Unit:              types.StringValue(result.GetUnit()),
+				Frequency:         adaptEnumToStateValue(state.Frequency, result.GetFrequency()),
+				Threshold:         adaptThresholdToStateValue(state.Threshold, result.GetThreshold()),
+				Unit:              adaptEnumToStateValue(state.Unit, result.GetUnit()),
 				DedicatedServerID: state.DedicatedServerID,
 			},
 		)...,
@@ -191,9 +208,9 @@ func (n *notificationSettingBandwidthResource) Update(
 			notificationSettingBandwidthResourceModel{
 				ID:                plan.ID,
 				DedicatedServerID: plan.DedicatedServerID,
-				Frequency:         types.StringValue(result.GetFrequency()),
-				Threshold:         types.StringValue(result.GetThreshold()),
-				Unit:              types.StringValue(result.GetUnit()),
+				Frequency:         adaptEnumToStateValue(plan.Frequency, result.GetFrequency()),
+				Threshold:         adaptThresholdToStateValue(plan.Threshold, result.GetThreshold()),
+				Unit:              adaptEnumToStateValue(plan.Unit, result.GetUnit()),
 			},
 		)...,
 	)
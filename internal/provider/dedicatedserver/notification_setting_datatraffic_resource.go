@@ -15,8 +15,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &notificationSettingDatatrafficResource{}
-	_ resource.ResourceWithConfigure = &notificationSettingDatatrafficResource{}
+	_ resource.Resource                = &notificationSettingDatatrafficResource{}
+	_ resource.ResourceWithConfigure   = &notificationSettingDatatrafficResource{}
+	_ resource.ResourceWithImportState = &notificationSettingDatatrafficResource{}
 )
 
 type notificationSettingDatatrafficResource struct {
@@ -39,6 +40,19 @@ func NewNotificationSettingDatatrafficResource() resource.Resource {
 	}
 }
 
+func (n *notificationSettingDatatrafficResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"dedicated_server_id", "id"},
+		req,
+		resp,
+	)
+}
+
 func (n *notificationSettingDatatrafficResource) Schema(
 	_ context.Context,
 	_ resource.SchemaRequest,
@@ -55,7 +69,10 @@ func (n *notificationSettingDatatrafficResource) Schema(
 			},
 			"dedicated_server_id": schema.StringAttribute{
 				Required:    true,
-				Description: "The ID of the dedicated server.",
+				Description: "The ID of the dedicated server. Changing it creates the notification setting on the new server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"frequency": schema.StringAttribute{
 				Required:    true,
@@ -114,9 +131,9 @@ func (n *notificationSettingDatatrafficResource) Create(
 			notificationSettingDatatrafficResourceModel{
 				DedicatedServerID: plan.DedicatedServerID,
 				ID:                types.StringValue(result.GetId()),
-				Frequency:         types.StringValue(result.GetFrequency()),
-				Threshold:         types.StringValue(result.GetThreshold()),
-				Unit:              types.StringValue(result.GetUnit()),
+				Frequency:         adaptEnumToStateValue(plan.Frequency, result.GetFrequency()),
+				Threshold:         adaptThresholdToStateValue(plan.Threshold, result.GetThreshold()),
+				Unit:              adaptEnumToStateValue(plan.Unit, result.GetUnit()),
 			},
 		)...,
 	)
@@ -150,9 +167,9 @@ func (n *notificationSettingDatatrafficResource) Read(
 			notificationSettingDatatrafficResourceModel{
 				DedicatedServerID: state.DedicatedServerID,
 				ID:                types.StringValue(result.GetId()),
-				Frequency:         types.StringValue(result.GetFrequency()),
-				Threshold:         types.StringValue(result.GetThreshold()),
-				Unit:              types.StringValue(result.GetUnit()),
+				Frequency:         adaptEnumToStateValue(state.Frequency, result.GetFrequency()),
+				Threshold:         adaptThresholdToStateValue(state.Threshold, result.GetThreshold()),
+				Unit:              adaptEnumToStateValue(state.Unit, result.GetUnit()),
 			},
 		)...,
 	)
@@ -191,9 +208,9 @@ func (n *notificationSettingDatatrafficResource) Update(
 			notificationSettingDatatrafficResourceModel{
 				ID:                plan.ID,
 				DedicatedServerID: plan.DedicatedServerID,
-				Frequency:         types.StringValue(result.GetFrequency()),
-				Threshold:         types.StringValue(result.GetThreshold()),
-				Unit:              types.StringValue(result.GetUnit()),
+				Frequency:         adaptEnumToStateValue(plan.Frequency, result.GetFrequency()),
+				Threshold:         adaptThresholdToStateValue(plan.Threshold, result.GetThreshold()),
+				Unit:              adaptEnumToStateValue(plan.Unit, result.GetUnit()),
 			},
 		)...,
 	)
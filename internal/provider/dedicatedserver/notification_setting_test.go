@@ -0,0 +1,42 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptThresholdToStateValue(t *testing.T) {
+	t.Run("keeps the configured notation of the same number", func(t *testing.T) {
+		got := adaptThresholdToStateValue(types.StringValue("1"), "1.00")
+
+		assert.Equal(t, types.StringValue("1"), got)
+	})
+
+	t.Run("reports a different number", func(t *testing.T) {
+		got := adaptThresholdToStateValue(types.StringValue("1"), "2")
+
+		assert.Equal(t, types.StringValue("2"), got)
+	})
+
+	t.Run("reports the threshold without a current value", func(t *testing.T) {
+		got := adaptThresholdToStateValue(types.StringNull(), "1.00")
+
+		assert.Equal(t, types.StringValue("1.00"), got)
+	})
+}
+
+func Test_adaptEnumToStateValue(t *testing.T) {
+	t.Run("keeps the configured case", func(t *testing.T) {
+		got := adaptEnumToStateValue(types.StringValue("Gbps"), "GBPS")
+
+		assert.Equal(t, types.StringValue("Gbps"), got)
+	})
+
+	t.Run("reports a different value", func(t *testing.T) {
+		got := adaptEnumToStateValue(types.StringValue("DAILY"), "WEEKLY")
+
+		assert.Equal(t, types.StringValue("WEEKLY"), got)
+	})
+}
@@ -0,0 +1,107 @@
+package dedicatedserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &powerDataSource{}
+	_ datasource.DataSourceWithConfigure = &powerDataSource{}
+)
+
+type powerDataSource struct {
+	utils.DataSourceAPI
+}
+
+type powerDataSourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	PowerState        types.String `tfsdk:"power_state"`
+	PDUStatus         types.String `tfsdk:"pdu_status"`
+	IPMIStatus        types.String `tfsdk:"ipmi_status"`
+}
+
+func adaptPowerStatusToPowerDataSource(
+	dedicatedServerID string,
+	powerStatus dedicatedserver.GetPowerStatusResult,
+) powerDataSourceModel {
+	model := powerDataSourceModel{
+		DedicatedServerID: types.StringValue(dedicatedServerID),
+		PowerState:        types.StringValue(adaptPowerStatusToPowerState(powerStatus)),
+		PDUStatus:         types.StringNull(),
+		IPMIStatus:        types.StringNull(),
+	}
+
+	if pdu, ok := powerStatus.GetPduOk(); ok {
+		model.PDUStatus = types.StringPointerValue(pdu.Status)
+	}
+	if ipmi, ok := powerStatus.GetIpmiOk(); ok {
+		model.IPMIStatus = types.StringPointerValue(ipmi.Status)
+	}
+
+	return model
+}
+
+func (p *powerDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the power status of a dedicated server.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Description: "The ID of a server",
+				Required:    true,
+			},
+			"power_state": schema.StringAttribute{
+				Computed:    true,
+				Description: "`off` if either the PDU or IPMI reports the server as off, `on` otherwise",
+			},
+			"pdu_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The power status reported by the PDU",
+			},
+			"ipmi_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The power status reported by IPMI",
+			},
+		},
+	}
+}
+
+func (p *powerDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config powerDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.DedicatedServerID.ValueString()
+	powerStatus, response, err := p.DedicatedserverAPI.GetPowerStatus(ctx, serverID).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.State.Set(ctx, adaptPowerStatusToPowerDataSource(serverID, *powerStatus))...,
+	)
+}
+
+func NewPowerDataSource() datasource.DataSource {
+	return &powerDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_power",
+		},
+	}
+}
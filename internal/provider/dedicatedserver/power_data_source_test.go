@@ -0,0 +1,32 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptPowerStatusToPowerDataSource(t *testing.T) {
+	t.Run("statuses are adapted", func(t *testing.T) {
+		powerStatus := dedicatedserver.GetPowerStatusResult{
+			Pdu:  &dedicatedserver.Pdu{Status: dedicatedserver.PtrString("on")},
+			Ipmi: &dedicatedserver.Ipmi{Status: dedicatedserver.PtrString("off")},
+		}
+
+		got := adaptPowerStatusToPowerDataSource("12345", powerStatus)
+
+		assert.Equal(t, "12345", got.DedicatedServerID.ValueString())
+		assert.Equal(t, "off", got.PowerState.ValueString())
+		assert.Equal(t, "on", got.PDUStatus.ValueString())
+		assert.Equal(t, "off", got.IPMIStatus.ValueString())
+	})
+
+	t.Run("missing statuses are null", func(t *testing.T) {
+		got := adaptPowerStatusToPowerDataSource("12345", dedicatedserver.GetPowerStatusResult{})
+
+		assert.Equal(t, "on", got.PowerState.ValueString())
+		assert.True(t, got.PDUStatus.IsNull())
+		assert.True(t, got.IPMIStatus.IsNull())
+	})
+}
@@ -0,0 +1,225 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+const (
+	powerStateOn  = "on"
+	powerStateOff = "off"
+)
+
+var (
+	_ resource.Resource                = &powerResource{}
+	_ resource.ResourceWithConfigure   = &powerResource{}
+	_ resource.ResourceWithImportState = &powerResource{}
+)
+
+type powerResource struct {
+	utils.ResourceAPI
+}
+
+type powerResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	PowerState        types.String `tfsdk:"power_state"`
+	PowerCycleTrigger types.String `tfsdk:"power_cycle_trigger"`
+}
+
+// adaptPowerStatusToPowerState considers a server powered off as soon as
+// either the PDU or IPMI reports it as off.
+func adaptPowerStatusToPowerState(powerStatus dedicatedserver.GetPowerStatusResult) string {
+	pdu := powerStatus.GetPdu()
+	ipmi := powerStatus.GetIpmi()
+	if pdu.GetStatus() == powerStateOff || ipmi.GetStatus() == powerStateOff {
+		return powerStateOff
+	}
+
+	return powerStateOn
+}
+
+// shouldPowerCycle reports whether the server must be power cycled, which is
+// when power_cycle_trigger changes while the server stays on. Powering the
+// server on or off already restarts it.
+func shouldPowerCycle(plan powerResourceModel, state powerResourceModel) bool {
+	return !plan.PowerCycleTrigger.Equal(state.PowerCycleTrigger) &&
+		plan.PowerState.Equal(state.PowerState) &&
+		plan.PowerState.ValueString() == powerStateOn
+}
+
+func NewPowerResource() resource.Resource {
+	return &powerResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_power",
+		},
+	}
+}
+
+func (p *powerResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Powers a dedicated server on or off, and power cycles it whenever `power_cycle_trigger` changes. Destroying the resource leaves the server in its last power state.\n\n",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"power_state": schema.StringAttribute{
+				Required:    true,
+				Description: "The desired power state of the server. Valid options are `on` and `off`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(powerStateOn, powerStateOff),
+				},
+			},
+			"power_cycle_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Any change to this value power cycles the server while `power_state` is `on`, for example a maintenance date. Setting it when creating the resource does not power cycle the server.",
+			},
+		},
+	}
+
+	utils.AddUnsupportedActionsNotation(
+		resp,
+		[]utils.Action{utils.DeleteAction},
+	)
+}
+
+func (p *powerResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan powerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	powerState, response, err := p.getPowerState(ctx, plan.DedicatedServerID.ValueString())
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	// Only switch the power when needed, so adopting a server is harmless.
+	if powerState != plan.PowerState.ValueString() {
+		response, err = p.setPowerState(ctx, plan)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (p *powerResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state powerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	powerState, response, err := p.getPowerState(ctx, state.DedicatedServerID.ValueString())
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+	state.PowerState = types.StringValue(powerState)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (p *powerResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state powerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.PowerState.Equal(state.PowerState) {
+		response, err := p.setPowerState(ctx, plan)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+	}
+
+	if shouldPowerCycle(plan, state) {
+		response, err := p.DedicatedserverAPI.PowerCycle(
+			ctx,
+			plan.DedicatedServerID.ValueString(),
+		).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (p *powerResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
+
+func (p *powerResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("dedicated_server_id"), req, resp)
+}
+
+func (p *powerResource) getPowerState(
+	ctx context.Context,
+	serverID string,
+) (string, *http.Response, error) {
+	powerStatus, response, err := p.DedicatedserverAPI.GetPowerStatus(ctx, serverID).Execute()
+	if err != nil {
+		return "", response, err
+	}
+
+	return adaptPowerStatusToPowerState(*powerStatus), response, nil
+}
+
+func (p *powerResource) setPowerState(
+	ctx context.Context,
+	plan powerResourceModel,
+) (*http.Response, error) {
+	serverID := plan.DedicatedServerID.ValueString()
+	if plan.PowerState.ValueString() == powerStateOn {
+		return p.DedicatedserverAPI.PowerOn(ctx, serverID).Execute()
+	}
+
+	return p.DedicatedserverAPI.PowerOff(ctx, serverID).Execute()
+}
@@ -0,0 +1,82 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptPowerStatusToPowerState(t *testing.T) {
+	t.Run("server is on if PDU and IPMI are on", func(t *testing.T) {
+		powerStatus := dedicatedserver.GetPowerStatusResult{
+			Pdu:  &dedicatedserver.Pdu{Status: dedicatedserver.PtrString("on")},
+			Ipmi: &dedicatedserver.Ipmi{Status: dedicatedserver.PtrString("on")},
+		}
+
+		assert.Equal(t, "on", adaptPowerStatusToPowerState(powerStatus))
+	})
+
+	t.Run("server is off if IPMI is off", func(t *testing.T) {
+		powerStatus := dedicatedserver.GetPowerStatusResult{
+			Pdu:  &dedicatedserver.Pdu{Status: dedicatedserver.PtrString("on")},
+			Ipmi: &dedicatedserver.Ipmi{Status: dedicatedserver.PtrString("off")},
+		}
+
+		assert.Equal(t, "off", adaptPowerStatusToPowerState(powerStatus))
+	})
+
+	t.Run("server is off if PDU is off", func(t *testing.T) {
+		powerStatus := dedicatedserver.GetPowerStatusResult{
+			Pdu: &dedicatedserver.Pdu{Status: dedicatedserver.PtrString("off")},
+		}
+
+		assert.Equal(t, "off", adaptPowerStatusToPowerState(powerStatus))
+	})
+}
+
+func Test_shouldPowerCycle(t *testing.T) {
+	newModel := func(powerState string, trigger types.String) powerResourceModel {
+		return powerResourceModel{
+			DedicatedServerID: types.StringValue("12345"),
+			PowerState:        types.StringValue(powerState),
+			PowerCycleTrigger: trigger,
+		}
+	}
+
+	t.Run("power cycles when the trigger changes", func(t *testing.T) {
+		assert.True(t, shouldPowerCycle(
+			newModel("on", types.StringValue("2024-06-01")),
+			newModel("on", types.StringValue("2024-05-01")),
+		))
+	})
+
+	t.Run("power cycles when the trigger is set", func(t *testing.T) {
+		assert.True(t, shouldPowerCycle(
+			newModel("on", types.StringValue("2024-06-01")),
+			newModel("on", types.StringNull()),
+		))
+	})
+
+	t.Run("does not power cycle when the trigger is unchanged", func(t *testing.T) {
+		assert.False(t, shouldPowerCycle(
+			newModel("on", types.StringValue("2024-06-01")),
+			newModel("on", types.StringValue("2024-06-01")),
+		))
+	})
+
+	t.Run("does not power cycle a server that stays off", func(t *testing.T) {
+		assert.False(t, shouldPowerCycle(
+			newModel("off", types.StringValue("2024-06-01")),
+			newModel("off", types.StringValue("2024-05-01")),
+		))
+	})
+
+	t.Run("does not power cycle a server that is powered on", func(t *testing.T) {
+		assert.False(t, shouldPowerCycle(
+			newModel("on", types.StringValue("2024-06-01")),
+			newModel("off", types.StringValue("2024-05-01")),
+		))
+	})
+}
@@ -0,0 +1,301 @@
+package dedicatedserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &privateNetworkResource{}
+	_ resource.ResourceWithConfigure   = &privateNetworkResource{}
+	_ resource.ResourceWithImportState = &privateNetworkResource{}
+)
+
+// privateNetworkConfigured is the status of a private network once the
+// server has access to it.
+const privateNetworkConfigured = "CONFIGURED"
+
+type privateNetworkResource struct {
+	utils.ResourceAPI
+}
+
+type privateNetworkResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	PrivateNetworkID  types.String `tfsdk:"private_network_id"`
+	LinkSpeed         types.Int32  `tfsdk:"link_speed"`
+	Status            types.String `tfsdk:"status"`
+	Subnet            types.String `tfsdk:"subnet"`
+	VLANID            types.String `tfsdk:"vlan_id"`
+	DHCP              types.String `tfsdk:"dhcp"`
+}
+
+func adaptPrivateNetworkToPrivateNetworkResource(
+	privateNetwork dedicatedserver.PrivateNetwork,
+	dedicatedServerID types.String,
+) privateNetworkResourceModel {
+	linkSpeed := types.Int32Null()
+	if privateNetwork.LinkSpeed != nil {
+		linkSpeed = types.Int32Value(int32(privateNetwork.GetLinkSpeed()))
+	}
+
+	return privateNetworkResourceModel{
+		DedicatedServerID: dedicatedServerID,
+		PrivateNetworkID:  types.StringValue(privateNetwork.GetId()),
+		LinkSpeed:         linkSpeed,
+		Status:            types.StringPointerValue(privateNetwork.Status),
+		Subnet:            types.StringPointerValue(privateNetwork.Subnet),
+		VLANID:            types.StringPointerValue(privateNetwork.VlanId),
+		DHCP:              types.StringPointerValue(privateNetwork.Dhcp),
+	}
+}
+
+func NewPrivateNetworkResource() resource.Resource {
+	return &privateNetworkResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_private_network",
+		},
+	}
+}
+
+func (p *privateNetworkResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	warningError := "**WARNING!** Changing this value once running will cause the server to be removed from the private network and added again."
+	linkSpeeds := utils.NewIntMarkdownList(dedicatedserver.AllowedLinkSpeedEnumValues)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a dedicated server to a private network. Creating the resource waits until the server has access to the private network, which takes a few minutes. Destroying the resource removes the server from the private network.\n\n",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the private network.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"link_speed": schema.Int32Attribute{
+				Required:    true,
+				Description: "The port speed in Mbps.\n" + warningError + " Valid options are " + linkSpeeds.Markdown(),
+				Validators: []validator.Int32{
+					int32validator.OneOf(linkSpeeds.ToInt32()...),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the server in the private network, e.g. `CONFIGURED`",
+			},
+			"subnet": schema.StringAttribute{
+				Computed:    true,
+				Description: "The subnet of the private network",
+			},
+			"vlan_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The VLAN of the private network",
+			},
+			"dhcp": schema.StringAttribute{
+				Computed:    true,
+				Description: "Whether DHCP is enabled in the private network",
+			},
+		},
+	}
+}
+
+func (p *privateNetworkResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan privateNetworkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := dedicatedserver.NewAddToPrivateNetworkOpts(
+		dedicatedserver.LinkSpeed(plan.LinkSpeed.ValueInt32()),
+	)
+	response, err := p.DedicatedserverAPI.AddToPrivateNetwork(
+		ctx,
+		plan.DedicatedServerID.ValueString(),
+		plan.PrivateNetworkID.ValueString(),
+	).AddToPrivateNetworkOpts(*opts).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	privateNetwork, response, err := p.waitForPrivateNetwork(ctx, plan)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	state := adaptPrivateNetworkToPrivateNetworkResource(
+		*privateNetwork,
+		plan.DedicatedServerID,
+	)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (p *privateNetworkResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state privateNetworkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateNetwork, response, err := p.getPrivateNetwork(ctx, state)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+	// The server has been removed from the private network outside of
+	// Terraform.
+	if privateNetwork == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := adaptPrivateNetworkToPrivateNetworkResource(
+		*privateNetwork,
+		state.DedicatedServerID,
+	)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+// Update is never called, as every configurable attribute requires
+// replacement.
+func (p *privateNetworkResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+func (p *privateNetworkResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state privateNetworkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := p.DedicatedserverAPI.DeleteFromPrivateNetwork(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+		state.PrivateNetworkID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+	}
+}
+
+func (p *privateNetworkResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"dedicated_server_id", "private_network_id"},
+		req,
+		resp,
+	)
+}
+
+// getPrivateNetwork returns the private network of the server, or nil if
+// the server is not in it.
+func (p *privateNetworkResource) getPrivateNetwork(
+	ctx context.Context,
+	model privateNetworkResourceModel,
+) (*dedicatedserver.PrivateNetwork, *http.Response, error) {
+	server, response, err := p.DedicatedserverAPI.GetServer(
+		ctx,
+		model.DedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		return nil, response, err
+	}
+
+	for _, privateNetwork := range server.GetPrivateNetworks() {
+		if privateNetwork.GetId() == model.PrivateNetworkID.ValueString() {
+			return &privateNetwork, response, nil
+		}
+	}
+
+	return nil, response, nil
+}
+
+// waitForPrivateNetwork polls the server until its private network is
+// configured.
+func (p *privateNetworkResource) waitForPrivateNetwork(
+	ctx context.Context,
+	model privateNetworkResourceModel,
+) (*dedicatedserver.PrivateNetwork, *http.Response, error) {
+	// Create a constant backoff with a 30-second retry interval
+	bo := backoff.NewConstantBackOff(30 * time.Second)
+
+	// Set the retry limit to 40 retries (20 minutes)
+	retryCount := 0
+	maxRetries := 40
+
+	for {
+		if retryCount >= maxRetries {
+			return nil, nil, errors.New("timed out waiting for the private network to be configured after 20 minutes")
+		}
+
+		privateNetwork, response, err := p.getPrivateNetwork(ctx, model)
+		if err != nil {
+			return nil, response, err
+		}
+		if privateNetwork != nil && privateNetwork.GetStatus() == privateNetworkConfigured {
+			return privateNetwork, response, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf(
+				"waiting for server %s to be added to private network %s: %w",
+				model.DedicatedServerID.ValueString(),
+				model.PrivateNetworkID.ValueString(),
+				ctx.Err(),
+			)
+		case <-time.After(bo.NextBackOff()):
+		}
+		retryCount++
+	}
+}
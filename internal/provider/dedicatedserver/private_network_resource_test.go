@@ -0,0 +1,51 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptPrivateNetworkToPrivateNetworkResource(t *testing.T) {
+	t.Run("all attributes are adapted", func(t *testing.T) {
+		linkSpeed := dedicatedserver.LINKSPEED__10000
+		privateNetwork := dedicatedserver.PrivateNetwork{
+			Id:        dedicatedserver.PtrString("1238793"),
+			LinkSpeed: &linkSpeed,
+			Status:    dedicatedserver.PtrString("CONFIGURED"),
+			Dhcp:      dedicatedserver.PtrString("DISABLED"),
+			Subnet:    dedicatedserver.PtrString("24"),
+			VlanId:    dedicatedserver.PtrString("1912639"),
+		}
+
+		got := adaptPrivateNetworkToPrivateNetworkResource(
+			privateNetwork,
+			types.StringValue("12345"),
+		)
+
+		assert.Equal(t, "12345", got.DedicatedServerID.ValueString())
+		assert.Equal(t, "1238793", got.PrivateNetworkID.ValueString())
+		assert.Equal(t, int32(10000), got.LinkSpeed.ValueInt32())
+		assert.Equal(t, "CONFIGURED", got.Status.ValueString())
+		assert.Equal(t, "DISABLED", got.DHCP.ValueString())
+		assert.Equal(t, "24", got.Subnet.ValueString())
+		assert.Equal(t, "1912639", got.VLANID.ValueString())
+	})
+
+	t.Run("missing attributes are null", func(t *testing.T) {
+		privateNetwork := dedicatedserver.PrivateNetwork{
+			Id: dedicatedserver.PtrString("1238793"),
+		}
+
+		got := adaptPrivateNetworkToPrivateNetworkResource(
+			privateNetwork,
+			types.StringValue("12345"),
+		)
+
+		assert.True(t, got.LinkSpeed.IsNull())
+		assert.True(t, got.Status.IsNull())
+		assert.True(t, got.VLANID.IsNull())
+	})
+}
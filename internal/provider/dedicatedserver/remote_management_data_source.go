@@ -0,0 +1,143 @@
+package dedicatedserver
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSource              = &remoteManagementDataSource{}
+	_ datasource.DataSourceWithConfigure = &remoteManagementDataSource{}
+)
+
+type remoteManagementDataSource struct {
+	utils.DataSourceAPI
+}
+
+type remoteManagementDataSourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	IP                types.String `tfsdk:"ip"`
+	Gateway           types.String `tfsdk:"gateway"`
+	MAC               types.String `tfsdk:"mac"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+}
+
+// adaptServerToRemoteManagementDataSource reads the remote management
+// interface of the server. The IP is returned without its prefix length.
+func adaptServerToRemoteManagementDataSource(
+	server dedicatedserver.Server,
+	credential *dedicatedserver.Credential,
+) remoteManagementDataSourceModel {
+	model := remoteManagementDataSourceModel{
+		DedicatedServerID: types.StringValue(server.GetId()),
+		IP:                types.StringNull(),
+		Gateway:           types.StringNull(),
+		MAC:               types.StringNull(),
+		Username:          types.StringNull(),
+		Password:          types.StringNull(),
+	}
+
+	if networkInterfaces, ok := server.GetNetworkInterfacesOk(); ok {
+		if remoteNetworkInterface, ok := networkInterfaces.GetRemoteManagementOk(); ok {
+			ip := net.ParseIP(strings.Split(remoteNetworkInterface.GetIp(), "/")[0])
+			if ip != nil {
+				model.IP = types.StringValue(ip.String())
+			}
+			model.Gateway = types.StringPointerValue(remoteNetworkInterface.Gateway.Get())
+			model.MAC = types.StringPointerValue(remoteNetworkInterface.Mac.Get())
+		}
+	}
+
+	if credential != nil {
+		model.Username = types.StringValue(credential.GetUsername())
+		model.Password = types.StringValue(credential.GetPassword())
+	}
+
+	return model
+}
+
+func (r *remoteManagementDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Reports the remote management (IPMI) interface of a dedicated server and its credentials, without resetting them.",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Description: "The ID of a server",
+				Required:    true,
+			},
+			"ip": schema.StringAttribute{
+				Computed:    true,
+				Description: "The IP address of the remote management interface",
+			},
+			"gateway": schema.StringAttribute{
+				Computed:    true,
+				Description: "The gateway of the remote management interface",
+			},
+			"mac": schema.StringAttribute{
+				Computed:    true,
+				Description: "The MAC address of the remote management interface",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The remote management username, null if the server has no remote management credentials",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The remote management password, null if the server has no remote management credentials",
+			},
+		},
+	}
+}
+
+func (r *remoteManagementDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config remoteManagementDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := config.DedicatedServerID.ValueString()
+	server, response, err := r.DedicatedserverAPI.GetServer(ctx, serverID).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	credential, response, err := getRemoteManagementCredential(
+		ctx,
+		r.DedicatedserverAPI,
+		serverID,
+	)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	state := adaptServerToRemoteManagementDataSource(*server, credential)
+	state.DedicatedServerID = config.DedicatedServerID
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func NewRemoteManagementDataSource() datasource.DataSource {
+	return &remoteManagementDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dedicated_server_remote_management",
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptServerToRemoteManagementDataSource(t *testing.T) {
+	t.Run("remote management interface and credential are adapted", func(t *testing.T) {
+		server := dedicatedserver.Server{
+			Id: dedicatedserver.PtrString("12345"),
+			NetworkInterfaces: &dedicatedserver.NetworkInterfaces{
+				RemoteManagement: &dedicatedserver.NetworkInterface{
+					Ip:      *dedicatedserver.NewNullableString(dedicatedserver.PtrString("10.22.192.1/26")),
+					Gateway: *dedicatedserver.NewNullableString(dedicatedserver.PtrString("10.22.192.126")),
+					Mac:     *dedicatedserver.NewNullableString(dedicatedserver.PtrString("AA:AC:CC:88:EE:E4")),
+				},
+			},
+		}
+		credential := dedicatedserver.Credential{
+			Username: "root",
+			Password: "secret",
+		}
+
+		got := adaptServerToRemoteManagementDataSource(server, &credential)
+
+		assert.Equal(t, "12345", got.DedicatedServerID.ValueString())
+		assert.Equal(t, "10.22.192.1", got.IP.ValueString())
+		assert.Equal(t, "10.22.192.126", got.Gateway.ValueString())
+		assert.Equal(t, "AA:AC:CC:88:EE:E4", got.MAC.ValueString())
+		assert.Equal(t, "root", got.Username.ValueString())
+		assert.Equal(t, "secret", got.Password.ValueString())
+	})
+
+	t.Run("missing interface and credential are null", func(t *testing.T) {
+		server := dedicatedserver.Server{
+			Id: dedicatedserver.PtrString("12345"),
+		}
+
+		got := adaptServerToRemoteManagementDataSource(server, nil)
+
+		assert.True(t, got.IP.IsNull())
+		assert.True(t, got.Gateway.IsNull())
+		assert.True(t, got.MAC.IsNull())
+		assert.True(t, got.Username.IsNull())
+		assert.True(t, got.Password.IsNull())
+	})
+}
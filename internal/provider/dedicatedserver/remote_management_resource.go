@@ -0,0 +1,287 @@
+package dedicatedserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &remoteManagementResource{}
+	_ resource.ResourceWithConfigure   = &remoteManagementResource{}
+	_ resource.ResourceWithImportState = &remoteManagementResource{}
+)
+
+type remoteManagementResource struct {
+	utils.ResourceAPI
+}
+
+type remoteManagementResourceModel struct {
+	DedicatedServerID types.String `tfsdk:"dedicated_server_id"`
+	ResetTrigger      types.String `tfsdk:"reset_trigger"`
+	PowerCycle        types.Bool   `tfsdk:"power_cycle"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+}
+
+func NewRemoteManagementResource() resource.Resource {
+	return &remoteManagementResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_remote_management",
+		},
+	}
+}
+
+func (r *remoteManagementResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resets the remote management (IPMI) interface of a dedicated server when created and whenever `reset_trigger` changes. A reset generates new remote management credentials. Remote management access itself cannot be enabled or disabled through the API. Importing reads the current credentials without resetting them.\n\n",
+		Attributes: map[string]schema.Attribute{
+			"dedicated_server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the dedicated server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reset_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Any change to this value resets the remote management interface again, for example a rotation date.",
+			},
+			"power_cycle": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "If true, the server is power cycled to complete the reset. Defaults to false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username of the remote management credentials.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password of the remote management credentials.",
+			},
+		},
+	}
+
+	utils.AddUnsupportedActionsNotation(
+		resp,
+		[]utils.Action{utils.DeleteAction},
+	)
+}
+
+func (r *remoteManagementResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan remoteManagementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.reset(ctx, plan)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	response, err = r.readCredentials(ctx, &plan)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *remoteManagementResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state remoteManagementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.readCredentials(ctx, &state)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *remoteManagementResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state remoteManagementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ResetTrigger.Equal(state.ResetTrigger) {
+		response, err := r.reset(ctx, plan)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, response)
+			return
+		}
+	}
+
+	response, err := r.readCredentials(ctx, &plan)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *remoteManagementResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+}
+
+// ImportState adopts the remote management credentials of the server. No
+// reset is done until reset_trigger changes.
+func (r *remoteManagementResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("dedicated_server_id"), req, resp)
+}
+
+// reset launches an IPMI reset and waits until the job has finished.
+func (r *remoteManagementResource) reset(
+	ctx context.Context,
+	plan remoteManagementResourceModel,
+) (*http.Response, error) {
+	serverID := plan.DedicatedServerID.ValueString()
+
+	opts := dedicatedserver.NewIpmiResetOpts()
+	opts.PowerCycle = utils.AdaptBoolPointerValueToNullableBool(plan.PowerCycle)
+	job, response, err := r.DedicatedserverAPI.IpmiReset(ctx, serverID).
+		IpmiResetOpts(*opts).
+		Execute()
+	if err != nil {
+		return response, err
+	}
+
+	// Create a constant backoff with a 30-second retry interval
+	bo := backoff.NewConstantBackOff(30 * time.Second)
+
+	// Set the retry limit to 40 retries (20 minutes)
+	retryCount := 0
+	maxRetries := 40
+
+	for {
+		if retryCount >= maxRetries {
+			return nil, errors.New("timed out waiting for remote management reset to finish after 20 minutes")
+		}
+
+		currentJob, response, err := r.DedicatedserverAPI.GetJob(ctx, serverID, job.GetUuid()).Execute()
+		if err != nil {
+			return response, err
+		}
+
+		switch currentJob.GetStatus() {
+		case "FINISHED":
+			return response, nil
+		case "FAILED", "CANCELED":
+			return nil, fmt.Errorf("remote management reset job %s for server %s has failed or was canceled", job.GetUuid(), serverID)
+		}
+
+		// Sleep for the backoff interval before retrying
+		time.Sleep(bo.NextBackOff())
+		retryCount++
+	}
+}
+
+// getRemoteManagementCredential returns the remote management credential of
+// the server, or nil if it has none.
+func getRemoteManagementCredential(
+	ctx context.Context,
+	api dedicatedserver.DedicatedserverAPI,
+	serverID string,
+) (*dedicatedserver.Credential, *http.Response, error) {
+	credentials, response, err := api.GetCredentialListByType(
+		ctx,
+		serverID,
+		dedicatedserver.CREDENTIALTYPE_REMOTE_MANAGEMENT,
+	).Execute()
+	if err != nil {
+		return nil, response, err
+	}
+
+	if len(credentials.GetCredentials()) == 0 {
+		return nil, response, nil
+	}
+
+	return api.GetCredential(
+		ctx,
+		serverID,
+		dedicatedserver.CREDENTIALTYPE_REMOTE_MANAGEMENT,
+		credentials.GetCredentials()[0].GetUsername(),
+	).Execute()
+}
+
+// readCredentials fills the remote management credentials of the server.
+func (r *remoteManagementResource) readCredentials(
+	ctx context.Context,
+	model *remoteManagementResourceModel,
+) (*http.Response, error) {
+	credential, response, err := getRemoteManagementCredential(
+		ctx,
+		r.DedicatedserverAPI,
+		model.DedicatedServerID.ValueString(),
+	)
+	if err != nil {
+		return response, err
+	}
+
+	if model.PowerCycle.IsUnknown() {
+		model.PowerCycle = types.BoolValue(false)
+	}
+
+	if credential == nil {
+		model.Username = types.StringNull()
+		model.Password = types.StringNull()
+		return response, nil
+	}
+
+	model.Username = types.StringValue(credential.GetUsername())
+	model.Password = types.StringValue(credential.GetPassword())
+
+	return response, nil
+}
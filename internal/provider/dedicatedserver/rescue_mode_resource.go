@@ -0,0 +1,278 @@
+package dedicatedserver
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/client"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+const defaultRescueModeTimeout = 30 * time.Minute
+
+var (
+	_ resource.ResourceWithConfigure   = &rescueModeResource{}
+	_ resource.ResourceWithImportState = &rescueModeResource{}
+)
+
+type rescueModeResource struct {
+	utils.ResourceAPI
+}
+
+type rescueModeResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	DedicatedServerID types.String   `tfsdk:"dedicated_server_id"`
+	RescueImageID     types.String   `tfsdk:"rescue_image_id"`
+	SSHKeys           []types.String `tfsdk:"ssh_keys"`
+	Password          types.String   `tfsdk:"password"`
+	PostInstallScript types.String   `tfsdk:"post_install_script"`
+	PowerCycle        types.Bool     `tfsdk:"power_cycle"`
+	CallbackURL       types.String   `tfsdk:"callback_url"`
+	Status            types.String   `tfsdk:"status"`
+}
+
+func NewRescueModeResource() resource.Resource {
+	return &rescueModeResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "dedicated_server_rescue_mode",
+		},
+	}
+}
+
+func (r *rescueModeResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	warningError := "**WARNING!** Changing this value once running will cause the server to be rebooted into rescue mode again."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Launches rescue mode on a dedicated server, e.g. to wipe its disks or to diagnose it. Creating the resource waits for the rescue mode job to finish when `power_cycle` is enabled. Destroying the resource power cycles the server, which boots it back into its installed operating system.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the rescue mode job",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dedicated_server_id": schema.StringAttribute{
+				Description: "The ID of a server.\n" + warningError,
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rescue_image_id": schema.StringAttribute{
+				Description: "Rescue image identifier, e.g. `GRML`.\n" + warningError,
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_keys": schema.SetAttribute{
+				Description: "Public SSH keys that can log in to the rescue environment.\n" + warningError,
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of the rescue environment. If not provided, it would be automatically generated.\n" + warningError,
+				Optional:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"post_install_script": schema.StringAttribute{
+				Description: "A valid bash script to run right after rescue mode is launched. It must start with a shebang line such as `#!/usr/bin/env bash`.\n" + warningError,
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"power_cycle": schema.BoolAttribute{
+				Description: "If true, the server is power cycled to boot into rescue mode. Otherwise, you should reboot it manually and the job is not waited for. Defaults to true.\n" + warningError,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"callback_url": schema.StringAttribute{
+				Description: "Url which will receive callbacks when rescue mode is launched or failed.\n" + warningError,
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the rescue mode job",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *rescueModeResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan rescueModeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.PowerCycle.IsUnknown() {
+		plan.PowerCycle = types.BoolValue(true)
+	}
+
+	opts := dedicatedserver.NewEnableRescueModeOpts(plan.RescueImageID.ValueString())
+	opts.PowerCycle = plan.PowerCycle.ValueBoolPointer()
+	opts.Password = utils.AdaptStringPointerValueToNullableString(plan.Password)
+	opts.CallbackUrl = utils.AdaptStringPointerValueToNullableString(plan.CallbackURL)
+	if !plan.PostInstallScript.IsNull() {
+		postInstallScript := base64.StdEncoding.EncodeToString(
+			[]byte(strings.TrimSpace(plan.PostInstallScript.ValueString())),
+		)
+		opts.PostInstallScript = &postInstallScript
+	}
+
+	var sshKeys []string
+	for _, sshKey := range plan.SSHKeys {
+		if utils.AdaptStringPointerValueToNullableString(sshKey) != nil {
+			sshKeys = append(sshKeys, sshKey.ValueString())
+		}
+	}
+	if len(sshKeys) > 0 {
+		joinedSSHKeys := strings.Join(sshKeys, "\n")
+		opts.SshKeys = &joinedSSHKeys
+	}
+
+	serverID := plan.DedicatedServerID.ValueString()
+	rescueModeJob, response, err := r.DedicatedserverAPI.EnableRescueMode(ctx, serverID).
+		EnableRescueModeOpts(*opts).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+	plan.ID = types.StringValue(rescueModeJob.GetUuid())
+	plan.Status = types.StringPointerValue(rescueModeJob.Status)
+
+	// Without a power cycle the job only finishes once the server has been
+	// rebooted manually.
+	if plan.PowerCycle.ValueBool() {
+		jobID := rescueModeJob.GetUuid()
+		job, err := waitForJob(
+			ctx,
+			func(ctx context.Context) (*dedicatedserver.CurrentJob, *http.Response, error) {
+				return r.DedicatedserverAPI.GetJob(ctx, serverID, jobID).Execute()
+			},
+			defaultInstallPollInterval,
+			defaultRescueModeTimeout,
+		)
+		if err != nil {
+			utils.ReportError(err.Error(), &resp.Diagnostics)
+			return
+		}
+		plan.Status = types.StringValue(job.GetStatus())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *rescueModeResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state rescueModeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, response, err := r.DedicatedserverAPI.GetJob(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+		state.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		if client.ClassifyResponse(response, err) == client.ErrorClassNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	state.Status = types.StringPointerValue(job.Status)
+	// The job does not report the rescue image, only whether the server was
+	// power cycled.
+	if state.PowerCycle.IsNull() {
+		payload := job.GetPayload()
+		state.PowerCycle = types.BoolPointerValue(payload.PowerCycle)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update is never called, as every configurable attribute requires
+// replacement.
+func (r *rescueModeResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+// Delete power cycles the server, which boots it back into its installed
+// operating system.
+func (r *rescueModeResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state rescueModeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := r.DedicatedserverAPI.PowerCycle(
+		ctx,
+		state.DedicatedServerID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+	}
+}
+
+func (r *rescueModeResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"dedicated_server_id", "id"},
+		req,
+		resp,
+	)
+}
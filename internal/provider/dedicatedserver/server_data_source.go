@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
 
@@ -19,35 +20,106 @@ type serverDataSource struct {
 }
 
 type serverDataSourceModel struct {
-	ID                                 types.String `tfsdk:"id"`
-	AssetID                            types.String `tfsdk:"asset_id"`
-	ContractID                         types.String `tfsdk:"contract_id"`
-	CPUQuantity                        types.Int32  `tfsdk:"cpu_quantity"`
-	CPUType                            types.String `tfsdk:"cpu_type"`
-	InternalGateway                    types.String `tfsdk:"internal_gateway"`
-	InternalIP                         types.String `tfsdk:"internal_ip"`
-	InternalMAC                        types.String `tfsdk:"internal_mac"`
-	IsAutomationFeatureAvailable       types.Bool   `tfsdk:"is_automation_feature_available"`
-	IsIPMIRebootFeatureAvailable       types.Bool   `tfsdk:"is_ipmi_reboot_feature_available"`
-	IsPowerCycleFeatureAvailable       types.Bool   `tfsdk:"is_power_cycle_feature_available"`
-	IsPrivateNetworkFeatureAvailable   types.Bool   `tfsdk:"is_private_network_feature_available"`
-	IsRemoteManagementFeatureAvailable types.Bool   `tfsdk:"is_remote_management_feature_available"`
-	LocationRack                       types.String `tfsdk:"location_rack"`
-	LocationSite                       types.String `tfsdk:"location_site"`
-	LocationSuite                      types.String `tfsdk:"location_suite"`
-	LocationUnit                       types.String `tfsdk:"location_unit"`
-	PublicGateway                      types.String `tfsdk:"public_gateway"`
-	PublicIP                           types.String `tfsdk:"public_ip"`
-	PublicMAC                          types.String `tfsdk:"public_mac"`
-	RackCapacity                       types.String `tfsdk:"rack_capacity"`
-	RackID                             types.String `tfsdk:"rack_id"`
-	RackType                           types.String `tfsdk:"rack_type"`
-	RAMSize                            types.Int32  `tfsdk:"ram_size"`
-	RAMUnit                            types.String `tfsdk:"ram_unit"`
-	RemoteGateway                      types.String `tfsdk:"remote_gateway"`
-	RemoteIP                           types.String `tfsdk:"remote_ip"`
-	RemoteMAC                          types.String `tfsdk:"remote_mac"`
-	SerialNumber                       types.String `tfsdk:"serial_number"`
+	ID                                 types.String                          `tfsdk:"id"`
+	AssetID                            types.String                          `tfsdk:"asset_id"`
+	ContractID                         types.String                          `tfsdk:"contract_id"`
+	CPUQuantity                        types.Int32                           `tfsdk:"cpu_quantity"`
+	CPUType                            types.String                          `tfsdk:"cpu_type"`
+	InternalGateway                    types.String                          `tfsdk:"internal_gateway"`
+	InternalIP                         types.String                          `tfsdk:"internal_ip"`
+	InternalMAC                        types.String                          `tfsdk:"internal_mac"`
+	IsAutomationFeatureAvailable       types.Bool                            `tfsdk:"is_automation_feature_available"`
+	IsIPMIRebootFeatureAvailable       types.Bool                            `tfsdk:"is_ipmi_reboot_feature_available"`
+	IsPowerCycleFeatureAvailable       types.Bool                            `tfsdk:"is_power_cycle_feature_available"`
+	IsPrivateNetworkFeatureAvailable   types.Bool                            `tfsdk:"is_private_network_feature_available"`
+	IsRemoteManagementFeatureAvailable types.Bool                            `tfsdk:"is_remote_management_feature_available"`
+	LocationRack                       types.String                          `tfsdk:"location_rack"`
+	LocationSite                       types.String                          `tfsdk:"location_site"`
+	LocationSuite                      types.String                          `tfsdk:"location_suite"`
+	LocationUnit                       types.String                          `tfsdk:"location_unit"`
+	PublicGateway                      types.String                          `tfsdk:"public_gateway"`
+	PublicIP                           types.String                          `tfsdk:"public_ip"`
+	PublicMAC                          types.String                          `tfsdk:"public_mac"`
+	RackCapacity                       types.String                          `tfsdk:"rack_capacity"`
+	RackID                             types.String                          `tfsdk:"rack_id"`
+	RackType                           types.String                          `tfsdk:"rack_type"`
+	RAMSize                            types.Int32                           `tfsdk:"ram_size"`
+	RAMUnit                            types.String                          `tfsdk:"ram_unit"`
+	RemoteGateway                      types.String                          `tfsdk:"remote_gateway"`
+	RemoteIP                           types.String                          `tfsdk:"remote_ip"`
+	RemoteMAC                          types.String                          `tfsdk:"remote_mac"`
+	SerialNumber                       types.String                          `tfsdk:"serial_number"`
+	Switch                             types.String                          `tfsdk:"switch"`
+	SwitchPort                         types.String                          `tfsdk:"switch_port"`
+	OperatingSystem                    *serverOperatingSystemDataSourceModel `tfsdk:"operating_system"`
+	ControlPanel                       *serverControlPanelDataSourceModel    `tfsdk:"control_panel"`
+}
+
+type serverOperatingSystemDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Family       types.String `tfsdk:"family"`
+	Version      types.String `tfsdk:"version"`
+	Architecture types.String `tfsdk:"architecture"`
+}
+
+type serverControlPanelDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func adaptServerJobPayloadToOperatingSystemDataSource(
+	payload dedicatedserver.ServerJobPayload,
+) *serverOperatingSystemDataSourceModel {
+	operatingSystemID, ok := payload.GetOperatingSystemIdOk()
+	if !ok {
+		return nil
+	}
+
+	operatingSystem := payload.GetOs()
+
+	return &serverOperatingSystemDataSourceModel{
+		ID:           types.StringPointerValue(operatingSystemID),
+		Name:         types.StringPointerValue(operatingSystem.Name),
+		Family:       types.StringPointerValue(operatingSystem.Family),
+		Version:      types.StringPointerValue(operatingSystem.Version),
+		Architecture: types.StringPointerValue(operatingSystem.Architecture),
+	}
+}
+
+func adaptServerJobPayloadToControlPanelDataSource(
+	payload dedicatedserver.ServerJobPayload,
+) *serverControlPanelDataSourceModel {
+	// The SDK does not model the control panel of an installation, so it
+	// ends up in the additional properties.
+	controlPanelID, ok := payload.AdditionalProperties["controlPanelId"].(string)
+	if !ok || controlPanelID == "" {
+		return nil
+	}
+
+	return &serverControlPanelDataSourceModel{
+		ID: types.StringValue(controlPanelID),
+	}
+}
+
+// getSwitchPort returns the switch and port the server uplinks to. The public
+// network interface is preferred, servers without a public uplink fall back to
+// the internal one.
+func getSwitchPort(
+	networkInterfaces dedicatedserver.NetworkInterfaces,
+) (switchName *string, switchPort *string) {
+	for _, networkInterface := range []*dedicatedserver.NetworkInterface{
+		networkInterfaces.Public,
+		networkInterfaces.Internal,
+	} {
+		if networkInterface == nil || len(networkInterface.GetPorts()) == 0 {
+			continue
+		}
+
+		port := networkInterface.GetPorts()[0]
+		return port.Name, port.Port
+	}
+
+	return nil, nil
 }
 
 func (s *serverDataSource) Read(
@@ -125,6 +197,11 @@ func (s *serverDataSource) Read(
 		}
 	}
 
+	var switchName, switchPort *string
+	if networkInterfaces, ok := result.GetNetworkInterfacesOk(); ok {
+		switchName, switchPort = getSwitchPort(*networkInterfaces)
+	}
+
 	var ramSize *int32
 	var ramUnit *string
 	if specs, ok := result.GetSpecsOk(); ok {
@@ -143,6 +220,21 @@ func (s *serverDataSource) Read(
 		}
 	}
 
+	// The installed software is only known from the latest installation.
+	var operatingSystem *serverOperatingSystemDataSourceModel
+	var controlPanel *serverControlPanelDataSourceModel
+	jobs, response, err := s.DedicatedserverAPI.GetJobList(ctx, config.ID.ValueString()).
+		Offset(0).Limit(1).Type_("install").Status("FINISHED").Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+	if len(jobs.GetJobs()) != 0 {
+		payload := jobs.GetJobs()[0].GetPayload()
+		operatingSystem = adaptServerJobPayloadToOperatingSystemDataSource(payload)
+		controlPanel = adaptServerJobPayloadToControlPanelDataSource(payload)
+	}
+
 	resp.Diagnostics.Append(
 		resp.State.Set(
 			ctx,
@@ -156,6 +248,8 @@ func (s *serverDataSource) Read(
 				InternalIP:                         types.StringPointerValue(internalIP),
 				InternalMAC:                        types.StringPointerValue(internalMAC),
 				SerialNumber:                       types.StringValue(result.GetSerialNumber()),
+				Switch:                             types.StringPointerValue(switchName),
+				SwitchPort:                         types.StringPointerValue(switchPort),
 				IsAutomationFeatureAvailable:       types.BoolPointerValue(automation),
 				IsIPMIRebootFeatureAvailable:       types.BoolPointerValue(ipmiReboot),
 				IsPowerCycleFeatureAvailable:       types.BoolPointerValue(powerCycle),
@@ -176,6 +270,8 @@ func (s *serverDataSource) Read(
 				RemoteGateway:                      types.StringPointerValue(remoteGateway),
 				RemoteIP:                           types.StringPointerValue(remoteIP),
 				RemoteMAC:                          types.StringPointerValue(remoteMAC),
+				OperatingSystem:                    operatingSystem,
+				ControlPanel:                       controlPanel,
 			},
 		)...,
 	)
@@ -200,6 +296,14 @@ func (s *serverDataSource) Schema(
 				Computed:    true,
 				Description: "Serial number of server.",
 			},
+			"switch": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the switch the server uplinks to. Null if the switch is not known.",
+			},
+			"switch_port": schema.StringAttribute{
+				Computed:    true,
+				Description: "Switch port the server uplinks to. Null if the switch is not known.",
+			},
 			"contract_id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The unique identifier of the contract.",
@@ -303,6 +407,42 @@ func (s *serverDataSource) Schema(
 				Computed:    true,
 				Description: "The type of the cpu.",
 			},
+			"operating_system": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The operating system of the latest finished installation. Not set if the server was never installed.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ID of the operating system.",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the operating system.",
+					},
+					"family": schema.StringAttribute{
+						Computed:    true,
+						Description: "The family of the operating system.",
+					},
+					"version": schema.StringAttribute{
+						Computed:    true,
+						Description: "The version of the operating system.",
+					},
+					"architecture": schema.StringAttribute{
+						Computed:    true,
+						Description: "The architecture of the operating system.",
+					},
+				},
+			},
+			"control_panel": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The control panel of the latest finished installation. Not set if no control panel was installed.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ID of the control panel.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,104 @@
+package dedicatedserver
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_adaptServerJobPayloadToOperatingSystemDataSource(t *testing.T) {
+	t.Run("adapts the installed operating system", func(t *testing.T) {
+		operatingSystemID := "UBUNTU_22_04_64BIT"
+		name := "Ubuntu 22.04 LTS (Jammy Jellyfish) (amd64)"
+		family := "ubuntu"
+		payload := dedicatedserver.ServerJobPayload{
+			OperatingSystemId: &operatingSystemID,
+			Os: &dedicatedserver.Os{
+				Name:   &name,
+				Family: &family,
+			},
+		}
+
+		got := adaptServerJobPayloadToOperatingSystemDataSource(payload)
+
+		require.NotNil(t, got)
+		assert.Equal(t, "UBUNTU_22_04_64BIT", got.ID.ValueString())
+		assert.Equal(t, name, got.Name.ValueString())
+		assert.Equal(t, "ubuntu", got.Family.ValueString())
+		assert.True(t, got.Version.IsNull())
+	})
+
+	t.Run("returns nil without an operating system", func(t *testing.T) {
+		assert.Nil(t, adaptServerJobPayloadToOperatingSystemDataSource(dedicatedserver.ServerJobPayload{}))
+	})
+}
+
+func Test_adaptServerJobPayloadToControlPanelDataSource(t *testing.T) {
+	t.Run("adapts the installed control panel", func(t *testing.T) {
+		payload := dedicatedserver.ServerJobPayload{
+			AdditionalProperties: map[string]interface{}{"controlPanelId": "PLESK_12"},
+		}
+
+		got := adaptServerJobPayloadToControlPanelDataSource(payload)
+
+		require.NotNil(t, got)
+		assert.Equal(t, "PLESK_12", got.ID.ValueString())
+	})
+
+	t.Run("returns nil without a control panel", func(t *testing.T) {
+		assert.Nil(t, adaptServerJobPayloadToControlPanelDataSource(dedicatedserver.ServerJobPayload{}))
+	})
+}
+
+func Test_getSwitchPort(t *testing.T) {
+	t.Run("the public uplink is preferred", func(t *testing.T) {
+		networkInterfaces := dedicatedserver.NetworkInterfaces{
+			Public: &dedicatedserver.NetworkInterface{
+				Ports: []dedicatedserver.Port{
+					{Name: dedicatedserver.PtrString("EVO-AABB-01"), Port: dedicatedserver.PtrString("30")},
+				},
+			},
+			Internal: &dedicatedserver.NetworkInterface{
+				Ports: []dedicatedserver.Port{
+					{Name: dedicatedserver.PtrString("EVO-CCDD-02"), Port: dedicatedserver.PtrString("12")},
+				},
+			},
+		}
+
+		switchName, switchPort := getSwitchPort(networkInterfaces)
+
+		require.NotNil(t, switchName)
+		require.NotNil(t, switchPort)
+		assert.Equal(t, "EVO-AABB-01", *switchName)
+		assert.Equal(t, "30", *switchPort)
+	})
+
+	t.Run("falls back to the internal uplink", func(t *testing.T) {
+		networkInterfaces := dedicatedserver.NetworkInterfaces{
+			Public: &dedicatedserver.NetworkInterface{
+				Ports: []dedicatedserver.Port{},
+			},
+			Internal: &dedicatedserver.NetworkInterface{
+				Ports: []dedicatedserver.Port{
+					{Name: dedicatedserver.PtrString("EVO-CCDD-02"), Port: dedicatedserver.PtrString("12")},
+				},
+			},
+		}
+
+		switchName, switchPort := getSwitchPort(networkInterfaces)
+
+		require.NotNil(t, switchName)
+		require.NotNil(t, switchPort)
+		assert.Equal(t, "EVO-CCDD-02", *switchName)
+		assert.Equal(t, "12", *switchPort)
+	})
+
+	t.Run("nil is returned without ports", func(t *testing.T) {
+		switchName, switchPort := getSwitchPort(dedicatedserver.NetworkInterfaces{})
+
+		assert.Nil(t, switchName)
+		assert.Nil(t, switchPort)
+	})
+}
@@ -42,6 +42,7 @@ type serverResourceModel struct {
 	RemoteManagementIP           types.String `tfsdk:"remote_management_ip"`
 	InternalMAC                  types.String `tfsdk:"internal_mac"`
 	Location                     types.Object `tfsdk:"location"`
+	ContractEndDate              types.String `tfsdk:"contract_end_date"`
 }
 
 type locationResourceModel struct {
@@ -127,6 +128,13 @@ func (s *serverResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"contract_end_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The date the contract of the dedicated server ends. Not set if the contract has no end date.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"location": schema.SingleNestedAttribute{
 				Computed: true,
 				Attributes: map[string]schema.Attribute{
@@ -194,8 +202,10 @@ func (s *serverResource) Read(
 	}
 
 	var reference string
+	contractEndDate := types.StringNull()
 	if contract, ok := server.GetContractOk(); ok {
 		reference = contract.GetReference()
+		contractEndDate = utils.AdaptNullableTimeToStringValue(contract.EndsAt.Get())
 	}
 
 	var internalMAC string
@@ -315,6 +325,7 @@ func (s *serverResource) Read(
 				RemoteManagementIP:           types.StringValue(remoteManagementIP),
 				InternalMAC:                  types.StringValue(internalMAC),
 				Location:                     location,
+				ContractEndDate:              contractEndDate,
 			},
 		)...,
 	)
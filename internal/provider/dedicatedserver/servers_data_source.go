@@ -2,10 +2,14 @@ package dedicatedserver
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
 
@@ -19,6 +23,7 @@ type serversDataSource struct {
 }
 
 type serversDataSourceModel struct {
+	Limit                 types.Int32    `tfsdk:"limit"`
 	IDs                   []types.String `tfsdk:"ids"`
 	Reference             types.String   `tfsdk:"reference"`
 	IP                    types.String   `tfsdk:"ip"`
@@ -29,16 +34,11 @@ type serversDataSourceModel struct {
 	PrivateNetworkEnabled types.String   `tfsdk:"private_network_enabled"`
 }
 
-func (s *serversDataSource) Read(
-	ctx context.Context,
-	req datasource.ReadRequest,
-	resp *datasource.ReadResponse,
-) {
-	var config serversDataSourceModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
-	// NOTE: we show only the latest 50 items.
-	request := s.DedicatedserverAPI.GetServerList(ctx).Limit(50)
-
+// filterServerList applies the configured filters to the request.
+func filterServerList(
+	request dedicatedserver.ApiGetServerListRequest,
+	config serversDataSourceModel,
+) dedicatedserver.ApiGetServerListRequest {
 	if !config.Reference.IsNull() && !config.Reference.IsUnknown() {
 		request = request.Reference(config.Reference.ValueString())
 	}
@@ -67,14 +67,47 @@ func (s *serversDataSource) Read(
 		request = request.PrivateNetworkEnabled(config.PrivateNetworkEnabled.ValueString())
 	}
 
-	var Ids []types.String
+	return request
+}
+
+func (s *serversDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config serversDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	result, response, err := request.Execute()
+	servers, response, err := utils.FetchPages(
+		ctx,
+		s.PaginationConcurrency,
+		config.Limit,
+		func(ctx context.Context, offset int32) (*utils.Page[dedicatedserver.Server], *http.Response, error) {
+			request := filterServerList(s.DedicatedserverAPI.GetServerList(ctx).Limit(50), config)
+			result, response, err := request.Offset(offset).Execute()
+			if err != nil {
+				return nil, response, err
+			}
+
+			metadata := result.GetMetadata()
+			return &utils.Page[dedicatedserver.Server]{
+				Items:      result.GetServers(),
+				Limit:      metadata.GetLimit(),
+				Offset:     metadata.GetOffset(),
+				TotalCount: metadata.GetTotalCount(),
+			}, response, nil
+		},
+	)
 	if err != nil {
 		utils.SdkError(ctx, &resp.Diagnostics, err, response)
 		return
 	}
-	for _, server := range result.GetServers() {
+
+	Ids := []types.String{}
+	for _, server := range servers {
 		Ids = append(Ids, types.StringValue(server.GetId()))
 	}
 
@@ -82,6 +115,7 @@ func (s *serversDataSource) Read(
 		resp.State.Set(
 			ctx,
 			serversDataSourceModel{
+				Limit:                 config.Limit,
 				IDs:                   Ids,
 				Reference:             config.Reference,
 				IP:                    config.IP,
@@ -102,6 +136,11 @@ func (s *serversDataSource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Maximum number of servers to return. All servers are returned by default.",
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+			},
 			"ids": schema.ListAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
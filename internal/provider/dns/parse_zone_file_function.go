@@ -0,0 +1,102 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ function.Function = &parseZoneFileFunction{}
+)
+
+func (r resourceRecordSetDataSourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":    types.StringType,
+		"type":    types.StringType,
+		"content": types.ListType{ElemType: types.StringType},
+		"ttl":     types.Int32Type,
+	}
+}
+
+func adaptZoneFileRecordSetToResourceRecordSetDataSource(
+	recordSet zoneFileRecordSet,
+) resourceRecordSetDataSourceModel {
+	return resourceRecordSetDataSourceModel{
+		Name:       basetypes.NewStringValue(recordSet.Name),
+		RecordType: basetypes.NewStringValue(recordSet.Type),
+		Content:    recordSet.Content,
+		TTL:        basetypes.NewInt32PointerValue(recordSet.TTL),
+	}
+}
+
+type parseZoneFileFunction struct{}
+
+func (p *parseZoneFileFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	response *function.MetadataResponse,
+) {
+	response.Name = "parse_zone_file"
+}
+
+func (p *parseZoneFileFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	response *function.DefinitionResponse,
+) {
+	response.Definition = function.Definition{
+		Summary:             "Parse a zone file into resource record sets",
+		MarkdownDescription: "Parses the content of an RFC 1035 (BIND) zone file into resource record sets with the same structure as the `resource_record_sets` of the `leaseweb_dns_resource_record_sets` data source, so a zone can be migrated with `for_each` over `leaseweb_dns_resource_record_set`. Records are grouped by name and type, names are fully qualified and records of types the API does not support are skipped. `ttl` is null for records without a TTL, and must be one of the TTLs the API accepts to be used as is.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "The content of the zone file. `$ORIGIN` and `$TTL` directives are supported, `$INCLUDE` and `$GENERATE` are not.",
+			},
+			function.StringParameter{
+				Name:                "origin",
+				MarkdownDescription: "The domain name relative names in the zone file are qualified with, until a `$ORIGIN` directive changes it.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: resourceRecordSetDataSourceModel{}.attributeTypes(),
+			},
+		},
+	}
+}
+
+func (p *parseZoneFileFunction) Run(
+	ctx context.Context,
+	request function.RunRequest,
+	response *function.RunResponse,
+) {
+	var content, origin string
+	response.Error = request.Arguments.Get(ctx, &content, &origin)
+	if response.Error != nil {
+		return
+	}
+
+	recordSets, err := parseZoneFile(content, origin)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result := []resourceRecordSetDataSourceModel{}
+	for _, recordSet := range recordSets {
+		result = append(
+			result,
+			adaptZoneFileRecordSetToResourceRecordSetDataSource(recordSet),
+		)
+	}
+
+	response.Error = response.Result.Set(ctx, result)
+}
+
+func NewParseZoneFileFunction() function.Function {
+	return &parseZoneFileFunction{}
+}
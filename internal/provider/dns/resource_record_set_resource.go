@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -23,18 +25,95 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &resourceRecordSetResource{}
-	_ resource.ResourceWithImportState = &resourceRecordSetResource{}
+	_ resource.ResourceWithConfigure      = &resourceRecordSetResource{}
+	_ resource.ResourceWithImportState    = &resourceRecordSetResource{}
+	_ resource.ResourceWithModifyPlan     = &resourceRecordSetResource{}
+	_ resource.ResourceWithValidateConfig = &resourceRecordSetResource{}
 )
 
 type resourceRecordSetResourceModel struct {
 	Content    types.List   `tfsdk:"content"`
+	CAA        types.List   `tfsdk:"caa"`
 	DomainName types.String `tfsdk:"domain_name"`
 	Name       types.String `tfsdk:"name"`
 	TTL        types.Int32  `tfsdk:"ttl"`
 	RecordType types.String `tfsdk:"type"`
 }
 
+type caaRecordResourceModel struct {
+	Flag  types.Int32  `tfsdk:"flag"`
+	Tag   types.String `tfsdk:"tag"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (c caaRecordResourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"flag":  types.Int32Type,
+		"tag":   types.StringType,
+		"value": types.StringType,
+	}
+}
+
+// formatCAA serializes a CAA record in the `flag tag "value"` format the API
+// expects.
+func formatCAA(flag int32, tag string, value string) string {
+	return fmt.Sprintf("%d %s %s", flag, strings.ToLower(tag), strconv.Quote(value))
+}
+
+// parseCAA splits CAA content into its flag, tag and value. The value may be
+// quoted.
+func parseCAA(content string) (*caaRecordResourceModel, error) {
+	fields := strings.SplitN(strings.TrimSpace(content), " ", 3)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("%q must be in the format `flag tag value`", content)
+	}
+
+	flag, err := strconv.ParseUint(fields[0], 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("flag must be a number between 0 and 255, got %q", fields[0])
+	}
+
+	value := strings.TrimSpace(fields[2])
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+
+	return &caaRecordResourceModel{
+		Flag:  basetypes.NewInt32Value(int32(flag)),
+		Tag:   basetypes.NewStringValue(strings.ToLower(fields[1])),
+		Value: basetypes.NewStringValue(value),
+	}, nil
+}
+
+// adaptContentToCAA returns the structured form of the content of a CAA
+// record set, null for other types.
+func adaptContentToCAA(
+	ctx context.Context,
+	recordType string,
+	content []string,
+) (types.List, diag.Diagnostics) {
+	elementType := types.ObjectType{AttrTypes: caaRecordResourceModel{}.attributeTypes()}
+	if recordType != string(dns.RESOURCERECORDSETTYPE_CAA) {
+		return types.ListNull(elementType), nil
+	}
+
+	var diags diag.Diagnostics
+	caa := make([]caaRecordResourceModel, 0, len(content))
+	for _, entry := range content {
+		record, err := parseCAA(entry)
+		if err != nil {
+			diags.AddError("Invalid CAA record", err.Error())
+			return types.ListNull(elementType), diags
+		}
+		caa = append(caa, *record)
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, elementType, caa)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
 func adaptResourceRecordSetDetailsToResourceRecordSetResourceResource(
 	domainName string,
 	resourceRecordSetDetails dns.ResourceRecordSetDetails,
@@ -51,9 +130,21 @@ func adaptResourceRecordSetDetailsToResourceRecordSetResourceResource(
 		return nil
 	}
 
+	// Content that is not valid CAA, e.g. created outside of Terraform, is
+	// kept in content only.
+	caa, diags := adaptContentToCAA(
+		ctx,
+		string(resourceRecordSetDetails.GetType()),
+		resourceRecordSetDetails.GetContent(),
+	)
+	if diags.HasError() {
+		caa = types.ListNull(types.ObjectType{AttrTypes: caaRecordResourceModel{}.attributeTypes()})
+	}
+
 	return &resourceRecordSetResourceModel{
 		DomainName: basetypes.NewStringValue(domainName),
 		Content:    content,
+		CAA:        caa,
 		Name:       basetypes.NewStringValue(resourceRecordSetDetails.GetName()),
 		TTL:        basetypes.NewInt32Value(int32(resourceRecordSetDetails.GetTtl())),
 		RecordType: basetypes.NewStringValue(string(resourceRecordSetDetails.GetType())),
@@ -64,37 +155,251 @@ type resourceRecordSetResource struct {
 	utils.ResourceAPI
 }
 
+// qualifyName turns a name relative to the domain, e.g. "www" or "@" for the
+// apex, into the fully qualified name the API uses.
+func qualifyName(domainName string, name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	domainName = strings.TrimSuffix(domainName, ".") + "."
+	if name == "@" {
+		return domainName
+	}
+
+	return name + "." + domainName
+}
+
 func (r *resourceRecordSetResource) ImportState(
 	ctx context.Context,
 	request resource.ImportStateRequest,
 	response *resource.ImportStateResponse,
 ) {
-	idParts := strings.Split(request.ID, ",")
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"domain_name", "name", "type"},
+		request,
+		response,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		utils.UnexpectedImportIdentifierError(
-			&response.Diagnostics,
-			"domain_name,name,type",
-			request.ID,
-		)
+	var domainName, name, recordType types.String
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("domain_name"), &domainName)...)
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("type"), &recordType)...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
-	response.Diagnostics.Append(response.State.SetAttribute(
-		ctx,
-		path.Root("domain_name"),
-		idParts[0],
-	)...)
-	response.Diagnostics.Append(response.State.SetAttribute(
-		ctx,
-		path.Root("name"),
-		idParts[1],
-	)...)
-	response.Diagnostics.Append(response.State.SetAttribute(
+	qualifiedName := qualifyName(domainName.ValueString(), name.ValueString())
+
+	// Fail early with a clear message, Read fills in the state afterwards.
+	_, httpResponse, err := r.DNSAPI.GetResourceRecordSet(
 		ctx,
-		path.Root("type"),
-		idParts[2],
-	)...)
+		domainName.ValueString(),
+		qualifiedName,
+		recordType.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.ImportError(ctx, &response.Diagnostics, request.ID, err, httpResponse)
+		return
+	}
+
+	response.Diagnostics.Append(
+		response.State.SetAttribute(ctx, path.Root("name"), qualifiedName)...,
+	)
+}
+
+// ModifyPlan applies the provider's default TTL to records without a TTL and
+// keeps content and caa in sync.
+func (r *resourceRecordSetResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.modifyPlanTTL(ctx, request, response)
+	modifyPlanCAA(ctx, request, response)
+}
+
+// modifyPlanCAA derives content from caa or the other way around, so the
+// plan shows both.
+func modifyPlanCAA(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	var recordType types.String
+	var content, caa types.List
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("type"), &recordType)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("content"), &content)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("caa"), &caa)...)
+	if response.Diagnostics.HasError() || recordType.IsUnknown() {
+		return
+	}
+
+	if !caa.IsNull() {
+		if caa.IsUnknown() {
+			return
+		}
+
+		var records []caaRecordResourceModel
+		response.Diagnostics.Append(caa.ElementsAs(ctx, &records, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		entries := make([]string, 0, len(records))
+		for _, record := range records {
+			if record.Flag.IsUnknown() || record.Tag.IsUnknown() || record.Value.IsUnknown() {
+				return
+			}
+			entries = append(entries, formatCAA(
+				record.Flag.ValueInt32(),
+				record.Tag.ValueString(),
+				record.Value.ValueString(),
+			))
+		}
+		response.Diagnostics.Append(
+			response.Plan.SetAttribute(ctx, path.Root("content"), entries)...,
+		)
+		return
+	}
+
+	if content.IsNull() || content.IsUnknown() {
+		return
+	}
+
+	var entries []types.String
+	response.Diagnostics.Append(content.ElementsAs(ctx, &entries, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	values := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsUnknown() {
+			return
+		}
+		values = append(values, entry.ValueString())
+	}
+
+	// Invalid content is reported by ValidateConfig.
+	planned, diags := adaptContentToCAA(ctx, recordType.ValueString(), values)
+	if diags.HasError() {
+		return
+	}
+	response.Diagnostics.Append(
+		response.Plan.SetAttribute(ctx, path.Root("caa"), planned)...,
+	)
+}
+
+func (r *resourceRecordSetResource) modifyPlanTTL(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	var ttl types.Int32
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("ttl"), &ttl)...)
+	if response.Diagnostics.HasError() || !ttl.IsNull() {
+		return
+	}
+
+	if r.DefaultDNSTTL != 0 {
+		response.Diagnostics.Append(
+			response.Plan.SetAttribute(ctx, path.Root("ttl"), r.DefaultDNSTTL)...,
+		)
+		return
+	}
+
+	var plannedTTL types.Int32
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("ttl"), &plannedTTL)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plannedTTL.IsUnknown() {
+		return
+	}
+
+	// Keep the current TTL of existing records.
+	if !request.State.Raw.IsNull() {
+		var currentTTL types.Int32
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("ttl"), &currentTTL)...)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("ttl"), currentTTL)...)
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		path.Root("ttl"),
+		"Missing TTL",
+		"Set ttl on the record or default_dns_ttl on the provider.",
+	)
+}
+
+// ValidateConfig checks the format of every content entry against the record
+// type, so typos fail at plan time.
+func (r *resourceRecordSetResource) ValidateConfig(
+	ctx context.Context,
+	request resource.ValidateConfigRequest,
+	response *resource.ValidateConfigResponse,
+) {
+	var recordType types.String
+	var content, caa types.List
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("type"), &recordType)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("content"), &content)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("caa"), &caa)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if content.IsNull() == caa.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid Attribute Combination",
+			"Exactly one of content or caa must be set.",
+		)
+		return
+	}
+
+	if !caa.IsNull() && !recordType.IsUnknown() &&
+		recordType.ValueString() != string(dns.RESOURCERECORDSETTYPE_CAA) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("caa"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("caa can only be set on CAA records, not on %s records.", recordType.ValueString()),
+		)
+		return
+	}
+
+	if recordType.IsNull() || recordType.IsUnknown() || content.IsNull() || content.IsUnknown() {
+		return
+	}
+
+	for i, element := range content.Elements() {
+		entry, ok := element.(types.String)
+		if !ok || entry.IsNull() || entry.IsUnknown() {
+			continue
+		}
+
+		err := validateContent(
+			dns.ResourceRecordSetType(recordType.ValueString()),
+			entry.ValueString(),
+		)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("content").AtListIndex(i),
+				"Invalid Content",
+				fmt.Sprintf("Invalid %s record content: %s.", recordType.ValueString(), err),
+			)
+		}
+	}
 }
 
 func (r *resourceRecordSetResource) Schema(
@@ -110,13 +415,50 @@ func (r *resourceRecordSetResource) Schema(
 		Attributes: map[string]schema.Attribute{
 			"content": schema.ListAttribute{
 				ElementType: types.StringType,
-				Required:    true,
-				Description: "Array of resource record set Content entries",
+				Optional:    true,
+				Computed:    true,
+				Description: "Array of resource record set Content entries. Exactly one of `content` and `caa` must be set.",
 				Validators: []validator.List{
 					listvalidator.NoNullValues(),
 					listvalidator.SizeAtLeast(1),
 				},
 			},
+			"caa": schema.ListNestedAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The entries of a CAA record set in structured form, as an alternative to `content`. Computed from `content` for CAA record sets that set it.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"flag": schema.Int32Attribute{
+							Required:    true,
+							Description: "The flags of the entry, 128 marks the tag as critical.",
+							Validators: []validator.Int32{
+								int32validator.Between(0, 255),
+							},
+						},
+						"tag": schema.StringAttribute{
+							Required: true,
+							Description: fmt.Sprintf(
+								"The property of the entry. Valid options are %s",
+								utils.StringTypeArrayToMarkdown(caaTags),
+							),
+							Validators: []validator.String{
+								stringvalidator.OneOf(caaTags...),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							Description: "The value of the property, such as the domain of a certificate authority for `issue` or a URL for `iodef`.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
 			"domain_name": schema.StringAttribute{
 				Required:    true,
 				Description: "Domain Name",
@@ -134,9 +476,10 @@ func (r *resourceRecordSetResource) Schema(
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"ttl": schema.Int32Attribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				Description: fmt.Sprintf(
-					"Time to live of the resource record set. Valid options are %s",
+					"Time to live of the resource record set. Defaults to the provider's `default_dns_ttl`, one of the two must be set. Valid options are %s",
 					ttl.Markdown(),
 				),
 				Validators: []validator.Int32{
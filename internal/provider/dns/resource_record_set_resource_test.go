@@ -0,0 +1,144 @@
+package dns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_qualifyName(t *testing.T) {
+	t.Run("fully qualified names are kept", func(t *testing.T) {
+		got := qualifyName("example.com", "www.example.com.")
+
+		assert.Equal(t, "www.example.com.", got)
+	})
+
+	t.Run("relative names are qualified", func(t *testing.T) {
+		got := qualifyName("example.com", "www")
+
+		assert.Equal(t, "www.example.com.", got)
+	})
+
+	t.Run("@ is the apex", func(t *testing.T) {
+		got := qualifyName("example.com", "@")
+
+		assert.Equal(t, "example.com.", got)
+	})
+
+	t.Run("domain names with a trailing dot are supported", func(t *testing.T) {
+		got := qualifyName("example.com.", "www")
+
+		assert.Equal(t, "www.example.com.", got)
+	})
+}
+
+func Test_formatCAA(t *testing.T) {
+	got := formatCAA(128, "Issue", "letsencrypt.org")
+
+	assert.Equal(t, `128 issue "letsencrypt.org"`, got)
+}
+
+func Test_parseCAA(t *testing.T) {
+	t.Run("quoted values are unquoted", func(t *testing.T) {
+		got, err := parseCAA(`0 issue "letsencrypt.org"`)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), got.Flag.ValueInt32())
+		assert.Equal(t, "issue", got.Tag.ValueString())
+		assert.Equal(t, "letsencrypt.org", got.Value.ValueString())
+	})
+
+	t.Run("unquoted values are kept", func(t *testing.T) {
+		got, err := parseCAA("0 iodef mailto:security@example.com")
+
+		require.NoError(t, err)
+		assert.Equal(t, "iodef", got.Tag.ValueString())
+		assert.Equal(t, "mailto:security@example.com", got.Value.ValueString())
+	})
+
+	t.Run("formatted records are parsed back", func(t *testing.T) {
+		got, err := parseCAA(formatCAA(128, "issuewild", `ca.example.com; account="1"`))
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(128), got.Flag.ValueInt32())
+		assert.Equal(t, `ca.example.com; account="1"`, got.Value.ValueString())
+	})
+
+	t.Run("errors on a missing value", func(t *testing.T) {
+		_, err := parseCAA("0 issue")
+
+		assert.ErrorContains(t, err, "must be in the format")
+	})
+
+	t.Run("errors on an invalid flag", func(t *testing.T) {
+		_, err := parseCAA(`256 issue "letsencrypt.org"`)
+
+		assert.ErrorContains(t, err, "flag must be a number between 0 and 255")
+	})
+}
+
+func Test_adaptContentToCAA(t *testing.T) {
+	t.Run("other record types have no caa", func(t *testing.T) {
+		got, diags := adaptContentToCAA(context.TODO(), "A", []string{"127.0.0.1"})
+
+		assert.False(t, diags.HasError())
+		assert.True(t, got.IsNull())
+	})
+
+	t.Run("CAA content is structured", func(t *testing.T) {
+		got, diags := adaptContentToCAA(
+			context.TODO(),
+			"CAA",
+			[]string{`0 issue "letsencrypt.org"`, `0 iodef "mailto:security@example.com"`},
+		)
+
+		require.False(t, diags.HasError())
+		var records []caaRecordResourceModel
+		got.ElementsAs(context.TODO(), &records, false)
+		assert.Len(t, records, 2)
+		assert.Equal(t, "iodef", records[1].Tag.ValueString())
+	})
+
+	t.Run("invalid CAA content returns an error", func(t *testing.T) {
+		_, diags := adaptContentToCAA(context.TODO(), "CAA", []string{"tralala"})
+
+		assert.True(t, diags.HasError())
+	})
+}
+
+// Record sets only gained attributes since they were added, which Terraform
+// reads as null from older state, so their schema has not been versioned.
+func TestResourceRecordSetResource_stateWithoutCAA(t *testing.T) {
+	ctx := context.TODO()
+	stateWithoutCAA := `{
+  "content": ["85.17.150.51"],
+  "domain_name": "example.com",
+  "name": "www.example.com.",
+  "ttl": 3600,
+  "type": "A"
+}`
+
+	schemaResponse := resource.SchemaResponse{}
+	(&resourceRecordSetResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	require.Equal(t, int64(0), schemaResponse.Schema.Version)
+
+	value, err := tftypes.ValueFromJSONWithOpts(
+		[]byte(stateWithoutCAA),
+		schemaResponse.Schema.Type().TerraformType(ctx),
+		tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	)
+	require.NoError(t, err)
+
+	state := tfsdk.State{Raw: value, Schema: schemaResponse.Schema}
+	got := resourceRecordSetResourceModel{}
+	diags := state.Get(ctx, &got)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "www.example.com.", got.Name.ValueString())
+	assert.True(t, got.CAA.IsNull())
+}
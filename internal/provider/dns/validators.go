@@ -0,0 +1,81 @@
+package dns
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dns"
+)
+
+var caaTags = []string{"issue", "issuewild", "iodef"}
+
+// validateContent performs a sanity check of a single content entry of the
+// given record type. Types without a well-known format are not checked.
+func validateContent(recordType dns.ResourceRecordSetType, content string) error {
+	fields := strings.Fields(content)
+
+	switch recordType {
+	case dns.RESOURCERECORDSETTYPE_A:
+		address, err := netip.ParseAddr(content)
+		if err != nil || !address.Is4() {
+			return fmt.Errorf("%q is not a valid IPv4 address", content)
+		}
+	case dns.RESOURCERECORDSETTYPE_AAAA:
+		address, err := netip.ParseAddr(content)
+		if err != nil || !address.Is6() || address.Is4In6() {
+			return fmt.Errorf("%q is not a valid IPv6 address", content)
+		}
+	case dns.RESOURCERECORDSETTYPE_CNAME, dns.RESOURCERECORDSETTYPE_NS:
+		if len(fields) != 1 {
+			return fmt.Errorf("%q must be a single hostname", content)
+		}
+	case dns.RESOURCERECORDSETTYPE_MX:
+		if len(fields) != 2 {
+			return fmt.Errorf("%q must be in the format `priority hostname`", content)
+		}
+		if err := validateUint16("priority", fields[0]); err != nil {
+			return err
+		}
+	case dns.RESOURCERECORDSETTYPE_SRV:
+		if len(fields) != 4 {
+			return fmt.Errorf("%q must be in the format `priority weight port target`", content)
+		}
+		for i, name := range []string{"priority", "weight", "port"} {
+			if err := validateUint16(name, fields[i]); err != nil {
+				return err
+			}
+		}
+	case dns.RESOURCERECORDSETTYPE_CAA:
+		if len(fields) < 3 {
+			return fmt.Errorf("%q must be in the format `flags tag value`", content)
+		}
+		if _, err := strconv.ParseUint(fields[0], 10, 8); err != nil {
+			return fmt.Errorf("flags must be a number between 0 and 255, got %q", fields[0])
+		}
+		if !isCAATag(fields[1]) {
+			return fmt.Errorf("tag must be one of %s, got %q", strings.Join(caaTags, ", "), fields[1])
+		}
+	}
+
+	return nil
+}
+
+func validateUint16(name string, value string) error {
+	if _, err := strconv.ParseUint(value, 10, 16); err != nil {
+		return fmt.Errorf("%s must be a number between 0 and 65535, got %q", name, value)
+	}
+
+	return nil
+}
+
+func isCAATag(tag string) bool {
+	for _, caaTag := range caaTags {
+		if strings.EqualFold(tag, caaTag) {
+			return true
+		}
+	}
+
+	return false
+}
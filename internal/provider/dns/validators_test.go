@@ -0,0 +1,112 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateContent(t *testing.T) {
+	tests := []struct {
+		name       string
+		recordType dns.ResourceRecordSetType
+		content    string
+		wantErr    string
+	}{
+		{
+			name:       "valid A record",
+			recordType: dns.RESOURCERECORDSETTYPE_A,
+			content:    "85.17.150.51",
+		},
+		{
+			name:       "A record with an IPv6 address",
+			recordType: dns.RESOURCERECORDSETTYPE_A,
+			content:    "2001:db8::1",
+			wantErr:    `"2001:db8::1" is not a valid IPv4 address`,
+		},
+		{
+			name:       "A record with a typo",
+			recordType: dns.RESOURCERECORDSETTYPE_A,
+			content:    "85.17.150.510",
+			wantErr:    `"85.17.150.510" is not a valid IPv4 address`,
+		},
+		{
+			name:       "valid AAAA record",
+			recordType: dns.RESOURCERECORDSETTYPE_AAAA,
+			content:    "2001:db8::1",
+		},
+		{
+			name:       "AAAA record with an IPv4 address",
+			recordType: dns.RESOURCERECORDSETTYPE_AAAA,
+			content:    "85.17.150.51",
+			wantErr:    `"85.17.150.51" is not a valid IPv6 address`,
+		},
+		{
+			name:       "valid CNAME record",
+			recordType: dns.RESOURCERECORDSETTYPE_CNAME,
+			content:    "www.example.com.",
+		},
+		{
+			name:       "CNAME record with several hostnames",
+			recordType: dns.RESOURCERECORDSETTYPE_CNAME,
+			content:    "www.example.com. example.com.",
+			wantErr:    "must be a single hostname",
+		},
+		{
+			name:       "valid MX record",
+			recordType: dns.RESOURCERECORDSETTYPE_MX,
+			content:    "10 mail.example.com.",
+		},
+		{
+			name:       "MX record without priority",
+			recordType: dns.RESOURCERECORDSETTYPE_MX,
+			content:    "mail.example.com.",
+			wantErr:    "must be in the format `priority hostname`",
+		},
+		{
+			name:       "MX record with an invalid priority",
+			recordType: dns.RESOURCERECORDSETTYPE_MX,
+			content:    "high mail.example.com.",
+			wantErr:    `priority must be a number between 0 and 65535, got "high"`,
+		},
+		{
+			name:       "valid SRV record",
+			recordType: dns.RESOURCERECORDSETTYPE_SRV,
+			content:    "10 5 5060 sip.example.com.",
+		},
+		{
+			name:       "SRV record with an invalid port",
+			recordType: dns.RESOURCERECORDSETTYPE_SRV,
+			content:    "10 5 70000 sip.example.com.",
+			wantErr:    `port must be a number between 0 and 65535, got "70000"`,
+		},
+		{
+			name:       "valid CAA record",
+			recordType: dns.RESOURCERECORDSETTYPE_CAA,
+			content:    `0 issue "letsencrypt.org"`,
+		},
+		{
+			name:       "CAA record with an unknown tag",
+			recordType: dns.RESOURCERECORDSETTYPE_CAA,
+			content:    `0 isue "letsencrypt.org"`,
+			wantErr:    `tag must be one of issue, issuewild, iodef, got "isue"`,
+		},
+		{
+			name:       "TXT records are not checked",
+			recordType: dns.RESOURCERECORDSETTYPE_TXT,
+			content:    "v=spf1 -all",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateContent(tt.recordType, tt.content)
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
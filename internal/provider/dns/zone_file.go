@@ -0,0 +1,285 @@
+package dns
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dns"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+// zoneFileRecordSet is a resource record set read from a zone file.
+type zoneFileRecordSet struct {
+	Name    string
+	Type    string
+	Content []string
+	TTL     *int32
+}
+
+// zoneFileEntry is a directive or record of a zone file, with the lines
+// continued by parentheses joined.
+type zoneFileEntry struct {
+	line int
+	// blankOwner is set for records that start with whitespace, which reuse
+	// the owner of the previous record.
+	blankOwner bool
+	tokens     []string
+}
+
+var zoneFileClasses = []string{"IN", "CS", "CH", "HS"}
+
+// zoneFileDomainNameFields lists, per record type, the content fields that
+// hold domain names. Relative names in them are qualified with the origin.
+var zoneFileDomainNameFields = map[string][]int{
+	string(dns.RESOURCERECORDSETTYPE_CNAME): {0},
+	string(dns.RESOURCERECORDSETTYPE_NS):    {0},
+	string(dns.RESOURCERECORDSETTYPE_MX):    {1},
+	string(dns.RESOURCERECORDSETTYPE_SRV):   {3},
+	string(dns.RESOURCERECORDSETTYPE_SOA):   {0, 1},
+}
+
+// splitZoneFile splits an RFC 1035 zone file into its entries. Comments are
+// dropped and quoted strings are kept as single tokens, quotes included.
+func splitZoneFile(content string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	var entry *zoneFileEntry
+	depth := 0
+
+	for i, line := range strings.Split(content, "\n") {
+		lineNumber := i + 1
+		if entry == nil {
+			entry = &zoneFileEntry{
+				line:       lineNumber,
+				blankOwner: line != "" && unicode.IsSpace(rune(line[0])),
+			}
+		}
+
+		var token strings.Builder
+		flush := func() {
+			if token.Len() > 0 {
+				entry.tokens = append(entry.tokens, token.String())
+				token.Reset()
+			}
+		}
+
+		inQuote := false
+		for j := 0; j < len(line); j++ {
+			c := line[j]
+			switch {
+			case inQuote:
+				token.WriteByte(c)
+				if c == '\\' && j+1 < len(line) {
+					j++
+					token.WriteByte(line[j])
+				} else if c == '"' {
+					inQuote = false
+				}
+			case c == ';':
+				j = len(line)
+			case c == '"':
+				token.WriteByte(c)
+				inQuote = true
+			case c == '(':
+				flush()
+				depth++
+			case c == ')':
+				flush()
+				depth--
+				if depth < 0 {
+					return nil, fmt.Errorf("line %d: unexpected \")\"", lineNumber)
+				}
+			case c == ' ' || c == '\t' || c == '\r':
+				flush()
+			default:
+				token.WriteByte(c)
+			}
+		}
+		if inQuote {
+			return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+		}
+		flush()
+
+		if depth > 0 {
+			continue
+		}
+		if len(entry.tokens) > 0 {
+			entries = append(entries, *entry)
+		}
+		entry = nil
+	}
+
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: missing \")\"", entry.line)
+	}
+
+	return entries, nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds, or in BIND's unit notation such
+// as "1h30m".
+func parseZoneFileTTL(value string) (int32, error) {
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+	var total, number int64
+	hasNumber := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= '0' && c <= '9':
+			number = number*10 + int64(c-'0')
+			hasNumber = true
+		case hasNumber && units[c|0x20] != 0:
+			total += number * units[c|0x20]
+			number = 0
+			hasNumber = false
+		default:
+			return 0, fmt.Errorf("%q is not a valid TTL", value)
+		}
+		if number > math.MaxInt32 || total > math.MaxInt32 {
+			return 0, fmt.Errorf("TTL %q is too large", value)
+		}
+	}
+	total += number
+	if total > math.MaxInt32 {
+		return 0, fmt.Errorf("TTL %q is too large", value)
+	}
+
+	return int32(total), nil
+}
+
+// qualifyZoneFileName makes a name relative to origin absolute. "@" is the
+// origin itself.
+func qualifyZoneFileName(name string, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	}
+
+	return name + "." + origin
+}
+
+// parseZoneFile reads the resource record sets of an RFC 1035 zone file.
+// Records are grouped into record sets by name and type, in the order they
+// first appear. Records of types the API does not support are skipped.
+func parseZoneFile(content string, origin string) ([]zoneFileRecordSet, error) {
+	origin = strings.TrimSpace(origin)
+	if origin == "" || origin == "." {
+		return nil, fmt.Errorf("origin must be a domain name")
+	}
+	if !strings.HasSuffix(origin, ".") {
+		origin += "."
+	}
+
+	entries, err := splitZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordSets []zoneFileRecordSet
+	index := map[string]int{}
+	var defaultTTL, lastTTL *int32
+	lastOwner := ""
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if strings.HasPrefix(tokens[0], "$") {
+			directive := strings.ToUpper(tokens[0])
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN needs a domain name", entry.line)
+				}
+				origin = qualifyZoneFileName(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL needs a TTL", entry.line)
+				}
+				ttl, err := parseZoneFileTTL(tokens[1])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", entry.line, err)
+				}
+				defaultTTL = &ttl
+			default:
+				return nil, fmt.Errorf("line %d: %s is not supported", entry.line, tokens[0])
+			}
+			continue
+		}
+
+		owner := lastOwner
+		if !entry.blankOwner {
+			owner = qualifyZoneFileName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no name", entry.line)
+		}
+		lastOwner = owner
+
+		// The TTL and class are optional and may come in either order.
+		var ttl *int32
+		for len(tokens) > 0 {
+			if slices.Contains(zoneFileClasses, strings.ToUpper(tokens[0])) {
+				tokens = tokens[1:]
+				continue
+			}
+			if tokens[0][0] >= '0' && tokens[0][0] <= '9' {
+				value, err := parseZoneFileTTL(tokens[0])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", entry.line, err)
+				}
+				ttl = &value
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record needs a type and content", entry.line)
+		}
+
+		if ttl != nil {
+			lastTTL = ttl
+		} else if defaultTTL != nil {
+			ttl = defaultTTL
+		} else {
+			ttl = lastTTL
+		}
+
+		recordType := strings.ToUpper(tokens[0])
+		if !slices.Contains(
+			utils.AdaptStringTypeArrayToStringArray(dns.AllowedResourceRecordSetTypeEnumValues),
+			recordType,
+		) {
+			continue
+		}
+
+		fields := slices.Clone(tokens[1:])
+		for _, i := range zoneFileDomainNameFields[recordType] {
+			if i < len(fields) && !strings.HasPrefix(fields[i], "\"") {
+				fields[i] = qualifyZoneFileName(fields[i], origin)
+			}
+		}
+		recordContent := strings.Join(fields, " ")
+
+		key := owner + " " + recordType
+		if i, ok := index[key]; ok {
+			recordSets[i].Content = append(recordSets[i].Content, recordContent)
+			continue
+		}
+		index[key] = len(recordSets)
+		recordSets = append(recordSets, zoneFileRecordSet{
+			Name:    owner,
+			Type:    recordType,
+			Content: []string{recordContent},
+			TTL:     ttl,
+		})
+	}
+
+	return recordSets, nil
+}
@@ -0,0 +1,203 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseZoneFile(t *testing.T) {
+	t.Run("record sets are parsed", func(t *testing.T) {
+		zoneFile := `$TTL 3600
+@	IN	SOA	ns0 postmaster (
+		2024101701 ; serial
+		10800 3600 1209600 3600 )
+	IN	NS	ns0.nameserver.com.
+	IN	MX	10 mail
+	IN	MX	20 mailfilter.leaseweb.com.
+@	300	IN	A	85.17.150.51
+	300	IN	A	85.17.150.52
+www	IN	CNAME	@
+@	IN	TXT	"v=spf1 a mx -all" ; sender policy
+_sip._tcp	IN	SRV	10 5 5060 sip
+`
+
+		got, err := parseZoneFile(zoneFile, "example.com")
+
+		require.NoError(t, err)
+		ttl := func(value int32) *int32 { return &value }
+		assert.Equal(t, []zoneFileRecordSet{
+			{
+				Name:    "example.com.",
+				Type:    "SOA",
+				Content: []string{"ns0.example.com. postmaster.example.com. 2024101701 10800 3600 1209600 3600"},
+				TTL:     ttl(3600),
+			},
+			{
+				Name:    "example.com.",
+				Type:    "NS",
+				Content: []string{"ns0.nameserver.com."},
+				TTL:     ttl(3600),
+			},
+			{
+				Name:    "example.com.",
+				Type:    "MX",
+				Content: []string{"10 mail.example.com.", "20 mailfilter.leaseweb.com."},
+				TTL:     ttl(3600),
+			},
+			{
+				Name:    "example.com.",
+				Type:    "A",
+				Content: []string{"85.17.150.51", "85.17.150.52"},
+				TTL:     ttl(300),
+			},
+			{
+				Name:    "www.example.com.",
+				Type:    "CNAME",
+				Content: []string{"example.com."},
+				TTL:     ttl(3600),
+			},
+			{
+				Name:    "example.com.",
+				Type:    "TXT",
+				Content: []string{`"v=spf1 a mx -all"`},
+				TTL:     ttl(3600),
+			},
+			{
+				Name:    "_sip._tcp.example.com.",
+				Type:    "SRV",
+				Content: []string{"10 5 5060 sip.example.com."},
+				TTL:     ttl(3600),
+			},
+		}, got)
+	})
+
+	t.Run("$ORIGIN changes the origin", func(t *testing.T) {
+		zoneFile := `$ORIGIN example.org.
+www 3600 IN A 85.17.150.51
+$ORIGIN sub
+www 3600 IN A 85.17.150.52
+`
+
+		got, err := parseZoneFile(zoneFile, "example.com.")
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, "www.example.org.", got[0].Name)
+		assert.Equal(t, "www.sub.example.org.", got[1].Name)
+	})
+
+	t.Run("records without TTL inherit the previous one", func(t *testing.T) {
+		zoneFile := `www 1h A 85.17.150.51
+mail A 85.17.150.52
+`
+
+		got, err := parseZoneFile(zoneFile, "example.com")
+
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, int32(3600), *got[1].TTL)
+	})
+
+	t.Run("TTL is nil if the zone file has none", func(t *testing.T) {
+		got, err := parseZoneFile("www IN A 85.17.150.51", "example.com")
+
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Nil(t, got[0].TTL)
+	})
+
+	t.Run("unsupported record types are skipped", func(t *testing.T) {
+		zoneFile := `www 3600 IN A 85.17.150.51
+51 3600 IN PTR www
+`
+
+		got, err := parseZoneFile(zoneFile, "example.com")
+
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "A", got[0].Type)
+	})
+
+	t.Run("errors are reported with their line", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			zoneFile string
+			want     string
+		}{
+			{
+				name:     "unsupported directive",
+				zoneFile: "$TTL 3600\n$INCLUDE other.zone",
+				want:     "line 2: $INCLUDE is not supported",
+			},
+			{
+				name:     "invalid TTL",
+				zoneFile: "www 1x IN A 85.17.150.51",
+				want:     `line 1: "1x" is not a valid TTL`,
+			},
+			{
+				name:     "missing content",
+				zoneFile: "www 3600 IN A",
+				want:     "line 1: record needs a type and content",
+			},
+			{
+				name:     "missing owner",
+				zoneFile: "\tIN A 85.17.150.51",
+				want:     "line 1: record has no name",
+			},
+			{
+				name:     "unclosed parenthesis",
+				zoneFile: "@ IN SOA ns0 postmaster (\n1 2 3 4 5",
+				want:     `line 1: missing ")"`,
+			},
+			{
+				name:     "unterminated quoted string",
+				zoneFile: `@ IN TXT "v=spf1`,
+				want:     "line 1: unterminated quoted string",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := parseZoneFile(tt.zoneFile, "example.com")
+
+				assert.EqualError(t, err, tt.want)
+			})
+		}
+	})
+
+	t.Run("origin is required", func(t *testing.T) {
+		_, err := parseZoneFile("www IN A 85.17.150.51", "")
+
+		assert.EqualError(t, err, "origin must be a domain name")
+	})
+}
+
+func Test_parseZoneFileTTL(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int32
+	}{
+		{value: "300", want: 300},
+		{value: "1h", want: 3600},
+		{value: "1h30m", want: 5400},
+		{value: "1W", want: 604800},
+		{value: "1d12h", want: 129600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseZoneFileTTL(tt.value)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("value is too large", func(t *testing.T) {
+		_, err := parseZoneFileTTL("99999999999")
+
+		assert.EqualError(t, err, `TTL "99999999999" is too large`)
+	})
+}
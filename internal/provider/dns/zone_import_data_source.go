@@ -0,0 +1,209 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dns"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &zoneImportDataSource{}
+)
+
+var invalidResourceNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+type zoneImportDataSourceModel struct {
+	DomainName         types.String                                 `tfsdk:"domain_name"`
+	ImportBlocks       types.String                                 `tfsdk:"import_blocks"`
+	ResourceRecordSets []zoneImportResourceRecordSetDataSourceModel `tfsdk:"resource_record_sets"`
+}
+
+type zoneImportResourceRecordSetDataSourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	RecordType   types.String `tfsdk:"type"`
+	Content      []string     `tfsdk:"content"`
+	TTL          types.Int32  `tfsdk:"ttl"`
+	ImportID     types.String `tfsdk:"import_id"`
+	ResourceName types.String `tfsdk:"resource_name"`
+}
+
+// generateResourceName derives a Terraform resource name from the record
+// name relative to the domain and the record type, e.g. "a_www". The apex is
+// named "apex" and a leading wildcard "wildcard".
+func generateResourceName(domainName string, name string, recordType string) string {
+	relativeName := strings.TrimSuffix(strings.TrimSuffix(name, "."), domainName)
+	relativeName = strings.TrimSuffix(relativeName, ".")
+	if relativeName == "" {
+		relativeName = "apex"
+	}
+	if strings.HasPrefix(relativeName, "*") {
+		relativeName = "wildcard" + strings.TrimPrefix(relativeName, "*")
+	}
+
+	resourceName := strings.ToLower(recordType + "_" + relativeName)
+
+	return strings.Trim(invalidResourceNameCharacters.ReplaceAllString(resourceName, "_"), "_")
+}
+
+func adaptResourceRecordSetsToZoneImportDataSource(
+	domainName string,
+	resourceRecordSets []dns.ResourceRecordSetDetails,
+) zoneImportDataSourceModel {
+	zoneImport := zoneImportDataSourceModel{
+		DomainName:         basetypes.NewStringValue(domainName),
+		ResourceRecordSets: []zoneImportResourceRecordSetDataSourceModel{},
+	}
+
+	var importBlocks []string
+	resourceNames := map[string]int{}
+
+	for _, resourceRecordSet := range resourceRecordSets {
+		recordType := string(resourceRecordSet.GetType())
+		importID := strings.Join(
+			[]string{domainName, resourceRecordSet.GetName(), recordType},
+			"/",
+		)
+
+		// Names that only differ in characters Terraform does not allow
+		// would otherwise collide.
+		resourceName := generateResourceName(domainName, resourceRecordSet.GetName(), recordType)
+		resourceNames[resourceName]++
+		if resourceNames[resourceName] > 1 {
+			resourceName = fmt.Sprintf("%s_%d", resourceName, resourceNames[resourceName])
+		}
+
+		zoneImport.ResourceRecordSets = append(
+			zoneImport.ResourceRecordSets,
+			zoneImportResourceRecordSetDataSourceModel{
+				Name:         basetypes.NewStringValue(resourceRecordSet.GetName()),
+				RecordType:   basetypes.NewStringValue(recordType),
+				Content:      resourceRecordSet.GetContent(),
+				TTL:          basetypes.NewInt32Value(int32(resourceRecordSet.GetTtl())),
+				ImportID:     basetypes.NewStringValue(importID),
+				ResourceName: basetypes.NewStringValue(resourceName),
+			},
+		)
+
+		importBlocks = append(
+			importBlocks,
+			fmt.Sprintf(
+				"import {\n  to = leaseweb_dns_resource_record_set.%s\n  id = %q\n}\n",
+				resourceName,
+				importID,
+			),
+		)
+	}
+
+	zoneImport.ImportBlocks = basetypes.NewStringValue(strings.Join(importBlocks, "\n"))
+
+	return zoneImport
+}
+
+type zoneImportDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (z *zoneImportDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: "Lists all resource record sets of a domain with the identifiers needed to import them as `leaseweb_dns_resource_record_set` resources. Write `import_blocks` to a `.tf` file and run `terraform plan -generate-config-out=records.tf` to onboard the whole zone at once.",
+		Attributes: map[string]schema.Attribute{
+			"domain_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Domain Name",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"import_blocks": schema.StringAttribute{
+				Computed:    true,
+				Description: "Terraform `import` blocks for all resource record sets",
+			},
+			"resource_record_sets": schema.ListNestedAttribute{
+				Description: "Array of resource record sets",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the resource record set",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the resource record set",
+						},
+						"content": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Array of resource record set Content entries",
+						},
+						"ttl": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Time to live of the resource record set",
+						},
+						"import_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifier to import the resource record set with, in the format `domain_name/name/type`",
+						},
+						"resource_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "A unique resource name derived from the record type and name, used in `import_blocks`",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (z *zoneImportDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config zoneImportDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	result, httpResponse, err := z.DNSAPI.GetResourceRecordSetList(
+		ctx,
+		config.DomainName.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	response.Diagnostics.Append(
+		response.State.Set(
+			ctx,
+			adaptResourceRecordSetsToZoneImportDataSource(
+				config.DomainName.ValueString(),
+				result.GetResourceRecordSets(),
+			),
+		)...,
+	)
+}
+
+func NewZoneImportDataSource() datasource.DataSource {
+	return &zoneImportDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "dns_zone_import",
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_generateResourceName(t *testing.T) {
+	t.Run("apex records are named apex", func(t *testing.T) {
+		assert.Equal(t, "a_apex", generateResourceName("example.com", "example.com.", "A"))
+	})
+
+	t.Run("wildcard records are named wildcard", func(t *testing.T) {
+		assert.Equal(t, "cname_wildcard", generateResourceName("example.com", "*.example.com.", "CNAME"))
+		assert.Equal(t, "a_wildcard_dev", generateResourceName("example.com", "*.dev.example.com.", "A"))
+	})
+
+	t.Run("invalid characters are replaced", func(t *testing.T) {
+		assert.Equal(t, "txt_dkim__domainkey", generateResourceName("example.com", "dkim._domainkey.example.com.", "TXT"))
+		assert.Equal(t, "aaaa_my_host", generateResourceName("example.com", "my-host.example.com.", "AAAA"))
+	})
+}
+
+func Test_adaptResourceRecordSetsToZoneImportDataSource(t *testing.T) {
+	got := adaptResourceRecordSetsToZoneImportDataSource(
+		"example.com",
+		[]dns.ResourceRecordSetDetails{
+			{
+				Name:    "example.com.",
+				Type:    dns.RESOURCERECORDSETTYPE_A,
+				Content: []string{"192.0.2.1", "192.0.2.2"},
+				Ttl:     dns.TTL__300,
+			},
+			{
+				Name:    "my-host.example.com.",
+				Type:    dns.RESOURCERECORDSETTYPE_A,
+				Content: []string{"192.0.2.3"},
+				Ttl:     dns.TTL__300,
+			},
+			{
+				Name:    "my_host.example.com.",
+				Type:    dns.RESOURCERECORDSETTYPE_A,
+				Content: []string{"192.0.2.4"},
+				Ttl:     dns.TTL__300,
+			},
+		},
+	)
+
+	assert.Len(t, got.ResourceRecordSets, 3)
+	assert.Equal(t, "example.com/example.com./A", got.ResourceRecordSets[0].ImportID.ValueString())
+	assert.Equal(t, []string{"192.0.2.1", "192.0.2.2"}, got.ResourceRecordSets[0].Content)
+	assert.Equal(t, "a_my_host", got.ResourceRecordSets[1].ResourceName.ValueString())
+	assert.Equal(t, "a_my_host_2", got.ResourceRecordSets[2].ResourceName.ValueString())
+	assert.Contains(
+		t,
+		got.ImportBlocks.ValueString(),
+		"import {\n  to = leaseweb_dns_resource_record_set.a_apex\n  id = \"example.com/example.com./A\"\n}\n",
+	)
+}
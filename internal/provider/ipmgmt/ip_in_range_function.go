@@ -0,0 +1,91 @@
+package ipmgmt
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &ipInRangeFunction{}
+)
+
+// ipInRange reports whether an IP address is part of a range in CIDR
+// notation. Addresses of the other IP version are never in the range.
+func ipInRange(ipValue string, rangeValue string) (bool, *function.FuncError) {
+	ip, err := netip.ParseAddr(ipValue)
+	if err != nil || ip.Zone() != "" {
+		return false, function.NewArgumentFuncError(
+			0,
+			fmt.Sprintf("the value must be a valid IPv4 or IPv6 address, but got %s", ipValue),
+		)
+	}
+
+	prefix, err := netip.ParsePrefix(rangeValue)
+	if err != nil {
+		return false, function.NewArgumentFuncError(
+			1,
+			fmt.Sprintf("the value must be a range in CIDR notation such as 85.17.150.0/24, but got %s", rangeValue),
+		)
+	}
+
+	return prefix.Contains(ip.Unmap()), nil
+}
+
+type ipInRangeFunction struct{}
+
+func (i *ipInRangeFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	response *function.MetadataResponse,
+) {
+	response.Name = "ip_in_range"
+}
+
+func (i *ipInRangeFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	response *function.DefinitionResponse,
+) {
+	response.Definition = function.Definition{
+		Summary:             "Check whether an IP address is part of a range",
+		MarkdownDescription: "Returns true if the IP address is part of the range, e.g. to validate addresses against the ranges delivered with a server. An IPv4 address is never part of an IPv6 range, and vice versa.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ip",
+				MarkdownDescription: "An IPv4 or IPv6 address.",
+			},
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "The range in CIDR notation, e.g. `85.17.150.0/24` or `2001:db8::/64`.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (i *ipInRangeFunction) Run(
+	ctx context.Context,
+	request function.RunRequest,
+	response *function.RunResponse,
+) {
+	var ip, cidr string
+	response.Error = request.Arguments.Get(ctx, &ip, &cidr)
+	if response.Error != nil {
+		return
+	}
+
+	inRange, funcErr := ipInRange(ip, cidr)
+	if funcErr != nil {
+		response.Error = funcErr
+		return
+	}
+
+	response.Error = response.Result.Set(ctx, inRange)
+}
+
+func NewIPInRangeFunction() function.Function {
+	return &ipInRangeFunction{}
+}
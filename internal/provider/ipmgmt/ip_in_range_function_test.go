@@ -0,0 +1,51 @@
+package ipmgmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ipInRange(t *testing.T) {
+	t.Run("address in the range", func(t *testing.T) {
+		got, err := ipInRange("85.17.150.51", "85.17.150.0/24")
+
+		assert.Nil(t, err)
+		assert.True(t, got)
+	})
+
+	t.Run("address outside of the range", func(t *testing.T) {
+		got, err := ipInRange("85.17.151.51", "85.17.150.0/24")
+
+		assert.Nil(t, err)
+		assert.False(t, got)
+	})
+
+	t.Run("IPv6 address in the range", func(t *testing.T) {
+		got, err := ipInRange("2001:db8::1", "2001:db8::/64")
+
+		assert.Nil(t, err)
+		assert.True(t, got)
+	})
+
+	t.Run("address of another IP version is not in the range", func(t *testing.T) {
+		got, err := ipInRange("85.17.150.51", "2001:db8::/64")
+
+		assert.Nil(t, err)
+		assert.False(t, got)
+	})
+
+	t.Run("invalid address is reported on the first argument", func(t *testing.T) {
+		_, err := ipInRange("invalid", "85.17.150.0/24")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, int64(0), *err.FunctionArgument)
+	})
+
+	t.Run("invalid range is reported on the second argument", func(t *testing.T) {
+		_, err := ipInRange("85.17.150.51", "85.17.150.51")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, int64(1), *err.FunctionArgument)
+	})
+}
@@ -2,12 +2,20 @@ package ipmgmt
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/ipmgmt"
@@ -18,6 +26,7 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &ipResource{}
 	_ resource.ResourceWithImportState = &ipResource{}
+	_ resource.ResourceWithModifyPlan  = &ipResource{}
 )
 
 type ipResourceModel struct {
@@ -95,6 +104,23 @@ func adaptIPToIPResourceModel(
 	}
 }
 
+// defaultReverseLookup names the IP below the suffix, with dashes instead of
+// the separators of the address. IPv6 addresses are expanded, so the name
+// never starts or ends with a dash.
+func defaultReverseLookup(ipAddress string, suffix string) (string, error) {
+	ip, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return "", err
+	}
+
+	name := strings.ReplaceAll(ip.String(), ".", "-")
+	if ip.Is6() {
+		name = strings.ReplaceAll(ip.StringExpanded(), ":", "-")
+	}
+
+	return name + "." + suffix, nil
+}
+
 type subnetResourceModel struct {
 	Gateway      types.String `tfsdk:"gateway"`
 	ID           types.String `tfsdk:"id"`
@@ -142,6 +168,9 @@ func (i ipResource) Schema(
 			"ip": schema.StringAttribute{
 				Required:    true,
 				Description: "IP address. Changing this value updates the resource state with data related to the new IP address.",
+				Validators: []validator.String{
+					ipAddress(),
+				},
 			},
 			"null_level": schema.Int32Attribute{
 				Computed:    true,
@@ -162,7 +191,7 @@ func (i ipResource) Schema(
 			"reverse_lookup": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
-				Description: "Set reverse lookup for the IP",
+				Description: "Set reverse lookup for the IP. Creating the resource with a reverse lookup sets it on the existing IP, so the IP does not need to be imported first. Defaults to a name below the provider's `default_reverse_lookup_suffix` when the resource is created, if that is set.",
 			},
 			"subnet": schema.SingleNestedAttribute{
 				Computed: true,
@@ -201,12 +230,73 @@ func (i ipResource) Schema(
 	}
 }
 
+// ModifyPlan derives the reverse lookup of new IPs without one from the
+// provider's default_reverse_lookup_suffix. Existing IPs keep theirs.
+func (i ipResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	if i.DefaultReverseLookupSuffix == "" ||
+		request.Plan.Raw.IsNull() ||
+		!request.State.Raw.IsNull() {
+		return
+	}
+
+	var reverseLookup types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("reverse_lookup"), &reverseLookup)...)
+	if response.Diagnostics.HasError() || !reverseLookup.IsNull() {
+		return
+	}
+
+	var ipAddress types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("ip"), &ipAddress)...)
+	if response.Diagnostics.HasError() || ipAddress.IsUnknown() {
+		return
+	}
+
+	// Invalid IPs are reported by the validator of ip.
+	planned, err := defaultReverseLookup(ipAddress.ValueString(), i.DefaultReverseLookupSuffix)
+	if err != nil {
+		return
+	}
+
+	response.Diagnostics.Append(
+		response.Plan.SetAttribute(ctx, path.Root("reverse_lookup"), planned)...,
+	)
+}
+
 func (i ipResource) Create(
 	ctx context.Context,
 	request resource.CreateRequest,
 	response *resource.CreateResponse,
 ) {
-	utils.ImportOnlyError(&response.Diagnostics)
+	var plan ipResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// IPs cannot be ordered through the API, so the only thing creating the
+	// resource can do is set the reverse lookup of an existing IP. The
+	// default reverse lookup has been planned if no reverse lookup is set.
+	if plan.ReverseLookup.IsNull() || plan.ReverseLookup.IsUnknown() {
+		utils.ImportOnlyError(&response.Diagnostics)
+		return
+	}
+
+	ip, httpResponse, err := i.updateReverseLookup(
+		ctx,
+		plan.IP.ValueString(),
+		plan.ReverseLookup.ValueString(),
+	)
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptIPToIPResourceModel(*ip, ctx, &response.Diagnostics)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
 func (i ipResource) Read(
@@ -244,10 +334,11 @@ func (i ipResource) Update(
 		return
 	}
 
-	opts := publiccloud.NewUpdateIPOpts(plan.ReverseLookup.ValueString())
-	ip, httpResponse, err := i.IPmgmtAPI.UpdateIP(ctx, plan.IP.ValueString()).
-		UpdateIPOpts(ipmgmt.UpdateIPOpts(*opts)).
-		Execute()
+	ip, httpResponse, err := i.updateReverseLookup(
+		ctx,
+		plan.IP.ValueString(),
+		plan.ReverseLookup.ValueString(),
+	)
 	if err != nil {
 		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
 		return
@@ -257,6 +348,46 @@ func (i ipResource) Update(
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
+// updateReverseLookup sets the reverse lookup of the IP and waits until the
+// API reports the new value.
+func (i ipResource) updateReverseLookup(
+	ctx context.Context,
+	ipAddress string,
+	reverseLookup string,
+) (*ipmgmt.Ip, *http.Response, error) {
+	opts := publiccloud.NewUpdateIPOpts(reverseLookup)
+	ip, httpResponse, err := i.IPmgmtAPI.UpdateIP(ctx, ipAddress).
+		UpdateIPOpts(ipmgmt.UpdateIPOpts(*opts)).
+		Execute()
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	// Create a constant backoff with a 10-second retry interval
+	bo := backoff.NewConstantBackOff(10 * time.Second)
+
+	// Set the retry limit to 30 retries (5 minutes)
+	retryCount := 0
+	maxRetries := 30
+
+	for ip.GetReverseLookup() != reverseLookup {
+		if retryCount >= maxRetries {
+			return nil, nil, errors.New("timed out waiting for reverse lookup to be set after 5 minutes")
+		}
+
+		// Sleep for the backoff interval before retrying
+		time.Sleep(bo.NextBackOff())
+		retryCount++
+
+		ip, httpResponse, err = i.IPmgmtAPI.InspectIP(ctx, ipAddress).Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+	}
+
+	return ip, httpResponse, nil
+}
+
 func (i ipResource) Delete(
 	_ context.Context,
 	_ resource.DeleteRequest,
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/ipmgmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_adaptIPToIPResourceModel(t *testing.T) {
@@ -68,3 +69,29 @@ func Test_adaptIPToIPResourceModel(t *testing.T) {
 	assert.Equal(t, "2.2.2.2", subnet.Gateway.ValueString())
 
 }
+
+func Test_defaultReverseLookup(t *testing.T) {
+	t.Run("IPv4 addresses are named with dashes", func(t *testing.T) {
+		got, err := defaultReverseLookup("192.0.2.1", "ips.example.com")
+
+		require.NoError(t, err)
+		assert.Equal(t, "192-0-2-1.ips.example.com", got)
+	})
+
+	t.Run("IPv6 addresses are expanded", func(t *testing.T) {
+		got, err := defaultReverseLookup("2001:db8::1", "example.com")
+
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			"2001-0db8-0000-0000-0000-0000-0000-0001.example.com",
+			got,
+		)
+	})
+
+	t.Run("invalid IPs return an error", func(t *testing.T) {
+		_, err := defaultReverseLookup("tralala", "example.com")
+
+		assert.Error(t, err)
+	})
+}
@@ -2,12 +2,15 @@ package ipmgmt
 
 import (
 	"context"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -16,7 +19,8 @@ import (
 )
 
 var (
-	_ datasource.DataSourceWithConfigure = &ipsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &ipsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ipsDataSource{}
 )
 
 type ipsDataSourceModel struct {
@@ -37,18 +41,19 @@ type ipsDataSourceModel struct {
 }
 
 type ipDataSourceModel struct {
-	AssignedContract *assignedContractDataSourceModel `tfsdk:"assigned_contract"`
-	EquipmentID      types.String                     `tfsdk:"equipment_id"`
-	IP               types.String                     `tfsdk:"ip"`
-	NullLevel        types.Int32                      `tfsdk:"null_level"`
-	NullRouted       types.Bool                       `tfsdk:"null_routed"`
-	PrefixLength     types.Int32                      `tfsdk:"prefix_length"`
-	Primary          types.Bool                       `tfsdk:"primary"`
-	ReverseLookup    types.String                     `tfsdk:"reverse_lookup"`
-	Subnet           subnetDataSourceModel            `tfsdk:"subnet"`
-	Type             types.String                     `tfsdk:"type"`
-	UnnullingAllowed types.Bool                       `tfsdk:"unnulling_allowed"`
-	Version          types.Int32                      `tfsdk:"version"`
+	AssignedContract  *assignedContractDataSourceModel `tfsdk:"assigned_contract"`
+	EquipmentID       types.String                     `tfsdk:"equipment_id"`
+	IP                types.String                     `tfsdk:"ip"`
+	NullLevel         types.Int32                      `tfsdk:"null_level"`
+	NullRouteEligible types.Bool                       `tfsdk:"null_route_eligible"`
+	NullRouted        types.Bool                       `tfsdk:"null_routed"`
+	PrefixLength      types.Int32                      `tfsdk:"prefix_length"`
+	Primary           types.Bool                       `tfsdk:"primary"`
+	ReverseLookup     types.String                     `tfsdk:"reverse_lookup"`
+	Subnet            subnetDataSourceModel            `tfsdk:"subnet"`
+	Type              types.String                     `tfsdk:"type"`
+	UnnullingAllowed  types.Bool                       `tfsdk:"unnulling_allowed"`
+	Version           types.Int32                      `tfsdk:"version"`
 }
 
 type assignedContractDataSourceModel struct {
@@ -62,6 +67,29 @@ type subnetDataSourceModel struct {
 	PrefixLength types.Int32  `tfsdk:"prefix_length"`
 }
 
+// adaptIPToNullRouteEligible derives whether a null route can be created for
+// the IP, as the API does not report this directly. Addresses that are part of
+// the network infrastructure cannot be null-routed. Null is returned for IP
+// types where this is not known.
+func adaptIPToNullRouteEligible(ip ipmgmt.Ip) types.Bool {
+	if ip.GetNullRouted() {
+		return basetypes.NewBoolValue(false)
+	}
+
+	switch ip.GetType() {
+	case ipmgmt.IPTYPE_NORMAL_IP:
+		return basetypes.NewBoolValue(true)
+	case ipmgmt.IPTYPE_NETWORK,
+		ipmgmt.IPTYPE_BROADCAST,
+		ipmgmt.IPTYPE_GATEWAY,
+		ipmgmt.IPTYPE_ROUTER1,
+		ipmgmt.IPTYPE_ROUTER2:
+		return basetypes.NewBoolValue(false)
+	default:
+		return basetypes.NewBoolNull()
+	}
+}
+
 type ipsDataSource struct{ utils.DataSourceAPI }
 
 func (i ipsDataSource) Schema(
@@ -87,10 +115,16 @@ func (i ipsDataSource) Schema(
 				ElementType: types.StringType,
 				Optional:    true,
 				Description: "Return only these IPs",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(ipAddress()),
+				},
 			},
 			"from_ip": schema.StringAttribute{
 				Optional:    true,
 				Description: "Return only IPs greater or equal to the specified address",
+				Validators: []validator.String{
+					ipAddress(),
+				},
 			},
 			"null_routed": schema.BoolAttribute{
 				Optional:    true,
@@ -116,6 +150,9 @@ func (i ipsDataSource) Schema(
 			"to_ip": schema.StringAttribute{
 				Optional:    true,
 				Description: "Return only IPs lower or equal to the specified address",
+				Validators: []validator.String{
+					ipAddress(),
+				},
 			},
 			"type": schema.StringAttribute{
 				Optional:    true,
@@ -157,6 +194,10 @@ func (i ipsDataSource) Schema(
 							Computed:    true,
 							Description: "Null route level",
 						},
+						"null_route_eligible": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Boolean indicating if a null route can be created for the IP. It is false for IPs that are already null-routed or part of the network infrastructure, and unset when it cannot be determined for the IP type",
+						},
 						"null_routed": schema.BoolAttribute{
 							Computed:    true,
 							Description: "Boolean to indicate if the IP is null-routed",
@@ -213,93 +254,88 @@ func (i ipsDataSource) Schema(
 	}
 }
 
-func (i ipsDataSource) Read(
-	ctx context.Context,
-	request datasource.ReadRequest,
-	response *datasource.ReadResponse,
-) {
-	var config ipsDataSourceModel
-	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
-
-	var ips []ipmgmt.Ip
-	var offset *int32
-	var state ipsDataSourceModel
-
-	ipListRequest := i.IPmgmtAPI.GetIPList(ctx)
+// filterIPList applies the configured filters to the request.
+func filterIPList(
+	ipListRequest ipmgmt.ApiGetIPListRequest,
+	config ipsDataSourceModel,
+) ipmgmt.ApiGetIPListRequest {
 	if len(config.AssignedContractIDs) > 0 {
 		ipListRequest = ipListRequest.AssignedContractIds(strings.Join(config.AssignedContractIDs[:], ","))
-		state.AssignedContractIDs = config.AssignedContractIDs
 	}
 	if len(config.EquipmentIDs) > 0 {
 		ipListRequest = ipListRequest.EquipmentIds(strings.Join(config.EquipmentIDs[:], ","))
-		state.EquipmentIDs = config.EquipmentIDs
 	}
 	if len(config.FilteredIPs) > 0 {
 		ipListRequest = ipListRequest.Ips(strings.Join(config.FilteredIPs[:], ","))
-		state.IPs = config.IPs
 	}
 	if !config.FromIP.IsNull() {
 		ipListRequest = ipListRequest.FromIp(config.FromIP.ValueString())
-		state.FromIP = config.FromIP
 	}
 	if !config.NullRouted.IsNull() {
 		ipListRequest = ipListRequest.NullRouted(config.NullRouted.ValueBool())
-		state.NullRouted = config.NullRouted
 	}
 	if !config.Primary.IsNull() {
-		ipListRequest = ipListRequest.Primary(config.NullRouted.ValueBool())
-		state.Primary = config.Primary
+		ipListRequest = ipListRequest.Primary(config.Primary.ValueBool())
 	}
 	if !config.ReverseLookup.IsNull() {
 		ipListRequest = ipListRequest.ReverseLookup(config.ReverseLookup.ValueString())
-		state.ReverseLookup = config.ReverseLookup
 	}
 	if len(config.Sort) > 0 {
 		ipListRequest = ipListRequest.Sort(strings.Join(config.Sort[:], ","))
-		state.Sort = config.Sort
 	}
 	if !config.SubnetID.IsNull() {
 		ipListRequest = ipListRequest.SubnetId(config.SubnetID.ValueString())
-		state.SubnetID = config.SubnetID
 	}
 	if !config.ToIP.IsNull() {
 		ipListRequest = ipListRequest.ToIp(config.ToIP.ValueString())
-		state.ToIP = config.ToIP
 	}
 	if !config.Type.IsNull() {
 		ipListRequest = ipListRequest.Type_(ipmgmt.IpType(config.Type.ValueString()))
-		state.Type = config.Type
 	}
 	if !config.Version.IsNull() {
 		ipListRequest = ipListRequest.Version(ipmgmt.ProtocolVersion(config.Version.ValueInt32()))
-		state.Version = config.Version
 	}
 
-	for {
-		result, httpResponse, err := ipListRequest.Execute()
-		if err != nil {
-			utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
-			return
-		}
-
-		ips = append(ips, result.GetIps()...)
+	return ipListRequest
+}
 
-		metadata := result.GetMetadata()
+func (i ipsDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config ipsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-		offset = utils.NewOffset(
-			metadata.GetLimit(),
-			metadata.GetOffset(),
-			metadata.GetTotalCount(),
-		)
+	state := config
+	state.IPs = nil
 
-		if offset == nil {
-			break
-		}
+	ips, httpResponse, err := utils.FetchPages(
+		ctx,
+		i.PaginationConcurrency,
+		types.Int32Null(),
+		func(ctx context.Context, offset int32) (*utils.Page[ipmgmt.Ip], *http.Response, error) {
+			ipListRequest := filterIPList(i.IPmgmtAPI.GetIPList(ctx), config)
+			result, httpResponse, err := ipListRequest.Offset(offset).Execute()
+			if err != nil {
+				return nil, httpResponse, err
+			}
 
-		ipListRequest = ipListRequest.Offset(*offset)
+			metadata := result.GetMetadata()
+			return &utils.Page[ipmgmt.Ip]{
+				Items:      result.GetIps(),
+				Limit:      metadata.GetLimit(),
+				Offset:     metadata.GetOffset(),
+				TotalCount: metadata.GetTotalCount(),
+			}, httpResponse, nil
+		},
+	)
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
 	}
 
 	for _, sdkIP := range ips {
@@ -316,14 +352,15 @@ func (i ipsDataSource) Read(
 		subnet := sdkIP.GetSubnet()
 
 		ip := ipDataSourceModel{
-			AssignedContract: assignedContract,
-			EquipmentID:      basetypes.NewStringValue(sdkIP.GetEquipmentId()),
-			IP:               basetypes.NewStringValue(sdkIP.GetIp()),
-			NullLevel:        basetypes.NewInt32PointerValue(nullLevel),
-			NullRouted:       basetypes.NewBoolValue(sdkIP.GetNullRouted()),
-			PrefixLength:     basetypes.NewInt32Value(sdkIP.GetPrefixLength()),
-			Primary:          basetypes.NewBoolValue(sdkIP.GetPrimary()),
-			ReverseLookup:    basetypes.NewStringPointerValue(reverseLookup),
+			AssignedContract:  assignedContract,
+			EquipmentID:       basetypes.NewStringValue(sdkIP.GetEquipmentId()),
+			IP:                basetypes.NewStringValue(sdkIP.GetIp()),
+			NullLevel:         basetypes.NewInt32PointerValue(nullLevel),
+			NullRouteEligible: adaptIPToNullRouteEligible(sdkIP),
+			NullRouted:        basetypes.NewBoolValue(sdkIP.GetNullRouted()),
+			PrefixLength:      basetypes.NewInt32Value(sdkIP.GetPrefixLength()),
+			Primary:           basetypes.NewBoolValue(sdkIP.GetPrimary()),
+			ReverseLookup:     basetypes.NewStringPointerValue(reverseLookup),
 			Subnet: subnetDataSourceModel{
 				Gateway:      basetypes.NewStringValue(subnet.GetGateway()),
 				ID:           basetypes.NewStringValue(subnet.GetId()),
@@ -340,6 +377,31 @@ func (i ipsDataSource) Read(
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
+func (i ipsDataSource) ValidateConfig(
+	ctx context.Context,
+	request datasource.ValidateConfigRequest,
+	response *datasource.ValidateConfigResponse,
+) {
+	var fromIP, toIP types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("from_ip"), &fromIP)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("to_ip"), &toIP)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if fromIP.IsNull() || fromIP.IsUnknown() || toIP.IsNull() || toIP.IsUnknown() {
+		return
+	}
+
+	if err := validateIPRange(fromIP.ValueString(), toIP.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			path.Root("to_ip"),
+			"Invalid IP Range",
+			err.Error(),
+		)
+	}
+}
+
 func NewIPsDataSource() datasource.DataSource {
 	return &ipsDataSource{
 		DataSourceAPI: utils.DataSourceAPI{
@@ -0,0 +1,35 @@
+package ipmgmt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/ipmgmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptIPToNullRouteEligible(t *testing.T) {
+	t.Run("normal IPs are eligible", func(t *testing.T) {
+		ip := ipmgmt.Ip{Type: ipmgmt.IPTYPE_NORMAL_IP}
+
+		assert.Equal(t, basetypes.NewBoolValue(true), adaptIPToNullRouteEligible(ip))
+	})
+
+	t.Run("null-routed IPs are not eligible", func(t *testing.T) {
+		ip := ipmgmt.Ip{Type: ipmgmt.IPTYPE_NORMAL_IP, NullRouted: true}
+
+		assert.Equal(t, basetypes.NewBoolValue(false), adaptIPToNullRouteEligible(ip))
+	})
+
+	t.Run("infrastructure IPs are not eligible", func(t *testing.T) {
+		ip := ipmgmt.Ip{Type: ipmgmt.IPTYPE_GATEWAY}
+
+		assert.Equal(t, basetypes.NewBoolValue(false), adaptIPToNullRouteEligible(ip))
+	})
+
+	t.Run("eligibility is unknown for other IP types", func(t *testing.T) {
+		ip := ipmgmt.Ip{Type: ipmgmt.IPTYPE_IPMI}
+
+		assert.True(t, adaptIPToNullRouteEligible(ip).IsNull())
+	})
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/ipmgmt"
@@ -13,7 +15,8 @@ import (
 )
 
 var (
-	_ datasource.DataSourceWithConfigure = &nullRouteHistoryDataSource{}
+	_ datasource.DataSourceWithConfigure      = &nullRouteHistoryDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &nullRouteHistoryDataSource{}
 )
 
 type nullRouteHistoryDataSourceModel struct {
@@ -72,6 +75,9 @@ func (n nullRouteHistoryDataSource) Schema(
 			"from_ip": schema.StringAttribute{
 				Optional:    true,
 				Description: "Return only IPs greater or equal to the specified address",
+				Validators: []validator.String{
+					ipAddress(),
+				},
 			},
 			"nulled_by": schema.StringAttribute{
 				Optional:    true,
@@ -93,6 +99,9 @@ func (n nullRouteHistoryDataSource) Schema(
 			"to_ip": schema.StringAttribute{
 				Optional:    true,
 				Description: "Return only IPs lower or equal to the specified address",
+				Validators: []validator.String{
+					ipAddress(),
+				},
 			},
 			"unnulled_by": schema.StringAttribute{
 				Optional:    true,
@@ -277,6 +286,31 @@ func (n nullRouteHistoryDataSource) Read(ctx context.Context, request datasource
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
+func (n nullRouteHistoryDataSource) ValidateConfig(
+	ctx context.Context,
+	request datasource.ValidateConfigRequest,
+	response *datasource.ValidateConfigResponse,
+) {
+	var fromIP, toIP types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("from_ip"), &fromIP)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("to_ip"), &toIP)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if fromIP.IsNull() || fromIP.IsUnknown() || toIP.IsNull() || toIP.IsUnknown() {
+		return
+	}
+
+	if err := validateIPRange(fromIP.ValueString(), toIP.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			path.Root("to_ip"),
+			"Invalid IP Range",
+			err.Error(),
+		)
+	}
+}
+
 func NewNullRouteHistoryDataSource() datasource.DataSource {
 	return &nullRouteHistoryDataSource{
 		DataSourceAPI: utils.DataSourceAPI{
@@ -2,6 +2,7 @@ package ipmgmt
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"time"
 
@@ -23,8 +24,12 @@ import (
 var (
 	_ resource.ResourceWithConfigure   = &nullRouteResource{}
 	_ resource.ResourceWithImportState = &nullRouteResource{}
+	_ resource.ResourceWithModifyPlan  = &nullRouteResource{}
 )
 
+// nullRouteTimeFormat is the format automatic_unnulling_at is set in.
+const nullRouteTimeFormat = "2006-01-02 15:04:05 -0700 MST"
+
 type nullRouteResourceModel struct {
 	AssignedContract     types.Object `tfsdk:"assigned_contract"`
 	AutomaticUnnullingAt types.String `tfsdk:"automatic_unnulling_at"`
@@ -35,6 +40,8 @@ type nullRouteResourceModel struct {
 	NulledAt             types.String `tfsdk:"nulled_at"`
 	NulledBy             types.String `tfsdk:"nulled_by"`
 	NullLevel            types.Int32  `tfsdk:"null_level"`
+	RemoveAt             types.String `tfsdk:"remove_at"`
+	Duration             types.String `tfsdk:"duration"`
 	TicketID             types.String `tfsdk:"ticket_id"`
 	UnnulledAt           types.String `tfsdk:"unnulled_at"`
 	UnnulledBy           types.String `tfsdk:"unnulled_by"`
@@ -77,12 +84,100 @@ func adaptNullRouteToResourceModel(
 		NulledAt:             basetypes.NewStringValue(nullRoutedIP.GetNulledAt().String()),
 		NulledBy:             basetypes.NewStringValue(nullRoutedIP.GetNulledBy()),
 		NullLevel:            basetypes.NewInt32Value(nullRoutedIP.GetNullLevel()),
+		RemoveAt:             basetypes.NewStringNull(),
+		Duration:             basetypes.NewStringNull(),
 		TicketID:             basetypes.NewStringPointerValue(ticketID),
 		UnnulledAt:           utils.AdaptNullableTimeToStringValue(unnulledAt),
 		UnnulledBy:           basetypes.NewStringPointerValue(unnulledBy),
 	}
 }
 
+// getAutomatedUnnullingAt returns when the planned null route is to be
+// removed, nil if it is not to be removed automatically.
+func getAutomatedUnnullingAt(
+	plan nullRouteResourceModel,
+	now time.Time,
+) (*time.Time, error) {
+	switch {
+	case !plan.RemoveAt.IsNull() && !plan.RemoveAt.IsUnknown():
+		removeAt, err := time.Parse(time.RFC3339, plan.RemoveAt.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		return &removeAt, nil
+	case !plan.Duration.IsNull() && !plan.Duration.IsUnknown():
+		duration, err := time.ParseDuration(plan.Duration.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		removeAt := now.Add(duration)
+		return &removeAt, nil
+	case !plan.AutomaticUnnullingAt.IsNull() && !plan.AutomaticUnnullingAt.IsUnknown():
+		automatedUnnullingAt, err := time.Parse(
+			nullRouteTimeFormat,
+			plan.AutomaticUnnullingAt.ValueString(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &automatedUnnullingAt, nil
+	}
+
+	return nil, nil
+}
+
+// adaptAutomatedUnnullingAtToRemoveAt keeps remove_at as configured, unless
+// the removal has been rescheduled or cancelled outside of Terraform.
+func adaptAutomatedUnnullingAtToRemoveAt(
+	removeAt types.String,
+	nullRoutedIP ipmgmt.NullRoutedIP,
+) types.String {
+	if removeAt.IsNull() || removeAt.IsUnknown() {
+		return removeAt
+	}
+
+	automatedUnnullingAt, _ := nullRoutedIP.GetAutomatedUnnullingAtOk()
+	if automatedUnnullingAt == nil {
+		return basetypes.NewStringNull()
+	}
+
+	configured, err := time.Parse(time.RFC3339, removeAt.ValueString())
+	if err == nil && configured.Equal(*automatedUnnullingAt) {
+		return removeAt
+	}
+
+	return basetypes.NewStringValue(
+		automatedUnnullingAt.UTC().Format(time.RFC3339),
+	)
+}
+
+// isRemovedEarly reports whether a null route that is scheduled for removal
+// has been removed before its time, for example through the Customer Portal.
+func isRemovedEarly(
+	state nullRouteResourceModel,
+	nullRoutedIP ipmgmt.NullRoutedIP,
+) bool {
+	if state.RemoveAt.IsNull() && state.Duration.IsNull() {
+		return false
+	}
+
+	unnulledAt, _ := nullRoutedIP.GetUnnulledAtOk()
+	if unnulledAt == nil {
+		return false
+	}
+
+	scheduledAt, err := time.Parse(
+		nullRouteTimeFormat,
+		state.AutomaticUnnullingAt.ValueString(),
+	)
+	if err != nil {
+		// A removal that was never scheduled cannot be on time.
+		return true
+	}
+
+	return unnulledAt.Before(scheduledAt)
+}
+
 type assignedContractResourceModel struct {
 	ID types.String `tfsdk:"id"`
 }
@@ -123,6 +218,9 @@ func (n nullRouteResource) Schema(
 				Optional:    true,
 				Computed:    true,
 				Description: "IP address",
+				Validators: []validator.String{
+					ipAddress(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -143,6 +241,25 @@ func (n nullRouteResource) Schema(
 				Description: "The date and time when the null route is to be deactivated. The date and time should be specified using the `2019-09-08 00:00:00 +0000 UTC` format. If this field is not present then the null route will not be automatically removed",
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2} \+\d{4} UTC$`), "must be specified using the RFC3339 format (`yyyy-mm-ddThh:mm:ssZ`)"),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("remove_at"),
+						path.MatchRoot("duration"),
+					),
+				},
+			},
+			"remove_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "When the null route is to be removed automatically, as an RFC 3339 timestamp such as `2024-01-01T00:00:00Z`. Must be in the future when it is set or changed. If the removal is rescheduled or cancelled outside of Terraform, for example through the Customer Portal, the change shows up as drift. If the null route is removed before this time, it is removed from the state and planned to be created again",
+				Validators: []validator.String{
+					utils.TimestampValidator(),
+					stringvalidator.ConflictsWith(path.MatchRoot("duration")),
+				},
+			},
+			"duration": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long the null route is to stay active before it is removed automatically, as a duration string such as `2h`. The removal is scheduled when the null route is created or the duration is changed, `automatic_unnulling_at` holds the resulting time. If the null route is removed earlier, for example through the Customer Portal, it is removed from the state and planned to be created again",
+				Validators: []validator.String{
+					utils.DurationValidator(),
 				},
 			},
 			"comment": schema.StringAttribute{
@@ -183,6 +300,52 @@ func (n nullRouteResource) Schema(
 	}
 }
 
+// ModifyPlan ensures that remove_at is in the future when it is set or
+// changed, a remove_at that has passed since is left alone.
+func (n nullRouteResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	// The resource is destroyed.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan nullRouteResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if plan.RemoveAt.IsNull() || plan.RemoveAt.IsUnknown() {
+		return
+	}
+
+	if !request.State.Raw.IsNull() {
+		var state nullRouteResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		if plan.RemoveAt.Equal(state.RemoveAt) {
+			return
+		}
+	}
+
+	// The format is checked by the validator.
+	removeAt, err := time.Parse(time.RFC3339, plan.RemoveAt.ValueString())
+	if err != nil {
+		return
+	}
+	if !removeAt.After(time.Now()) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("remove_at"),
+			"Invalid Timestamp",
+			fmt.Sprintf("The value must be in the future, but got %s.", plan.RemoveAt.ValueString()),
+		)
+	}
+}
+
 func (n nullRouteResource) Create(
 	ctx context.Context,
 	request resource.CreateRequest,
@@ -204,16 +367,13 @@ func (n nullRouteResource) Create(
 	}
 
 	opts := ipmgmt.NewNullRouteIPOpts()
-	if !plan.AutomaticUnnullingAt.IsNull() && !plan.AutomaticUnnullingAt.IsUnknown() {
-		automatedUnnullingAt, err := time.Parse(
-			"2006-01-02 15:04:05 -0700 MST",
-			plan.AutomaticUnnullingAt.ValueString(),
-		)
-		if err != nil {
-			utils.GeneralError(&response.Diagnostics, ctx, err)
-			return
-		}
-		opts.SetAutomatedUnnullingAt(automatedUnnullingAt)
+	automatedUnnullingAt, err := getAutomatedUnnullingAt(plan, time.Now())
+	if err != nil {
+		utils.GeneralError(&response.Diagnostics, ctx, err)
+		return
+	}
+	if automatedUnnullingAt != nil {
+		opts.SetAutomatedUnnullingAt(*automatedUnnullingAt)
 	}
 
 	opts.Comment = utils.AdaptStringPointerValueToNullableString(plan.Comment)
@@ -236,6 +396,9 @@ func (n nullRouteResource) Create(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	state.RemoveAt = plan.RemoveAt
+	state.Duration = plan.Duration
+
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
@@ -268,6 +431,11 @@ func (n nullRouteResource) Read(
 		return
 	}
 
+	if isRemovedEarly(originalState, *nullRoutedIP) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
 	state := adaptNullRouteToResourceModel(
 		*nullRoutedIP,
 		&response.Diagnostics,
@@ -276,6 +444,11 @@ func (n nullRouteResource) Read(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	state.RemoveAt = adaptAutomatedUnnullingAtToRemoveAt(
+		originalState.RemoveAt,
+		*nullRoutedIP,
+	)
+	state.Duration = originalState.Duration
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -285,8 +458,9 @@ func (n nullRouteResource) Update(
 	request resource.UpdateRequest,
 	response *resource.UpdateResponse,
 ) {
-	var plan nullRouteResourceModel
+	var plan, currentState nullRouteResourceModel
 	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &currentState)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -301,16 +475,19 @@ func (n nullRouteResource) Update(
 	}
 
 	opts := ipmgmt.NewUpdateNullRouteOpts()
-	if !plan.AutomaticUnnullingAt.IsNull() && !plan.AutomaticUnnullingAt.IsUnknown() {
-		automatedUnnullingAt, err := time.Parse(
-			"2006-01-02 15:04:05 -0700 MST",
-			plan.AutomaticUnnullingAt.ValueString(),
-		)
-		if err != nil {
-			utils.GeneralError(&response.Diagnostics, ctx, err)
-			return
-		}
-		opts.SetAutomatedUnnullingAt(automatedUnnullingAt)
+	schedule := plan
+	// The removal is only rescheduled when the duration changes, not on every
+	// update.
+	if plan.Duration.Equal(currentState.Duration) {
+		schedule.Duration = basetypes.NewStringNull()
+	}
+	automatedUnnullingAt, err := getAutomatedUnnullingAt(schedule, time.Now())
+	if err != nil {
+		utils.GeneralError(&response.Diagnostics, ctx, err)
+		return
+	}
+	if automatedUnnullingAt != nil {
+		opts.SetAutomatedUnnullingAt(*automatedUnnullingAt)
 	}
 	if !plan.Comment.IsNull() && !plan.Comment.IsUnknown() {
 		opts.SetComment(plan.Comment.ValueString())
@@ -335,6 +512,8 @@ func (n nullRouteResource) Update(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	state.RemoveAt = plan.RemoveAt
+	state.Duration = plan.Duration
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
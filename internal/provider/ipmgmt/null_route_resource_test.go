@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/ipmgmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_adaptNullRouteToResourceModel(t *testing.T) {
@@ -80,3 +81,142 @@ func Test_adaptNullRouteToResourceModel(t *testing.T) {
 	assert.Equal(t, "comment", got.Comment.ValueString())
 	assert.Equal(t, "equipmentId", got.EquipmentID.ValueString())
 }
+
+func Test_getAutomatedUnnullingAt(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	plan := nullRouteResourceModel{
+		RemoveAt:             basetypes.NewStringNull(),
+		Duration:             basetypes.NewStringNull(),
+		AutomaticUnnullingAt: basetypes.NewStringNull(),
+	}
+
+	t.Run("remove_at is passed as is", func(t *testing.T) {
+		plan := plan
+		plan.RemoveAt = basetypes.NewStringValue("2024-01-02T00:00:00Z")
+
+		got, err := getAutomatedUnnullingAt(plan, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), *got)
+	})
+
+	t.Run("duration is counted from now", func(t *testing.T) {
+		plan := plan
+		plan.Duration = basetypes.NewStringValue("2h")
+
+		got, err := getAutomatedUnnullingAt(plan, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC), *got)
+	})
+
+	t.Run("automatic_unnulling_at is parsed", func(t *testing.T) {
+		plan := plan
+		plan.AutomaticUnnullingAt = basetypes.NewStringValue("2024-01-03 00:00:00 +0000 UTC")
+
+		got, err := getAutomatedUnnullingAt(plan, now)
+
+		require.NoError(t, err)
+		assert.True(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC).Equal(*got))
+	})
+
+	t.Run("returns nil if no removal is scheduled", func(t *testing.T) {
+		got, err := getAutomatedUnnullingAt(plan, now)
+
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("returns invalid durations as error", func(t *testing.T) {
+		plan := plan
+		plan.Duration = basetypes.NewStringValue("tralala")
+
+		_, err := getAutomatedUnnullingAt(plan, now)
+
+		assert.Error(t, err)
+	})
+}
+
+func Test_adaptAutomatedUnnullingAtToRemoveAt(t *testing.T) {
+	automatedUnnullingAt := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	nullRoutedIP := ipmgmt.NullRoutedIP{
+		AutomatedUnnullingAt: *ipmgmt.NewNullableTime(&automatedUnnullingAt),
+	}
+
+	t.Run("keeps remove_at if the removal is on schedule", func(t *testing.T) {
+		got := adaptAutomatedUnnullingAtToRemoveAt(
+			basetypes.NewStringValue("2024-01-02T01:00:00+01:00"),
+			nullRoutedIP,
+		)
+
+		assert.Equal(t, "2024-01-02T01:00:00+01:00", got.ValueString())
+	})
+
+	t.Run("reports a rescheduled removal", func(t *testing.T) {
+		got := adaptAutomatedUnnullingAtToRemoveAt(
+			basetypes.NewStringValue("2024-01-05T00:00:00Z"),
+			nullRoutedIP,
+		)
+
+		assert.Equal(t, "2024-01-02T00:00:00Z", got.ValueString())
+	})
+
+	t.Run("reports a cancelled removal", func(t *testing.T) {
+		got := adaptAutomatedUnnullingAtToRemoveAt(
+			basetypes.NewStringValue("2024-01-05T00:00:00Z"),
+			ipmgmt.NullRoutedIP{},
+		)
+
+		assert.True(t, got.IsNull())
+	})
+
+	t.Run("leaves an unset remove_at alone", func(t *testing.T) {
+		got := adaptAutomatedUnnullingAtToRemoveAt(
+			basetypes.NewStringNull(),
+			nullRoutedIP,
+		)
+
+		assert.True(t, got.IsNull())
+	})
+}
+
+func Test_isRemovedEarly(t *testing.T) {
+	state := nullRouteResourceModel{
+		RemoveAt:             basetypes.NewStringValue("2024-01-02T00:00:00Z"),
+		Duration:             basetypes.NewStringNull(),
+		AutomaticUnnullingAt: basetypes.NewStringValue("2024-01-02 00:00:00 +0000 UTC"),
+	}
+	removedAt := func(unnulledAt time.Time) ipmgmt.NullRoutedIP {
+		return ipmgmt.NullRoutedIP{
+			UnnulledAt: *ipmgmt.NewNullableTime(&unnulledAt),
+		}
+	}
+
+	t.Run("a null route removed before its time is removed early", func(t *testing.T) {
+		assert.True(
+			t,
+			isRemovedEarly(state, removedAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))),
+		)
+	})
+
+	t.Run("a null route removed on time is not removed early", func(t *testing.T) {
+		assert.False(
+			t,
+			isRemovedEarly(state, removedAt(time.Date(2024, 1, 2, 0, 0, 5, 0, time.UTC))),
+		)
+	})
+
+	t.Run("an active null route is not removed early", func(t *testing.T) {
+		assert.False(t, isRemovedEarly(state, ipmgmt.NullRoutedIP{}))
+	})
+
+	t.Run("null routes without a schedule are left alone", func(t *testing.T) {
+		state := state
+		state.RemoveAt = basetypes.NewStringNull()
+
+		assert.False(
+			t,
+			isRemovedEarly(state, removedAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))),
+		)
+	})
+}
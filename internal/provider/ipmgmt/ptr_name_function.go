@@ -0,0 +1,97 @@
+package ipmgmt
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &ptrNameFunction{}
+)
+
+// ptrName returns the fully qualified name of the PTR record of an IP
+// address, in the in-addr.arpa zone for IPv4 and the ip6.arpa zone for IPv6.
+func ptrName(value string) (string, error) {
+	ip, err := netip.ParseAddr(value)
+	if err != nil || ip.Zone() != "" {
+		return "", fmt.Errorf("the value must be a valid IPv4 or IPv6 address, but got %s", value)
+	}
+	ip = ip.Unmap()
+
+	var labels []string
+	if ip.Is4() {
+		octets := ip.As4()
+		for i := len(octets) - 1; i >= 0; i-- {
+			labels = append(labels, fmt.Sprintf("%d", octets[i]))
+		}
+
+		return strings.Join(labels, ".") + ".in-addr.arpa.", nil
+	}
+
+	octets := ip.As16()
+	for i := len(octets) - 1; i >= 0; i-- {
+		labels = append(
+			labels,
+			fmt.Sprintf("%x", octets[i]&0x0f),
+			fmt.Sprintf("%x", octets[i]>>4),
+		)
+	}
+
+	return strings.Join(labels, ".") + ".ip6.arpa.", nil
+}
+
+type ptrNameFunction struct{}
+
+func (p *ptrNameFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	response *function.MetadataResponse,
+) {
+	response.Name = "ptr_name"
+}
+
+func (p *ptrNameFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	response *function.DefinitionResponse,
+) {
+	response.Definition = function.Definition{
+		Summary:             "Compute the reverse DNS record name of an IP address",
+		MarkdownDescription: "Returns the fully qualified name of the PTR record of an IP address, e.g. `51.150.17.85.in-addr.arpa.` for `85.17.150.51`. IPv6 addresses are expanded into nibbles in the `ip6.arpa.` zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ip",
+				MarkdownDescription: "An IPv4 or IPv6 address.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (p *ptrNameFunction) Run(
+	ctx context.Context,
+	request function.RunRequest,
+	response *function.RunResponse,
+) {
+	var ip string
+	response.Error = request.Arguments.Get(ctx, &ip)
+	if response.Error != nil {
+		return
+	}
+
+	name, err := ptrName(ip)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	response.Error = response.Result.Set(ctx, name)
+}
+
+func NewPTRNameFunction() function.Function {
+	return &ptrNameFunction{}
+}
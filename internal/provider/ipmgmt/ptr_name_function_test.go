@@ -0,0 +1,40 @@
+package ipmgmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ptrName(t *testing.T) {
+	t.Run("IPv4 addresses are in the in-addr.arpa zone", func(t *testing.T) {
+		got, err := ptrName("85.17.150.51")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "51.150.17.85.in-addr.arpa.", got)
+	})
+
+	t.Run("IPv6 addresses are expanded into nibbles", func(t *testing.T) {
+		got, err := ptrName("2001:db8::567:89ab")
+
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			"b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+			got,
+		)
+	})
+
+	t.Run("IPv4-mapped IPv6 addresses are treated as IPv4", func(t *testing.T) {
+		got, err := ptrName("::ffff:85.17.150.51")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "51.150.17.85.in-addr.arpa.", got)
+	})
+
+	t.Run("invalid addresses are rejected", func(t *testing.T) {
+		_, err := ptrName("85.17.150.0/24")
+
+		assert.ErrorContains(t, err, "must be a valid IPv4 or IPv6 address")
+	})
+}
@@ -0,0 +1,496 @@
+package ipmgmt
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/ipmgmt"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+const (
+	// reverseLookupSuffix is replaced by the position of the IP in the range.
+	reverseLookupSuffix = "${suffix}"
+	// minReverseLookupRangePrefixLength bounds the number of reverse lookups
+	// that are generated for a range.
+	minReverseLookupRangePrefixLength = 116
+	maxReverseLookupRangeSize         = 1 << (128 - minReverseLookupRangePrefixLength)
+	// reverseLookupBatchSize is the maximum number of records the API
+	// updates in a single request.
+	reverseLookupBatchSize = 20
+	reverseLookupPageSize  = 100
+
+	defaultReverseLookupConcurrency = 4
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &reverseLookupRangeResource{}
+	_ resource.ResourceWithImportState = &reverseLookupRangeResource{}
+	_ resource.ResourceWithModifyPlan  = &reverseLookupRangeResource{}
+)
+
+type reverseLookupRangeResourceModel struct {
+	Range       types.String `tfsdk:"range"`
+	Template    types.String `tfsdk:"template"`
+	Concurrency types.Int32  `tfsdk:"concurrency"`
+	Records     types.Map    `tfsdk:"records"`
+}
+
+// renderReverseLookup fills the suffix into the template.
+func renderReverseLookup(template string, suffix string) string {
+	return strings.ReplaceAll(template, reverseLookupSuffix, suffix)
+}
+
+// adaptPrefixToRange returns the range in the format of the API, which
+// separates the prefix length with an underscore.
+func adaptPrefixToRange(prefix netip.Prefix) string {
+	return fmt.Sprintf("%s_%d", prefix.Addr(), prefix.Bits())
+}
+
+// generateReverseLookups renders a reverse lookup for every IP in the range.
+// The suffix is the hexadecimal position of the IP in the range.
+func generateReverseLookups(prefix netip.Prefix, template string) map[string]string {
+	size := 1 << (128 - prefix.Bits())
+	records := make(map[string]string, size)
+
+	ip := prefix.Addr()
+	for i := range size {
+		records[ip.String()] = renderReverseLookup(template, strconv.FormatInt(int64(i), 16))
+		ip = ip.Next()
+	}
+
+	return records
+}
+
+// diffReverseLookups returns the records that turn current into desired,
+// sorted by IP. Records that are not desired are removed by setting them to
+// null.
+func diffReverseLookups(
+	current map[string]string,
+	desired map[string]string,
+) []ipmgmt.ReverseLookup {
+	var records []ipmgmt.ReverseLookup
+
+	for ip, reverseLookup := range desired {
+		if current[ip] != reverseLookup {
+			records = append(
+				records,
+				*ipmgmt.NewReverseLookup(ip, *ipmgmt.NewNullableString(&reverseLookup)),
+			)
+		}
+	}
+	for ip := range current {
+		if _, ok := desired[ip]; !ok {
+			records = append(
+				records,
+				*ipmgmt.NewReverseLookup(ip, *ipmgmt.NewNullableString(nil)),
+			)
+		}
+	}
+
+	slices.SortFunc(records, func(a, b ipmgmt.ReverseLookup) int {
+		return netip.MustParseAddr(a.GetIp()).Compare(netip.MustParseAddr(b.GetIp()))
+	})
+
+	return records
+}
+
+func (r reverseLookupRangeResourceModel) concurrency() int {
+	if r.Concurrency.IsNull() || r.Concurrency.IsUnknown() {
+		return defaultReverseLookupConcurrency
+	}
+
+	return int(r.Concurrency.ValueInt32())
+}
+
+func (r reverseLookupRangeResourceModel) records(ctx context.Context) (map[string]string, error) {
+	records := map[string]string{}
+	if r.Records.IsNull() || r.Records.IsUnknown() {
+		return records, nil
+	}
+
+	diags := r.Records.ElementsAs(ctx, &records, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("cannot read records: %v", diags.Errors())
+	}
+
+	return records, nil
+}
+
+type reverseLookupRangeResource struct {
+	utils.ResourceAPI
+}
+
+func (r reverseLookupRangeResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	if _, err := parseReverseLookupRange(request.ID); err != nil {
+		utils.ImportError(ctx, &response.Diagnostics, request.ID, err, nil)
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("range"), request, response)
+}
+
+func (r reverseLookupRangeResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: fmt.Sprintf(
+			"Manages the reverse lookups of all IPs in an IPv6 range from a naming template. Records of the range that do not match the template are reported as drift and corrected on the next apply. Destroying the resource removes the reverse lookups of the range. Requests are subject to the `requests_per_second` limit of the provider. A range holds at most %d IPs.",
+			maxReverseLookupRangeSize,
+		),
+		Attributes: map[string]schema.Attribute{
+			"range": schema.StringAttribute{
+				Required: true,
+				Description: fmt.Sprintf(
+					"The IPv6 range in CIDR notation, e.g. `2001:db8::/120`. The prefix length must be at least %d.",
+					minReverseLookupRangePrefixLength,
+				),
+				Validators: []validator.String{
+					reverseLookupRange(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template": schema.StringAttribute{
+				Required:    true,
+				Description: "The reverse lookup of every IP, where `${suffix}` is replaced by the hexadecimal position of the IP in the range, e.g. `host-$${suffix}.example.com`. The `$` has to be doubled in Terraform configuration, so the placeholder is not interpolated.",
+				Validators: []validator.String{
+					reverseLookupTemplate(),
+				},
+			},
+			"concurrency": schema.Int32Attribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"How many requests of %d records are sent in parallel. Defaults to %d.",
+					reverseLookupBatchSize,
+					defaultReverseLookupConcurrency,
+				),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 10),
+				},
+			},
+			"records": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The reverse lookups of the range by IP",
+			},
+		},
+	}
+}
+
+// ModifyPlan plans the records generated from the template, so drift of
+// single records shows up in the plan.
+func (r reverseLookupRangeResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan reverseLookupRangeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Range.IsUnknown() || plan.Template.IsUnknown() {
+		return
+	}
+
+	// Invalid values are reported by the validators.
+	prefix, err := parseReverseLookupRange(plan.Range.ValueString())
+	if err != nil {
+		return
+	}
+
+	response.Diagnostics.Append(
+		response.Plan.SetAttribute(
+			ctx,
+			path.Root("records"),
+			generateReverseLookups(prefix, plan.Template.ValueString()),
+		)...,
+	)
+}
+
+func (r reverseLookupRangeResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	var plan reverseLookupRangeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	prefix, err := parseReverseLookupRange(plan.Range.ValueString())
+	if err != nil {
+		utils.GeneralError(&response.Diagnostics, ctx, err)
+		return
+	}
+
+	// Only records that differ are sent, so adopting a range is cheap.
+	current, httpResponse, err := r.getReverseLookups(ctx, prefix)
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	desired := generateReverseLookups(prefix, plan.Template.ValueString())
+	recordsValue, diags := types.MapValueFrom(ctx, types.StringType, desired)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	plan.Records = recordsValue
+
+	httpResponse, err = r.updateReverseLookups(
+		ctx,
+		prefix,
+		diffReverseLookups(current, desired),
+		plan.concurrency(),
+	)
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (r reverseLookupRangeResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	var state reverseLookupRangeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	prefix, err := parseReverseLookupRange(state.Range.ValueString())
+	if err != nil {
+		utils.GeneralError(&response.Diagnostics, ctx, err)
+		return
+	}
+
+	records, httpResponse, err := r.getReverseLookups(ctx, prefix)
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	recordsValue, diags := types.MapValueFrom(ctx, types.StringType, records)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	state.Records = recordsValue
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (r reverseLookupRangeResource) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	var plan, state reverseLookupRangeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	prefix, err := parseReverseLookupRange(plan.Range.ValueString())
+	if err != nil {
+		utils.GeneralError(&response.Diagnostics, ctx, err)
+		return
+	}
+
+	current, err := state.records(ctx)
+	if err != nil {
+		utils.GeneralError(&response.Diagnostics, ctx, err)
+		return
+	}
+	desired := generateReverseLookups(prefix, plan.Template.ValueString())
+	recordsValue, diags := types.MapValueFrom(ctx, types.StringType, desired)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	plan.Records = recordsValue
+
+	httpResponse, err := r.updateReverseLookups(
+		ctx,
+		prefix,
+		diffReverseLookups(current, desired),
+		plan.concurrency(),
+	)
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (r reverseLookupRangeResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	var state reverseLookupRangeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	prefix, err := parseReverseLookupRange(state.Range.ValueString())
+	if err != nil {
+		utils.GeneralError(&response.Diagnostics, ctx, err)
+		return
+	}
+
+	current, err := state.records(ctx)
+	if err != nil {
+		utils.GeneralError(&response.Diagnostics, ctx, err)
+		return
+	}
+
+	httpResponse, err := r.updateReverseLookups(
+		ctx,
+		prefix,
+		diffReverseLookups(current, map[string]string{}),
+		state.concurrency(),
+	)
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+	}
+}
+
+// getReverseLookups returns the reverse lookups that are set in the range by
+// IP.
+func (r reverseLookupRangeResource) getReverseLookups(
+	ctx context.Context,
+	prefix netip.Prefix,
+) (map[string]string, *http.Response, error) {
+	// The pages are fetched one by one, as the updates of the range already
+	// send requests in parallel.
+	sdkRecords, httpResponse, err := utils.FetchPages(
+		ctx,
+		1,
+		types.Int32Null(),
+		func(ctx context.Context, offset int32) (*utils.Page[ipmgmt.ReverseLookup], *http.Response, error) {
+			result, httpResponse, err := r.IPmgmtAPI.GetReverseLookupRecordList(ctx, adaptPrefixToRange(prefix)).
+				Limit(reverseLookupPageSize).
+				Offset(offset).
+				Execute()
+			if err != nil {
+				return nil, httpResponse, err
+			}
+
+			metadata := result.GetMetadata()
+			return &utils.Page[ipmgmt.ReverseLookup]{
+				Items:      result.GetReverseLookups(),
+				Limit:      metadata.GetLimit(),
+				Offset:     metadata.GetOffset(),
+				TotalCount: metadata.GetTotalCount(),
+			}, httpResponse, nil
+		},
+	)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	records := map[string]string{}
+	for _, record := range sdkRecords {
+		ip, err := netip.ParseAddr(record.GetIp())
+		if err != nil || !prefix.Contains(ip) || record.GetReverseLookup() == "" {
+			continue
+		}
+		records[ip.String()] = record.GetReverseLookup()
+	}
+
+	return records, nil, nil
+}
+
+// updateReverseLookups sends the records in batches, with up to concurrency
+// batches in flight. The first error cancels the batches that did not start
+// yet.
+func (r reverseLookupRangeResource) updateReverseLookups(
+	ctx context.Context,
+	prefix netip.Prefix,
+	records []ipmgmt.ReverseLookup,
+	concurrency int,
+) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	var firstResponse *http.Response
+	semaphore := make(chan struct{}, concurrency)
+
+	for batch := range slices.Chunk(records, reverseLookupBatchSize) {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(batch []ipmgmt.ReverseLookup) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			_, httpResponse, err := r.IPmgmtAPI.
+				UpdateReverseLookupRecords(ctx, adaptPrefixToRange(prefix)).
+				UpdateReverseLookupRecordsOpts(*ipmgmt.NewUpdateReverseLookupRecordsOpts(batch)).
+				Execute()
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					firstResponse = httpResponse
+					cancel()
+				}
+				mu.Unlock()
+			}
+		}(batch)
+	}
+	wg.Wait()
+
+	return firstResponse, firstErr
+}
+
+func NewReverseLookupRangeResource() resource.Resource {
+	return &reverseLookupRangeResource{
+		ResourceAPI: utils.ResourceAPI{Name: "ipmgmt_reverse_lookup_range"},
+	}
+}
@@ -0,0 +1,139 @@
+package ipmgmt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/ipmgmt"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_adaptPrefixToRange(t *testing.T) {
+	got := adaptPrefixToRange(netip.MustParsePrefix("2001:db8::/120"))
+
+	assert.Equal(t, "2001:db8::_120", got)
+}
+
+func Test_generateReverseLookups(t *testing.T) {
+	got := generateReverseLookups(
+		netip.MustParsePrefix("2001:db8::/124"),
+		"host-${suffix}.example.com",
+	)
+
+	assert.Len(t, got, 16)
+	assert.Equal(t, "host-0.example.com", got["2001:db8::"])
+	assert.Equal(t, "host-a.example.com", got["2001:db8::a"])
+	assert.Equal(t, "host-f.example.com", got["2001:db8::f"])
+}
+
+func Test_diffReverseLookups(t *testing.T) {
+	got := diffReverseLookups(
+		map[string]string{
+			"2001:db8::":  "host-0.example.com",
+			"2001:db8::1": "stale.example.com",
+			"2001:db8::5": "other.example.com",
+		},
+		map[string]string{
+			"2001:db8::":  "host-0.example.com",
+			"2001:db8::1": "host-1.example.com",
+			"2001:db8::2": "host-2.example.com",
+		},
+	)
+
+	assert.Len(t, got, 3)
+
+	assert.Equal(t, "2001:db8::1", got[0].GetIp())
+	assert.Equal(t, "host-1.example.com", got[0].GetReverseLookup())
+
+	assert.Equal(t, "2001:db8::2", got[1].GetIp())
+	assert.Equal(t, "host-2.example.com", got[1].GetReverseLookup())
+
+	assert.Equal(t, "2001:db8::5", got[2].GetIp())
+	assert.Nil(t, got[2].ReverseLookup.Get(), "records that are not desired are removed")
+}
+
+// newReverseLookupRangeResource returns a resource whose API serves
+// totalCount records of 2001:db8::/120 in pages of the requested limit.
+func newReverseLookupRangeResource(
+	t *testing.T,
+	totalCount int,
+	ignoreOffset bool,
+) reverseLookupRangeResource {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if ignoreOffset {
+			offset = 0
+		}
+
+		records := []ipmgmt.ReverseLookup{}
+		for i := offset; i < min(offset+limit, totalCount); i++ {
+			reverseLookup := fmt.Sprintf("host-%d.example.com", i)
+			records = append(records, ipmgmt.ReverseLookup{
+				Ip:            fmt.Sprintf("2001:db8::%x", i),
+				ReverseLookup: *ipmgmt.NewNullableString(&reverseLookup),
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(ipmgmt.GetReverseLookupRecordListResult{
+			ReverseLookups: records,
+			Metadata: ipmgmt.Metadata{
+				Limit:      int32(limit),
+				Offset:     int32(offset),
+				TotalCount: int32(totalCount),
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	cfg := ipmgmt.NewConfiguration()
+	cfg.Host = serverURL.Host
+	cfg.Scheme = serverURL.Scheme
+
+	return reverseLookupRangeResource{
+		ResourceAPI: utils.ResourceAPI{
+			IPmgmtAPI: ipmgmt.NewAPIClient(cfg).IpmgmtAPI,
+		},
+	}
+}
+
+func Test_reverseLookupRangeResource_getReverseLookups(t *testing.T) {
+	t.Run("records of all pages are returned", func(t *testing.T) {
+		r := newReverseLookupRangeResource(t, 250, false)
+
+		got, _, err := r.getReverseLookups(
+			context.TODO(),
+			netip.MustParsePrefix("2001:db8::/120"),
+		)
+
+		require.NoError(t, err)
+		assert.Len(t, got, 250)
+		assert.Equal(t, "host-249.example.com", got["2001:db8::f9"])
+	})
+
+	t.Run("an ignored offset returns an error", func(t *testing.T) {
+		r := newReverseLookupRangeResource(t, 250, true)
+
+		got, _, err := r.getReverseLookups(
+			context.TODO(),
+			netip.MustParsePrefix("2001:db8::/120"),
+		)
+
+		assert.ErrorContains(t, err, "cannot be fetched completely")
+		assert.Nil(t, got)
+	})
+}
@@ -0,0 +1,215 @@
+package ipmgmt
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// canonicalIPAddress returns the canonical form of an IPv4 or IPv6 address,
+// which is also the form the API reports IP addresses in.
+func canonicalIPAddress(value string) (string, error) {
+	ip, err := netip.ParseAddr(value)
+	if err != nil || ip.Zone() != "" {
+		return "", fmt.Errorf("the value must be a valid IPv4 or IPv6 address, but got %s", value)
+	}
+
+	return ip.String(), nil
+}
+
+// ipAddressValidator ensures that the given value is an IPv4 or IPv6 address
+// in canonical form. Addresses in any other form would not match the
+// address the API returns and cause an inconsistent state.
+type ipAddressValidator struct{}
+
+func (v ipAddressValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	canonical, err := canonicalIPAddress(value)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("The value must be a valid IPv4 or IPv6 address, but got %s.", value),
+		)
+		return
+	}
+
+	if canonical != value {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Non-canonical IP Address",
+			fmt.Sprintf("The IP address must be written in canonical form, use %s instead of %s.", canonical, value),
+		)
+	}
+}
+
+var _ validator.String = ipAddressValidator{}
+
+func (v ipAddressValidator) Description(_ context.Context) string {
+	return "Ensures that the value is an IPv4 or IPv6 address in canonical form"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ipAddress returns a new instance of the validator.
+func ipAddress() validator.String {
+	return ipAddressValidator{}
+}
+
+// validateIPRange ensures that fromIP and toIP, when both set, describe a
+// range of a single IP version in ascending order.
+func validateIPRange(fromIP string, toIP string) error {
+	from, err := netip.ParseAddr(fromIP)
+	if err != nil {
+		return nil
+	}
+	to, err := netip.ParseAddr(toIP)
+	if err != nil {
+		return nil
+	}
+
+	if from.Is4() != to.Is4() {
+		return fmt.Errorf("from_ip %s and to_ip %s must be of the same IP version", fromIP, toIP)
+	}
+
+	if from.Compare(to) > 0 {
+		return fmt.Errorf("from_ip %s must not be greater than to_ip %s", fromIP, toIP)
+	}
+
+	return nil
+}
+
+// parseReverseLookupRange parses an IPv6 range in CIDR notation that holds
+// at most maxReverseLookupRangeSize addresses.
+func parseReverseLookupRange(value string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil || !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		return netip.Prefix{}, fmt.Errorf("the value must be an IPv6 range in CIDR notation such as 2001:db8::/120, but got %s", value)
+	}
+
+	if prefix.Masked() != prefix {
+		return netip.Prefix{}, fmt.Errorf("the range must start at its network address, use %s instead of %s", prefix.Masked(), value)
+	}
+
+	if prefix.Bits() < minReverseLookupRangePrefixLength {
+		return netip.Prefix{}, fmt.Errorf(
+			"the prefix length must be at least %d, so the range holds at most %d addresses, but got %d",
+			minReverseLookupRangePrefixLength,
+			maxReverseLookupRangeSize,
+			prefix.Bits(),
+		)
+	}
+
+	return prefix, nil
+}
+
+// reverseLookupRangeValidator ensures that the given value is an IPv6 range
+// that is small enough to generate reverse lookups for.
+type reverseLookupRangeValidator struct{}
+
+func (v reverseLookupRangeValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseReverseLookupRange(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid IPv6 Range",
+			err.Error(),
+		)
+	}
+}
+
+var _ validator.String = reverseLookupRangeValidator{}
+
+func (v reverseLookupRangeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf(
+		"Ensures that the value is an IPv6 range in CIDR notation with a prefix length of at least %d",
+		minReverseLookupRangePrefixLength,
+	)
+}
+
+func (v reverseLookupRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// reverseLookupRange returns a new instance of the validator.
+func reverseLookupRange() validator.String {
+	return reverseLookupRangeValidator{}
+}
+
+var hostnameRegexp = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// validateReverseLookupTemplate ensures that the template contains the
+// suffix placeholder and renders to hostnames.
+func validateReverseLookupTemplate(template string) error {
+	if !strings.Contains(template, reverseLookupSuffix) {
+		return fmt.Errorf("the template must contain %s, but got %s", reverseLookupSuffix, template)
+	}
+
+	// The longest suffix of a range is "fff".
+	for _, suffix := range []string{"0", "fff"} {
+		name := renderReverseLookup(template, suffix)
+		if len(name) > 253 || !hostnameRegexp.MatchString(name) {
+			return fmt.Errorf("the template must render to lowercase hostnames such as host-%s.example.com, but renders to %s", suffix, name)
+		}
+	}
+
+	return nil
+}
+
+// reverseLookupTemplateValidator ensures that the given value is a naming
+// template for reverse lookups.
+type reverseLookupTemplateValidator struct{}
+
+func (v reverseLookupTemplateValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateReverseLookupTemplate(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Template",
+			err.Error(),
+		)
+	}
+}
+
+var _ validator.String = reverseLookupTemplateValidator{}
+
+func (v reverseLookupTemplateValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Ensures that the value contains %s and renders to hostnames", reverseLookupSuffix)
+}
+
+func (v reverseLookupTemplateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// reverseLookupTemplate returns a new instance of the validator.
+func reverseLookupTemplate() validator.String {
+	return reverseLookupTemplateValidator{}
+}
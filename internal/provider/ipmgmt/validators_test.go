@@ -0,0 +1,175 @@
+package ipmgmt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_canonicalIPAddress(t *testing.T) {
+	t.Run("returns IPv4 addresses as is", func(t *testing.T) {
+		got, err := canonicalIPAddress("192.0.2.1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "192.0.2.1", got)
+	})
+
+	t.Run("compresses and lowercases IPv6 addresses", func(t *testing.T) {
+		got, err := canonicalIPAddress("2001:DB8:0:0::1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "2001:db8::1", got)
+	})
+
+	t.Run("rejects malformed addresses", func(t *testing.T) {
+		for _, value := range []string{"123", "192.0.2.256", "192.0.2.0/24", "fe80::1%eth0", ""} {
+			_, err := canonicalIPAddress(value)
+
+			assert.Error(t, err, value)
+		}
+	})
+}
+
+func Test_ipAddressValidator_ValidateString(t *testing.T) {
+	t.Run("does not set errors for a canonical address", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("2001:db8::1"),
+		}
+		response := validator.StringResponse{}
+
+		ipAddress().ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("does not set errors for an unknown value", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringUnknown(),
+		}
+		response := validator.StringResponse{}
+
+		ipAddress().ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("sets errors for a malformed address", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("192.0.2"),
+		}
+		response := validator.StringResponse{}
+
+		ipAddress().ValidateString(context.TODO(), request, &response)
+
+		require.Len(t, response.Diagnostics.Errors(), 1)
+		assert.Equal(t, "Invalid IP Address", response.Diagnostics.Errors()[0].Summary())
+	})
+
+	t.Run("sets errors for a non-canonical address", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("2001:DB8:0::1"),
+		}
+		response := validator.StringResponse{}
+
+		ipAddress().ValidateString(context.TODO(), request, &response)
+
+		require.Len(t, response.Diagnostics.Errors(), 1)
+		assert.Equal(
+			t,
+			"The IP address must be written in canonical form, use 2001:db8::1 instead of 2001:DB8:0::1.",
+			response.Diagnostics.Errors()[0].Detail(),
+		)
+	})
+}
+
+func Test_validateIPRange(t *testing.T) {
+	t.Run("accepts an ascending range", func(t *testing.T) {
+		assert.NoError(t, validateIPRange("192.0.2.1", "192.0.2.255"))
+	})
+
+	t.Run("accepts a single IP range", func(t *testing.T) {
+		assert.NoError(t, validateIPRange("192.0.2.1", "192.0.2.1"))
+	})
+
+	t.Run("rejects a descending range", func(t *testing.T) {
+		err := validateIPRange("192.0.2.255", "192.0.2.1")
+
+		assert.ErrorContains(t, err, "from_ip 192.0.2.255 must not be greater than to_ip 192.0.2.1")
+	})
+
+	t.Run("rejects a range of mixed versions", func(t *testing.T) {
+		err := validateIPRange("192.0.2.1", "2001:db8::1")
+
+		assert.ErrorContains(t, err, "must be of the same IP version")
+	})
+
+	t.Run("leaves malformed values to the attribute validators", func(t *testing.T) {
+		assert.NoError(t, validateIPRange("123", "192.0.2.1"))
+	})
+}
+
+func Test_parseReverseLookupRange(t *testing.T) {
+	t.Run("parses IPv6 ranges", func(t *testing.T) {
+		got, err := parseReverseLookupRange("2001:db8::/120")
+
+		require.NoError(t, err)
+		assert.Equal(t, "2001:db8::/120", got.String())
+	})
+
+	t.Run("rejects ranges that are no IPv6 range", func(t *testing.T) {
+		for _, value := range []string{"2001:db8::1", "192.0.2.0/24", "::ffff:192.0.2.0/120", ""} {
+			_, err := parseReverseLookupRange(value)
+
+			assert.ErrorContains(t, err, "must be an IPv6 range", value)
+		}
+	})
+
+	t.Run("rejects ranges that do not start at the network address", func(t *testing.T) {
+		_, err := parseReverseLookupRange("2001:db8::1/120")
+
+		assert.ErrorContains(t, err, "use 2001:db8::/120 instead")
+	})
+
+	t.Run("rejects ranges that are too large", func(t *testing.T) {
+		_, err := parseReverseLookupRange("2001:db8::/112")
+
+		assert.ErrorContains(t, err, "at least 116")
+	})
+}
+
+func Test_validateReverseLookupTemplate(t *testing.T) {
+	t.Run("accepts templates with the suffix", func(t *testing.T) {
+		assert.NoError(t, validateReverseLookupTemplate("host-${suffix}.example.com"))
+	})
+
+	t.Run("rejects templates without the suffix", func(t *testing.T) {
+		err := validateReverseLookupTemplate("host.example.com")
+
+		assert.ErrorContains(t, err, "must contain ${suffix}")
+	})
+
+	t.Run("rejects templates that do not render to hostnames", func(t *testing.T) {
+		for _, template := range []string{"${suffix}", "Host-${suffix}.example.com", "host_${suffix}.example.com", "-${suffix}.example.com"} {
+			err := validateReverseLookupTemplate(template)
+
+			assert.ErrorContains(t, err, "must render to lowercase hostnames", template)
+		}
+	})
+}
+
+func Test_reverseLookupTemplateValidator_ValidateString(t *testing.T) {
+	t.Run("sets an error for an invalid template", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("host.example.com"),
+		}
+		response := validator.StringResponse{}
+
+		reverseLookupTemplateValidator{}.ValidateString(context.TODO(), request, &response)
+
+		assert.True(t, response.Diagnostics.HasError())
+	})
+}
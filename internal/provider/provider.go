@@ -2,24 +2,40 @@ package provider
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
 	"os"
+	"regexp"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkdns "github.com/leaseweb/leaseweb-go-sdk/dns"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/client"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/dedicatedserver"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/dns"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/ipmgmt"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
 
 var (
-	_ provider.Provider = &leasewebProvider{}
+	_ provider.Provider                       = &leasewebProvider{}
+	_ provider.ProviderWithEphemeralResources = &leasewebProvider{}
+	_ provider.ProviderWithFunctions          = &leasewebProvider{}
 )
 
 func New(version string) func() provider.Provider {
@@ -38,9 +54,111 @@ type leasewebProvider struct {
 }
 
 type leasewebProviderModel struct {
-	Host   types.String `tfsdk:"host"`
-	Token  types.String `tfsdk:"token"`
-	Scheme types.String `tfsdk:"scheme"`
+	Host                  types.String  `tfsdk:"host"`
+	Token                 types.String  `tfsdk:"token"`
+	Scheme                types.String  `tfsdk:"scheme"`
+	WaitForMaintenance    types.Bool    `tfsdk:"wait_for_maintenance"`
+	MaintenanceTimeout    types.String  `tfsdk:"maintenance_timeout"`
+	DefaultDNSTTL         types.Int32   `tfsdk:"default_dns_ttl"`
+	DefaultReverseLookup  types.String  `tfsdk:"default_reverse_lookup_suffix"`
+	MaxRetries            types.Int32   `tfsdk:"max_retries"`
+	RefreshMaxRetries     types.Int32   `tfsdk:"refresh_max_retries"`
+	RetryWaitMax          types.String  `tfsdk:"retry_wait_max"`
+	Timeout               types.String  `tfsdk:"timeout"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	UserAgentSuffix       types.String  `tfsdk:"user_agent_suffix"`
+	DebugHTTP             types.Bool    `tfsdk:"debug_http"`
+	ValidateInstanceType  types.Bool    `tfsdk:"validate_instance_type"`
+	ProxyURL              types.String  `tfsdk:"proxy_url"`
+	SkipCredentialsCheck  types.Bool    `tfsdk:"skip_credentials_validation"`
+	SkipUnavailable       types.Bool    `tfsdk:"skip_unavailable_subsystems"`
+	PaginationConcurrency types.Int32   `tfsdk:"pagination_concurrency"`
+	CACertFile            types.String  `tfsdk:"ca_cert_file"`
+	CACertPEM             types.String  `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify    types.Bool    `tfsdk:"insecure_skip_verify"`
+	TokenFile             types.String  `tfsdk:"token_file"`
+	SharedCredentialsFile types.String  `tfsdk:"shared_credentials_file"`
+	Profile               types.String  `tfsdk:"profile"`
+}
+
+// apiURL describes the configured API endpoint for error messages.
+func apiURL(scheme string, host string) string {
+	if scheme == "" {
+		scheme = "https"
+	}
+	if host == "" {
+		host = "api.leaseweb.com"
+	}
+
+	return scheme + "://" + host
+}
+
+var domainNameRegexp = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// maxReverseLookupSuffixLength leaves room in a hostname for the longest
+// name of an IP, that of an expanded IPv6 address, and the dot after it.
+const maxReverseLookupSuffixLength = 253 - 40
+
+// validateReverseLookupSuffix ensures that the suffix is a lowercase domain
+// name that leaves room for the name of the IP in front of it.
+func validateReverseLookupSuffix(suffix string) error {
+	if len(suffix) > maxReverseLookupSuffixLength || !domainNameRegexp.MatchString(suffix) {
+		return fmt.Errorf(
+			"it must be a lowercase domain name such as \"example.com\" of at most %d characters",
+			maxReverseLookupSuffixLength,
+		)
+	}
+
+	return nil
+}
+
+// maxTokenLength is well above the length of any Leaseweb API token.
+const maxTokenLength = 256
+
+// validateToken rejects tokens that cannot be valid, such as a token read
+// from a file including its trailing newline, so they fail with a clear
+// message instead of an authentication error on the first request.
+func validateToken(token string) error {
+	if len(token) > maxTokenLength {
+		return fmt.Errorf("it is %d characters long, at most %d are allowed", len(token), maxTokenLength)
+	}
+
+	for _, character := range token {
+		if character <= ' ' || character > '~' {
+			return fmt.Errorf("it contains the character %q, only printable ASCII characters without spaces are allowed", character)
+		}
+	}
+
+	return nil
+}
+
+// readSharedCredentials returns the token of the profile in the shared
+// credentials file. The default file and profile are optional, so their
+// absence is not an error.
+func readSharedCredentials(file string, profile string) (string, error) {
+	explicitFile := file != ""
+	if !explicitFile {
+		file = client.DefaultSharedCredentialsFile()
+		if file == "" {
+			return "", nil
+		}
+	}
+	explicitProfile := profile != ""
+	if !explicitProfile {
+		profile = client.DefaultProfile
+	}
+
+	token, err := client.ReadSharedCredentials(file, profile)
+	switch {
+	case err == nil:
+		return token, nil
+	case errors.Is(err, os.ErrNotExist) && !explicitFile:
+		return "", nil
+	case errors.Is(err, client.ErrProfileNotFound) && !explicitProfile:
+		return "", nil
+	}
+
+	return "", err
 }
 
 func (p *leasewebProvider) Metadata(
@@ -57,6 +175,8 @@ func (p *leasewebProvider) Schema(
 	_ provider.SchemaRequest,
 	resp *provider.SchemaResponse,
 ) {
+	dnsTTLs := utils.NewIntMarkdownList(sdkdns.AllowedTtlEnumValues)
+
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
@@ -69,9 +189,127 @@ func (p *leasewebProvider) Schema(
 			},
 			"token": schema.StringAttribute{
 				Optional:    true,
-				Description: "The API token to use. By default it takes the value from the LEASEWEB_TOKEN environment variable if present.",
+				Description: "The API token to use. By default it takes the value from the LEASEWEB_TOKEN environment variable if present. Terraform stores provider configuration in saved plan files, use the environment variable to keep the token out of them.",
 				Sensitive:   true,
 			},
+			"token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file that contains the API token, such as a secret mounted by Vault or Kubernetes. Surrounding whitespace is ignored. Only used if neither `token` nor LEASEWEB_TOKEN is set. May also be provided via LEASEWEB_TOKEN_FILE environment variable if present.",
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the shared credentials file the token of `profile` is read from. Only used if no other token is set. Defaults to \"~/.leaseweb/credentials\". May also be provided via LEASEWEB_SHARED_CREDENTIALS_FILE environment variable if present.",
+			},
+			"profile": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"The profile of the shared credentials file to read the token from. Defaults to %q. May also be provided via LEASEWEB_PROFILE environment variable if present.",
+					client.DefaultProfile,
+				),
+			},
+			"wait_for_maintenance": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait and retry requests while the Leaseweb API is in a maintenance window instead of failing immediately. Defaults to false.",
+			},
+			"maintenance_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for a maintenance window to end when `wait_for_maintenance` is enabled, as a duration string such as \"45m\". Defaults to \"30m\".",
+			},
+			"default_dns_ttl": schema.Int32Attribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"Time to live applied to `leaseweb_dns_resource_record_set` resources that do not set `ttl`. Valid options are %s",
+					dnsTTLs.Markdown(),
+				),
+				Validators: []validator.Int32{
+					int32validator.OneOf(dnsTTLs.ToInt32()...),
+				},
+			},
+			"default_reverse_lookup_suffix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Domain name that `leaseweb_ipmgmt_ip` resources created without `reverse_lookup` derive their reverse lookup from, e.g. `192-0-2-1.example.com` for 192.0.2.1 with the suffix \"example.com\". IPv6 addresses are written out in full. Setting `reverse_lookup` on the resource overrides it, and IPs that are imported keep their reverse lookup.",
+			},
+			"max_retries": schema.Int32Attribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"How often requests are retried after a rate limit or gateway error, using exponential backoff. Mutations are only retried on HTTP 429 and 503 so they are never applied twice. Reads are retried `refresh_max_retries` times instead, if set. Set to 0 to disable retries. Defaults to %d.",
+					client.DefaultMaxRetries,
+				),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"refresh_max_retries": schema.Int32Attribute{
+				Optional:    true,
+				Description: "How often reads, such as those of `terraform refresh` and `terraform plan`, are retried after a rate limit, gateway or network error. Reads cannot change anything, so they can safely be retried more often than mutations. Defaults to `max_retries`.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"The maximum wait between retries, as a duration string such as \"1m\". Also caps waits requested by the `Retry-After` header. Defaults to %q.",
+					client.DefaultRetryWaitMax.String(),
+				),
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long a single request to the Leaseweb API may take before it is aborted, as a duration string such as \"30s\". Reads that time out are retried, and each retry gets the full timeout. By default requests do not time out. May also be provided via LEASEWEB_TIMEOUT environment variable if present.",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The maximum average number of requests per second sent to the Leaseweb API, shared by all resources and data sources. Requests wait for their turn instead of failing, retries included. Defaults to 0, which does not limit requests. May also be provided via LEASEWEB_REQUESTS_PER_SECOND environment variable if present.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Text appended to the `User-Agent` header of every request, e.g. to identify your automation.",
+			},
+			"debug_http": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Log every request to the Leaseweb API and its response, bodies included, at the `DEBUG` log level. The API token is masked and bodies are truncated after 4 KiB. Defaults to false. May also be provided via LEASEWEB_DEBUG_HTTP environment variable if present.",
+			},
+			"validate_instance_type": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Check during planning that the `type` of each `leaseweb_public_cloud_instance` is offered in its `region`. This queries the API once per region while planning, disable it to plan without API access. Defaults to true.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The proxy to send all requests to the Leaseweb API through, such as \"http://proxy.example.com:3128\". Overrides the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables, which are used otherwise. May also be provided via LEASEWEB_PROXY_URL environment variable if present.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file with PEM encoded certificate authorities to trust in addition to those of the system, e.g. that of a proxy that intercepts TLS traffic. May also be provided via LEASEWEB_CA_CERT_FILE environment variable if present.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded certificate authorities to trust in addition to those of the system. Can be combined with `ca_cert_file`. May also be provided via LEASEWEB_CA_CERT_PEM environment variable if present.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Do not verify the TLS certificate of the Leaseweb API. This makes requests, including the API token, vulnerable to interception, so only use it to debug connection problems. Defaults to false. May also be provided via LEASEWEB_INSECURE_SKIP_VERIFY environment variable if present.",
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip the request that checks the token and the connection to the Leaseweb API when the provider is configured, e.g. to plan without API access. Defaults to false.",
+			},
+			"pagination_concurrency": schema.Int32Attribute{
+				Optional: true,
+				Description: fmt.Sprintf(
+					"How many pages the `leaseweb_dedicated_servers` and `leaseweb_ipmgmt_ips` data sources fetch in parallel, once the first page has reported how many items there are. Set to 1 to fetch the pages one after the other. Defaults to %d.",
+					client.DefaultPaginationConcurrency,
+				),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"skip_unavailable_subsystems": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Check each Leaseweb subsystem (Public Cloud, Dedicated Server, DNS and IP Management) when the provider is configured. Resources and data sources of an unreachable subsystem fail with an error, while those of the other subsystems proceed. This sends one request per subsystem on every run, and a run can apply only part of a configuration if a subsystem is down. The DNS API cannot list the domains of an account, so its check only covers whether the API responds, using a name under the reserved `.invalid` top-level domain. Defaults to false.",
+			},
 		},
 	}
 }
@@ -104,6 +342,16 @@ func (p *leasewebProvider) Configure(
 	host := os.Getenv("LEASEWEB_HOST")
 	scheme := os.Getenv("LEASEWEB_SCHEME")
 	token := os.Getenv("LEASEWEB_TOKEN")
+	timeout := os.Getenv("LEASEWEB_TIMEOUT")
+	requestsPerSecond := os.Getenv("LEASEWEB_REQUESTS_PER_SECOND")
+	debugHTTP := os.Getenv("LEASEWEB_DEBUG_HTTP")
+	proxyURL := os.Getenv("LEASEWEB_PROXY_URL")
+	caCertFile := os.Getenv("LEASEWEB_CA_CERT_FILE")
+	caCertPEM := os.Getenv("LEASEWEB_CA_CERT_PEM")
+	insecureSkipVerify := os.Getenv("LEASEWEB_INSECURE_SKIP_VERIFY")
+	tokenFile := os.Getenv("LEASEWEB_TOKEN_FILE")
+	sharedCredentialsFile := os.Getenv("LEASEWEB_SHARED_CREDENTIALS_FILE")
+	profile := os.Getenv("LEASEWEB_PROFILE")
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
@@ -114,16 +362,257 @@ func (p *leasewebProvider) Configure(
 	}
 
 	if !config.Token.IsNull() {
+		if token != "" {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("token"),
+				"Leaseweb API token set twice",
+				"The token is set in the provider configuration and in the LEASEWEB_TOKEN environment variable. "+
+					"The token in the configuration is used.",
+			)
+		}
 		token = config.Token.ValueString()
 	}
 
+	if !config.Timeout.IsNull() {
+		timeout = config.Timeout.ValueString()
+	}
+
+	if !config.ProxyURL.IsNull() {
+		proxyURL = config.ProxyURL.ValueString()
+	}
+
+	if !config.CACertFile.IsNull() {
+		caCertFile = config.CACertFile.ValueString()
+	}
+
+	if !config.CACertPEM.IsNull() {
+		caCertPEM = config.CACertPEM.ValueString()
+	}
+
+	if !config.TokenFile.IsNull() {
+		tokenFile = config.TokenFile.ValueString()
+	}
+
+	if !config.SharedCredentialsFile.IsNull() {
+		sharedCredentialsFile = config.SharedCredentialsFile.ValueString()
+	}
+
+	if !config.Profile.IsNull() {
+		profile = config.Profile.ValueString()
+	}
+
+	if token == "" && tokenFile != "" {
+		fileToken, err := client.ReadTokenFile(tokenFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token_file"),
+				"Invalid token file",
+				fmt.Sprintf("The token file could not be read: %s.", err),
+			)
+		}
+		token = fileToken
+	}
+
+	if token == "" && !resp.Diagnostics.HasError() {
+		sharedToken, err := readSharedCredentials(sharedCredentialsFile, profile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("shared_credentials_file"),
+				"Invalid shared credentials file",
+				fmt.Sprintf("The token could not be read from the shared credentials file: %s.", err),
+			)
+		}
+		token = sharedToken
+	}
+
 	if token == "" {
+		// A token file that could not be read has been reported already.
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token"),
+				"Missing Leaseweb API token",
+				"The provider cannot create the Leaseweb API client as there is a missing or empty value for the Leaseweb API token. "+
+					"Set the token value in the configuration, use the LEASEWEB_TOKEN environment variable, set token_file "+
+					"or add the token to the shared credentials file. "+
+					"If one of them is already set, ensure the value is not empty.",
+			)
+		}
+	} else if err := validateToken(token); err != nil {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
-			"Missing Leaseweb API token",
-			"The provider cannot create the Leaseweb API client as there is a missing or empty value for the Leaseweb API token. "+
-				"Set the token value in the configuration or use the LEASEWEB_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+			"Invalid Leaseweb API token",
+			fmt.Sprintf("The Leaseweb API token is malformed: %s.", err),
+		)
+	}
+
+	var maintenanceTimeout time.Duration
+	if !config.MaintenanceTimeout.IsNull() && !config.MaintenanceTimeout.IsUnknown() {
+		parsedTimeout, err := time.ParseDuration(config.MaintenanceTimeout.ValueString())
+		if err != nil || parsedTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("maintenance_timeout"),
+				"Invalid maintenance timeout",
+				fmt.Sprintf(
+					"The maintenance timeout must be a positive duration such as \"45m\". Got: %q",
+					config.MaintenanceTimeout.ValueString(),
+				),
+			)
+		}
+		maintenanceTimeout = parsedTimeout
+	}
+
+	if !config.DefaultReverseLookup.IsNull() && !config.DefaultReverseLookup.IsUnknown() {
+		if err := validateReverseLookupSuffix(config.DefaultReverseLookup.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("default_reverse_lookup_suffix"),
+				"Invalid default reverse lookup suffix",
+				fmt.Sprintf(
+					"The default reverse lookup suffix is malformed: %s. Got: %q",
+					err,
+					config.DefaultReverseLookup.ValueString(),
+				),
+			)
+		}
+	}
+
+	var retryWaitMax time.Duration
+	if !config.RetryWaitMax.IsNull() && !config.RetryWaitMax.IsUnknown() {
+		parsedWaitMax, err := time.ParseDuration(config.RetryWaitMax.ValueString())
+		if err != nil || parsedWaitMax <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_wait_max"),
+				"Invalid retry wait max",
+				fmt.Sprintf(
+					"The maximum retry wait must be a positive duration such as \"1m\". Got: %q",
+					config.RetryWaitMax.ValueString(),
+				),
+			)
+		}
+		retryWaitMax = parsedWaitMax
+	}
+
+	var requestTimeout time.Duration
+	if timeout != "" {
+		parsedTimeout, err := time.ParseDuration(timeout)
+		if err != nil || parsedTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid timeout",
+				fmt.Sprintf(
+					"The timeout must be a positive duration such as \"30s\". Got: %q",
+					timeout,
+				),
+			)
+		}
+		requestTimeout = parsedTimeout
+	}
+
+	var requestRate float64
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		requestRate = config.RequestsPerSecond.ValueFloat64()
+	} else if requestsPerSecond != "" {
+		parsedRate, err := strconv.ParseFloat(requestsPerSecond, 64)
+		if err != nil || parsedRate < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid requests per second",
+				fmt.Sprintf(
+					"LEASEWEB_REQUESTS_PER_SECOND must be a number of at least 0. Got: %q",
+					requestsPerSecond,
+				),
+			)
+		}
+		requestRate = parsedRate
+	}
+
+	var debugRequests bool
+	if !config.DebugHTTP.IsNull() && !config.DebugHTTP.IsUnknown() {
+		debugRequests = config.DebugHTTP.ValueBool()
+	} else if debugHTTP != "" {
+		parsedDebug, err := strconv.ParseBool(debugHTTP)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("debug_http"),
+				"Invalid debug http",
+				fmt.Sprintf(
+					"LEASEWEB_DEBUG_HTTP must be a boolean such as \"true\". Got: %q",
+					debugHTTP,
+				),
+			)
+		}
+		debugRequests = parsedDebug
+	}
+
+	var proxy *url.URL
+	if proxyURL != "" {
+		parsedURL, err := url.Parse(proxyURL)
+		if err != nil || parsedURL.Scheme == "" || parsedURL.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid proxy URL",
+				fmt.Sprintf(
+					"The proxy URL must be an absolute URL such as \"http://proxy.example.com:3128\". Got: %q",
+					proxyURL,
+				),
+			)
+		}
+		proxy = parsedURL
+	}
+
+	var caCerts [][]byte
+	if caCertFile != "" {
+		caCert, err := os.ReadFile(caCertFile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Invalid CA certificate file",
+				fmt.Sprintf("The CA certificate file could not be read: %s.", err),
+			)
+		}
+		caCerts = append(caCerts, caCert)
+	}
+	if caCertPEM != "" {
+		caCerts = append(caCerts, []byte(caCertPEM))
+	}
+
+	var rootCAs *x509.CertPool
+	if len(caCerts) > 0 && !resp.Diagnostics.HasError() {
+		pool, err := client.NewRootCAs(caCerts...)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid CA certificates",
+				fmt.Sprintf(
+					"The CA certificates of ca_cert_file and ca_cert_pem must be PEM encoded: %s.",
+					err,
+				),
+			)
+		}
+		rootCAs = pool
+	}
+
+	var skipVerify bool
+	if !config.InsecureSkipVerify.IsNull() && !config.InsecureSkipVerify.IsUnknown() {
+		skipVerify = config.InsecureSkipVerify.ValueBool()
+	} else if insecureSkipVerify != "" {
+		parsedSkipVerify, err := strconv.ParseBool(insecureSkipVerify)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid insecure skip verify",
+				fmt.Sprintf(
+					"LEASEWEB_INSECURE_SKIP_VERIFY must be a boolean such as \"true\". Got: %q",
+					insecureSkipVerify,
+				),
+			)
+		}
+		skipVerify = parsedSkipVerify
+	}
+	if skipVerify {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS certificate verification disabled",
+			"The TLS certificate of the Leaseweb API is not verified, so requests and the API token can be intercepted. "+
+				"Trust the certificate authority with ca_cert_file or ca_cert_pem instead.",
 		)
 	}
 
@@ -143,11 +632,89 @@ func (p *leasewebProvider) Configure(
 	if scheme != "" {
 		optional.Scheme = &scheme
 	}
+	optional.WaitForMaintenance = config.WaitForMaintenance.ValueBool()
+	optional.MaintenanceTimeout = maintenanceTimeout
+	optional.DefaultDNSTTL = config.DefaultDNSTTL.ValueInt32()
+	optional.DefaultReverseLookupSuffix = config.DefaultReverseLookup.ValueString()
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries := int(config.MaxRetries.ValueInt32())
+		optional.MaxRetries = &maxRetries
+	}
+	if !config.RefreshMaxRetries.IsNull() && !config.RefreshMaxRetries.IsUnknown() {
+		refreshMaxRetries := int(config.RefreshMaxRetries.ValueInt32())
+		optional.RefreshMaxRetries = &refreshMaxRetries
+	}
+	optional.RetryWaitMax = retryWaitMax
+	optional.Timeout = requestTimeout
+	optional.RequestsPerSecond = requestRate
+	optional.TerraformVersion = req.TerraformVersion
+	optional.UserAgentSuffix = config.UserAgentSuffix.ValueString()
+	optional.DebugHTTP = debugRequests
+	optional.ValidateInstanceType = config.ValidateInstanceType.IsNull() ||
+		config.ValidateInstanceType.ValueBool()
+	optional.ProxyURL = proxy
+	optional.PaginationConcurrency = int(config.PaginationConcurrency.ValueInt32())
+	optional.RootCAs = rootCAs
+	optional.InsecureSkipVerify = skipVerify
 
 	coreClient := client.NewClient(token, optional, p.version)
 
+	skipUnavailable := config.SkipUnavailable.ValueBool()
+	if skipUnavailable {
+		coreClient.UnavailableSubsystems = coreClient.CheckSubsystems(ctx)
+		for _, subsystem := range client.Subsystems {
+			err, ok := coreClient.UnavailableSubsystems[subsystem]
+			if !ok {
+				continue
+			}
+			resp.Diagnostics.AddWarning(
+				"Leaseweb subsystem unavailable",
+				fmt.Sprintf(
+					"The %s API at %s could not be reached: %s. Its resources and data sources will fail, the others proceed.",
+					subsystem,
+					apiURL(scheme, host),
+					err,
+				),
+			)
+		}
+	}
+
+	// The credentials are checked through IP Management, an outage of it
+	// has already been reported when unavailable subsystems are skipped.
+	_, ipmgmtUnavailable := coreClient.UnavailableSubsystems[client.SubsystemIPmgmt]
+	if !config.SkipCredentialsCheck.ValueBool() && !ipmgmtUnavailable {
+		err := coreClient.CheckCredentials(ctx)
+		switch {
+		case errors.Is(err, client.ErrAuthenticationFailed):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("token"),
+				"Authentication failed",
+				fmt.Sprintf(
+					"The Leaseweb API rejected the token: %s. Check that the token is valid and has not expired.",
+					err,
+				),
+			)
+			return
+		case errors.Is(err, client.ErrAPIUnreachable) && !skipUnavailable:
+			resp.Diagnostics.AddError(
+				"Leaseweb API unreachable",
+				fmt.Sprintf(
+					"The provider could not connect to the Leaseweb API at %s: %s. Check the host, scheme and proxy settings and your network connection, or set skip_credentials_validation to plan without API access.",
+					apiURL(scheme, host),
+					err,
+				),
+			)
+			return
+		}
+
+		if err == nil {
+			tflog.Info(ctx, "Validated Leaseweb API credentials")
+		}
+	}
+
 	resp.DataSourceData = coreClient
 	resp.ResourceData = coreClient
+	resp.EphemeralResourceData = coreClient
 
 	tflog.Info(
 		ctx,
@@ -165,12 +732,30 @@ func (p *leasewebProvider) DataSources(_ context.Context) []func() datasource.Da
 		dedicatedserver.NewControlPanelsDataSource,
 		dedicatedserver.NewOperatingSystemsDataSource,
 		dedicatedserver.NewCredentialDataSource,
+		dedicatedserver.NewCredentialsDataSource,
+		dedicatedserver.NewInstallationHistoryDataSource,
+		dedicatedserver.NewJobsDataSource,
+		dedicatedserver.NewJobDataSource,
+		dedicatedserver.NewPowerDataSource,
+		dedicatedserver.NewRemoteManagementDataSource,
+		dedicatedserver.NewBandwidthMetricsDataSource,
+		dedicatedserver.NewDatatrafficMetricsDataSource,
 		publiccloud.NewImagesDataSource,
+		publiccloud.NewSnapshotsDataSource,
 		publiccloud.NewLoadBalancersDataSource,
+		publiccloud.NewLoadBalancerConfigDataSource,
 		publiccloud.NewLoadBalancerListenersDataSource,
+		publiccloud.NewLoadBalancerMetricsDataSource,
 		publiccloud.NewTargetGroupsDataSource,
+		publiccloud.NewAutoScalingGroupsDataSource,
 		publiccloud.NewISOsDataSource,
+		publiccloud.NewMarketAppsDataSource,
+		publiccloud.NewAccountSummaryDataSource,
+		publiccloud.NewBillingSummaryDataSource,
+		publiccloud.NewInstanceTypesDataSource,
+		publiccloud.NewRegionsDataSource,
 		dns.NewResourceRecordSetsDataSource,
+		dns.NewZoneImportDataSource,
 		ipmgmt.NewIPsDataSource,
 		ipmgmt.NewNullRouteHistoryDataSource,
 	}
@@ -185,14 +770,40 @@ func (p *leasewebProvider) Resources(_ context.Context) []func() resource.Resour
 		dedicatedserver.NewNotificationSettingDatatrafficResource,
 		dedicatedserver.NewNotificationSettingBandwidthResource,
 		dedicatedserver.NewInstallationResource,
+		dedicatedserver.NewRemoteManagementResource,
+		dedicatedserver.NewPowerResource,
+		dedicatedserver.NewNetworkInterfaceResource,
+		dedicatedserver.NewDHCPLeaseResource,
+		dedicatedserver.NewPrivateNetworkResource,
+		dedicatedserver.NewRescueModeResource,
 		publiccloud.NewImageResource,
+		publiccloud.NewSnapshotResource,
 		publiccloud.NewLoadBalancerResource,
 		publiccloud.NewLoadBalancerListenerResource,
 		publiccloud.NewTargetGroupResource,
+		publiccloud.NewTargetGroupAttachmentResource,
+		publiccloud.NewAutoScalingGroupResource,
 		publiccloud.NewIPResource,
 		publiccloud.NewInstanceIsoResource,
+		publiccloud.NewNotificationSettingResource,
 		dns.NewResourceRecordSetsResource,
 		ipmgmt.NewIPResource,
 		ipmgmt.NewNullRouteResource,
+		ipmgmt.NewReverseLookupRangeResource,
+	}
+}
+
+func (p *leasewebProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		publiccloud.NewCredentialEphemeralResource,
+		dedicatedserver.NewCredentialEphemeralResource,
+	}
+}
+
+func (p *leasewebProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		dns.NewParseZoneFileFunction,
+		ipmgmt.NewPTRNameFunction,
+		ipmgmt.NewIPInRangeFunction,
 	}
 }
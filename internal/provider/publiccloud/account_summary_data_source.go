@@ -0,0 +1,340 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/client"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &accountSummaryDataSource{}
+)
+
+type accountSummaryProductDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Reference types.String `tfsdk:"reference"`
+	Region    types.String `tfsdk:"region"`
+	State     types.String `tfsdk:"state"`
+	Type      types.String `tfsdk:"type"`
+}
+
+type accountSummaryIPDataSourceModel struct {
+	IP          types.String `tfsdk:"ip"`
+	Version     types.Int32  `tfsdk:"version"`
+	NetworkType types.String `tfsdk:"network_type"`
+	ResourceID  types.String `tfsdk:"resource_id"`
+}
+
+type accountSummaryDataSourceModel struct {
+	InstanceCount     types.Int32                            `tfsdk:"instance_count"`
+	Instances         []accountSummaryProductDataSourceModel `tfsdk:"instances"`
+	LoadBalancerCount types.Int32                            `tfsdk:"load_balancer_count"`
+	LoadBalancers     []accountSummaryProductDataSourceModel `tfsdk:"load_balancers"`
+	IPCount           types.Int32                            `tfsdk:"ip_count"`
+	IPs               []accountSummaryIPDataSourceModel      `tfsdk:"ips"`
+}
+
+func adaptIpToAccountSummaryIPDataSource(
+	ip publiccloud.Ip,
+	resourceID string,
+) accountSummaryIPDataSourceModel {
+	return accountSummaryIPDataSourceModel{
+		IP:          basetypes.NewStringValue(ip.GetIp()),
+		Version:     basetypes.NewInt32Value(int32(ip.GetVersion())),
+		NetworkType: basetypes.NewStringValue(string(ip.GetNetworkType())),
+		ResourceID:  basetypes.NewStringValue(resourceID),
+	}
+}
+
+// adaptAccountSummary builds the summary from the fetched products. A nil
+// slice means that the product could not be accessed, its attributes are
+// then left null.
+func adaptAccountSummary(
+	instances []publiccloud.Instance,
+	loadBalancers []publiccloud.LoadBalancer,
+) accountSummaryDataSourceModel {
+	summary := accountSummaryDataSourceModel{
+		InstanceCount:     basetypes.NewInt32Null(),
+		LoadBalancerCount: basetypes.NewInt32Null(),
+		IPCount:           basetypes.NewInt32Null(),
+	}
+
+	if instances == nil && loadBalancers == nil {
+		return summary
+	}
+	summary.IPs = []accountSummaryIPDataSourceModel{}
+
+	if instances != nil {
+		summary.Instances = []accountSummaryProductDataSourceModel{}
+		for _, instance := range instances {
+			summary.Instances = append(
+				summary.Instances,
+				accountSummaryProductDataSourceModel{
+					ID:        basetypes.NewStringValue(instance.GetId()),
+					Reference: basetypes.NewStringPointerValue(instance.Reference.Get()),
+					Region:    basetypes.NewStringValue(string(instance.GetRegion())),
+					State:     basetypes.NewStringValue(string(instance.GetState())),
+					Type:      basetypes.NewStringValue(string(instance.GetType())),
+				},
+			)
+			for _, ip := range instance.GetIps() {
+				summary.IPs = append(
+					summary.IPs,
+					adaptIpToAccountSummaryIPDataSource(ip, instance.GetId()),
+				)
+			}
+		}
+		summary.InstanceCount = basetypes.NewInt32Value(int32(len(summary.Instances)))
+	}
+
+	if loadBalancers != nil {
+		summary.LoadBalancers = []accountSummaryProductDataSourceModel{}
+		for _, loadBalancer := range loadBalancers {
+			summary.LoadBalancers = append(
+				summary.LoadBalancers,
+				accountSummaryProductDataSourceModel{
+					ID:        basetypes.NewStringValue(loadBalancer.GetId()),
+					Reference: basetypes.NewStringPointerValue(loadBalancer.Reference.Get()),
+					Region:    basetypes.NewStringValue(string(loadBalancer.GetRegion())),
+					State:     basetypes.NewStringValue(string(loadBalancer.GetState())),
+					Type:      basetypes.NewStringValue(string(loadBalancer.GetType())),
+				},
+			)
+			for _, ip := range loadBalancer.GetIps() {
+				summary.IPs = append(
+					summary.IPs,
+					adaptIpToAccountSummaryIPDataSource(ip, loadBalancer.GetId()),
+				)
+			}
+		}
+		summary.LoadBalancerCount = basetypes.NewInt32Value(int32(len(summary.LoadBalancers)))
+	}
+
+	summary.IPCount = basetypes.NewInt32Value(int32(len(summary.IPs)))
+
+	return summary
+}
+
+type accountSummaryDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (a *accountSummaryDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	productAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier",
+		},
+		"reference": schema.StringAttribute{
+			Computed:    true,
+			Description: "The identifying name",
+		},
+		"region": schema.StringAttribute{
+			Computed: true,
+		},
+		"state": schema.StringAttribute{
+			Computed: true,
+		},
+		"type": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription + " Summarizes the Public Cloud instances, load balancers and their IPs in the account. Products the API token cannot access are left null.",
+		Attributes: map[string]schema.Attribute{
+			"instance_count": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The number of instances",
+			},
+			"instances": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: productAttributes,
+				},
+			},
+			"load_balancer_count": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The number of load balancers",
+			},
+			"load_balancers": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: productAttributes,
+				},
+			},
+			"ip_count": schema.Int32Attribute{
+				Computed:    true,
+				Description: "The number of IPs assigned to the instances and load balancers",
+			},
+			"ips": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							Computed: true,
+						},
+						"version": schema.Int32Attribute{
+							Computed: true,
+						},
+						"network_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the instance or load balancer the IP is assigned to",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *accountSummaryDataSource) Read(
+	ctx context.Context,
+	_ datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var wg sync.WaitGroup
+	var instances []publiccloud.Instance
+	var loadBalancers []publiccloud.LoadBalancer
+	var instancesResponse, loadBalancersResponse *http.Response
+	var instancesErr, loadBalancersErr error
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		instances, instancesResponse, instancesErr = listInstances(ctx, a.PubliccloudAPI)
+	}()
+	go func() {
+		defer wg.Done()
+		loadBalancers, loadBalancersResponse, loadBalancersErr = listLoadBalancers(ctx, a.PubliccloudAPI)
+	}()
+	wg.Wait()
+
+	if !a.handleFetchError(ctx, "instances", instancesErr, instancesResponse, response) {
+		return
+	}
+	if !a.handleFetchError(ctx, "load balancers", loadBalancersErr, loadBalancersResponse, response) {
+		return
+	}
+
+	response.Diagnostics.Append(
+		response.State.Set(ctx, adaptAccountSummary(instances, loadBalancers))...,
+	)
+}
+
+// handleFetchError reports whether the summary can still be built. A product
+// that the token cannot access only results in a warning.
+func (a *accountSummaryDataSource) handleFetchError(
+	ctx context.Context,
+	product string,
+	err error,
+	httpResponse *http.Response,
+	response *datasource.ReadResponse,
+) bool {
+	if err == nil {
+		return true
+	}
+
+	if client.ClassifyResponse(httpResponse, err) == client.ErrorClassAuthentication {
+		response.Diagnostics.AddWarning(
+			fmt.Sprintf("Public Cloud %s are not accessible", product),
+			fmt.Sprintf("The API token cannot access the Public Cloud %s, they are left out of the summary.", product),
+		)
+		return true
+	}
+
+	utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+	return false
+}
+
+// listInstances fetches all instances of the account.
+func listInstances(ctx context.Context, api publiccloud.PubliccloudAPI) (
+	[]publiccloud.Instance,
+	*http.Response,
+	error,
+) {
+	instances := []publiccloud.Instance{}
+	var offset *int32
+
+	request := api.GetInstanceList(ctx)
+	for {
+		result, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		instances = append(instances, result.GetInstances()...)
+
+		metadata := result.GetMetadata()
+
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+
+		if offset == nil {
+			return instances, httpResponse, nil
+		}
+
+		request = request.Offset(*offset)
+	}
+}
+
+// listLoadBalancers fetches all load balancers of the account.
+func listLoadBalancers(ctx context.Context, api publiccloud.PubliccloudAPI) (
+	[]publiccloud.LoadBalancer,
+	*http.Response,
+	error,
+) {
+	loadBalancers := []publiccloud.LoadBalancer{}
+	var offset *int32
+
+	request := api.GetLoadBalancerList(ctx)
+	for {
+		result, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		loadBalancers = append(loadBalancers, result.GetLoadBalancers()...)
+
+		metadata := result.GetMetadata()
+
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+
+		if offset == nil {
+			return loadBalancers, httpResponse, nil
+		}
+
+		request = request.Offset(*offset)
+	}
+}
+
+func NewAccountSummaryDataSource() datasource.DataSource {
+	return &accountSummaryDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_account_summary",
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptAccountSummary(t *testing.T) {
+	t.Run("summarizes the products and their IPs", func(t *testing.T) {
+		reference := "my-instance"
+		instances := []publiccloud.Instance{
+			{
+				Id:        "instanceId",
+				Reference: *publiccloud.NewNullableString(&reference),
+				Region:    publiccloud.REGIONNAME_EU_WEST_3,
+				State:     publiccloud.STATE_RUNNING,
+				Type:      publiccloud.TYPENAME_M3_LARGE,
+				Ips: []publiccloud.Ip{
+					{
+						Ip:          "127.0.0.1",
+						Version:     publiccloud.IPVERSION__4,
+						NetworkType: publiccloud.NETWORKTYPE_PUBLIC,
+					},
+				},
+			},
+		}
+		loadBalancers := []publiccloud.LoadBalancer{
+			{
+				Id:  "loadBalancerId",
+				Ips: []publiccloud.Ip{{Ip: "127.0.0.2"}},
+			},
+		}
+
+		got := adaptAccountSummary(instances, loadBalancers)
+
+		assert.Equal(t, int32(1), got.InstanceCount.ValueInt32())
+		assert.Equal(t, "instanceId", got.Instances[0].ID.ValueString())
+		assert.Equal(t, "my-instance", got.Instances[0].Reference.ValueString())
+		assert.Equal(t, "eu-west-3", got.Instances[0].Region.ValueString())
+		assert.Equal(t, "RUNNING", got.Instances[0].State.ValueString())
+		assert.Equal(t, "lsw.m3.large", got.Instances[0].Type.ValueString())
+
+		assert.Equal(t, int32(1), got.LoadBalancerCount.ValueInt32())
+		assert.True(t, got.LoadBalancers[0].Reference.IsNull())
+
+		assert.Equal(t, int32(2), got.IPCount.ValueInt32())
+		assert.Equal(t, "127.0.0.1", got.IPs[0].IP.ValueString())
+		assert.Equal(t, int32(4), got.IPs[0].Version.ValueInt32())
+		assert.Equal(t, "PUBLIC", got.IPs[0].NetworkType.ValueString())
+		assert.Equal(t, "instanceId", got.IPs[0].ResourceID.ValueString())
+		assert.Equal(t, "loadBalancerId", got.IPs[1].ResourceID.ValueString())
+	})
+
+	t.Run("leaves inaccessible products null", func(t *testing.T) {
+		got := adaptAccountSummary(
+			[]publiccloud.Instance{},
+			nil,
+		)
+
+		assert.Equal(t, int32(0), got.InstanceCount.ValueInt32())
+		assert.NotNil(t, got.Instances)
+		assert.True(t, got.LoadBalancerCount.IsNull())
+		assert.Nil(t, got.LoadBalancers)
+		assert.Equal(t, int32(0), got.IPCount.ValueInt32())
+	})
+
+	t.Run("leaves everything null without access", func(t *testing.T) {
+		got := adaptAccountSummary(nil, nil)
+
+		assert.True(t, got.InstanceCount.IsNull())
+		assert.True(t, got.LoadBalancerCount.IsNull())
+		assert.True(t, got.IPCount.IsNull())
+		assert.Nil(t, got.IPs)
+	})
+}
@@ -0,0 +1,571 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &autoScalingGroupResource{}
+	_ resource.ResourceWithImportState    = &autoScalingGroupResource{}
+	_ resource.ResourceWithValidateConfig = &autoScalingGroupResource{}
+)
+
+// scalingAttributes lists every attribute that configures the scaling of a
+// group.
+var scalingAttributes = []string{
+	"desired_amount",
+	"starts_at",
+	"ends_at",
+	"minimum_amount",
+	"maximum_amount",
+	"cpu_threshold",
+	"warmup_time",
+	"cooldown_time",
+}
+
+// autoScalingGroupAttributes lists the attributes that configure the scaling
+// of each type of auto scaling group. All of them are required for their
+// type and cannot be set for the others.
+var autoScalingGroupAttributes = map[publiccloud.AutoScalingGroupType][]string{
+	publiccloud.AUTOSCALINGGROUPTYPE_MANUAL: {"desired_amount"},
+	publiccloud.AUTOSCALINGGROUPTYPE_SCHEDULED: {
+		"desired_amount",
+		"starts_at",
+		"ends_at",
+	},
+	publiccloud.AUTOSCALINGGROUPTYPE_CPU_BASED: {
+		"minimum_amount",
+		"maximum_amount",
+		"cpu_threshold",
+		"warmup_time",
+		"cooldown_time",
+	},
+}
+
+type autoScalingGroupResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	InstanceID    types.String `tfsdk:"instance_id"`
+	Reference     types.String `tfsdk:"reference"`
+	Region        types.String `tfsdk:"region"`
+	State         types.String `tfsdk:"state"`
+	DesiredAmount types.Int32  `tfsdk:"desired_amount"`
+	StartsAt      types.String `tfsdk:"starts_at"`
+	EndsAt        types.String `tfsdk:"ends_at"`
+	MinimumAmount types.Int32  `tfsdk:"minimum_amount"`
+	MaximumAmount types.Int32  `tfsdk:"maximum_amount"`
+	CPUThreshold  types.Int32  `tfsdk:"cpu_threshold"`
+	WarmupTime    types.Int32  `tfsdk:"warmup_time"`
+	CooldownTime  types.Int32  `tfsdk:"cooldown_time"`
+	TargetGroupID types.String `tfsdk:"target_group_id"`
+}
+
+// adaptTimestamp keeps the planned timestamp if the API reports the same
+// moment in another notation, such as +00:00 instead of Z.
+func adaptTimestamp(value *time.Time, planned types.String) types.String {
+	if value == nil {
+		return basetypes.NewStringNull()
+	}
+
+	if !planned.IsNull() && !planned.IsUnknown() {
+		plannedTime, err := time.Parse(time.RFC3339, planned.ValueString())
+		if err == nil && plannedTime.Equal(*value) {
+			return planned
+		}
+	}
+
+	return basetypes.NewStringValue(value.Format(time.RFC3339))
+}
+
+// adaptAutoScalingGroupDetailsToAutoScalingGroupResource converts the API
+// response. The instance the group is based on is not returned by the API,
+// so it is taken from the plan or the state.
+func adaptAutoScalingGroupDetailsToAutoScalingGroupResource(
+	details publiccloud.AutoScalingGroupDetails,
+	previous autoScalingGroupResourceModel,
+) autoScalingGroupResourceModel {
+	autoScalingGroup := autoScalingGroupResourceModel{
+		ID:            basetypes.NewStringValue(details.GetId()),
+		Type:          basetypes.NewStringValue(string(details.GetType())),
+		InstanceID:    previous.InstanceID,
+		Reference:     basetypes.NewStringValue(details.GetReference()),
+		Region:        basetypes.NewStringValue(string(details.GetRegion())),
+		State:         basetypes.NewStringValue(string(details.GetState())),
+		DesiredAmount: basetypes.NewInt32PointerValue(details.DesiredAmount.Get()),
+		StartsAt:      adaptTimestamp(details.StartsAt.Get(), previous.StartsAt),
+		EndsAt:        adaptTimestamp(details.EndsAt.Get(), previous.EndsAt),
+		MinimumAmount: basetypes.NewInt32PointerValue(details.MinimumAmount.Get()),
+		MaximumAmount: basetypes.NewInt32PointerValue(details.MaximumAmount.Get()),
+		CPUThreshold:  basetypes.NewInt32PointerValue(details.CpuThreshold.Get()),
+		WarmupTime:    basetypes.NewInt32PointerValue(details.WarmupTime.Get()),
+		CooldownTime:  basetypes.NewInt32PointerValue(details.CooldownTime.Get()),
+		TargetGroupID: basetypes.NewStringNull(),
+	}
+
+	// Only the attributes of the type are kept. The desired amount of CPU
+	// based groups changes as they scale.
+	switch details.GetType() {
+	case publiccloud.AUTOSCALINGGROUPTYPE_CPU_BASED:
+		autoScalingGroup.DesiredAmount = basetypes.NewInt32Null()
+		autoScalingGroup.StartsAt = basetypes.NewStringNull()
+		autoScalingGroup.EndsAt = basetypes.NewStringNull()
+	case publiccloud.AUTOSCALINGGROUPTYPE_MANUAL:
+		autoScalingGroup.StartsAt = basetypes.NewStringNull()
+		autoScalingGroup.EndsAt = basetypes.NewStringNull()
+		fallthrough
+	case publiccloud.AUTOSCALINGGROUPTYPE_SCHEDULED:
+		autoScalingGroup.MinimumAmount = basetypes.NewInt32Null()
+		autoScalingGroup.MaximumAmount = basetypes.NewInt32Null()
+		autoScalingGroup.CPUThreshold = basetypes.NewInt32Null()
+		autoScalingGroup.WarmupTime = basetypes.NewInt32Null()
+		autoScalingGroup.CooldownTime = basetypes.NewInt32Null()
+	}
+
+	if targetGroups := details.GetTargetGroups(); len(targetGroups) > 0 {
+		autoScalingGroup.TargetGroupID = basetypes.NewStringValue(targetGroups[0].GetId())
+	}
+
+	return autoScalingGroup
+}
+
+// parseTimestamp returns nil if the value is not set. Invalid timestamps are
+// reported by the validator.
+func parseTimestamp(value types.String) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil
+	}
+
+	return &parsed
+}
+
+func (a autoScalingGroupResourceModel) generateCreateOpts() publiccloud.CreateAutoScalingGroupOpts {
+	opts := publiccloud.NewCreateAutoScalingGroupOpts(
+		a.InstanceID.ValueString(),
+		a.Reference.ValueString(),
+		a.Type.ValueString(),
+	)
+	opts.DesiredAmount = utils.AdaptInt32PointerValueToNullableInt32(a.DesiredAmount)
+	opts.StartsAt = parseTimestamp(a.StartsAt)
+	opts.EndsAt = parseTimestamp(a.EndsAt)
+	opts.MinimumAmount = utils.AdaptInt32PointerValueToNullableInt32(a.MinimumAmount)
+	opts.MaximumAmount = utils.AdaptInt32PointerValueToNullableInt32(a.MaximumAmount)
+	opts.CpuThreshold = utils.AdaptInt32PointerValueToNullableInt32(a.CPUThreshold)
+	opts.WarmupTime = utils.AdaptInt32PointerValueToNullableInt32(a.WarmupTime)
+	opts.CooldownTime = utils.AdaptInt32PointerValueToNullableInt32(a.CooldownTime)
+
+	return *opts
+}
+
+func (a autoScalingGroupResourceModel) generateUpdateOpts() publiccloud.UpdateAutoScalingGroupOpts {
+	opts := publiccloud.NewUpdateAutoScalingGroupOpts()
+	opts.SetReference(a.Reference.ValueString())
+	opts.DesiredAmount = utils.AdaptInt32PointerValueToNullableInt32(a.DesiredAmount)
+	opts.StartsAt = parseTimestamp(a.StartsAt)
+	opts.EndsAt = parseTimestamp(a.EndsAt)
+	opts.MinimumAmount = utils.AdaptInt32PointerValueToNullableInt32(a.MinimumAmount)
+	opts.MaximumAmount = utils.AdaptInt32PointerValueToNullableInt32(a.MaximumAmount)
+	opts.CpuThreshold = utils.AdaptInt32PointerValueToNullableInt32(a.CPUThreshold)
+	opts.WarmupTime = utils.AdaptInt32PointerValueToNullableInt32(a.WarmupTime)
+	opts.CooldownTime = utils.AdaptInt32PointerValueToNullableInt32(a.CooldownTime)
+
+	return *opts
+}
+
+type autoScalingGroupResource struct {
+	utils.ResourceAPI
+}
+
+func (a *autoScalingGroupResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(
+		ctx,
+		path.Root("id"),
+		request,
+		response,
+	)
+}
+
+func (a *autoScalingGroupResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	warningError := "**WARNING!** Changing this value once running will cause this auto scaling group to be destroyed and a new one to be created."
+
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Auto Scaling Group unique identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "How the group scales. `MANUAL` groups run `desired_amount` instances, `SCHEDULED` groups run them between `starts_at` and `ends_at`, and `CPU_BASED` groups run between `minimum_amount` and `maximum_amount` instances depending on their CPU usage. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedAutoScalingGroupTypeEnumValues) + "\n" + warningError,
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedAutoScalingGroupTypeEnumValues)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The instance the instances of the group are based on. It must be running or stopped. The API does not return it, so it is not refreshed, and imported groups take it from the configuration without being replaced.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(
+							_ context.Context,
+							request planmodifier.StringRequest,
+							response *stringplanmodifier.RequiresReplaceIfFuncResponse,
+						) {
+							// Imported groups do not know their instance.
+							response.RequiresReplace = !request.StateValue.IsNull()
+						},
+						"",
+						"",
+					),
+				},
+			},
+			"reference": schema.StringAttribute{
+				Required:    true,
+				Description: "The identifying name set to the auto scaling group",
+			},
+			"region": schema.StringAttribute{
+				Computed:    true,
+				Description: "The region of the instance the group is based on",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+			"desired_amount": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The number of instances that should be running. Required for `MANUAL` and `SCHEDULED` groups, cannot be set otherwise.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "When the instances of a `SCHEDULED` group are launched, as an RFC 3339 timestamp such as \"2024-05-01T08:00:00Z\". Required for `SCHEDULED` groups, cannot be set otherwise.",
+				Validators: []validator.String{
+					utils.TimestampValidator(),
+				},
+			},
+			"ends_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "When the instances of a `SCHEDULED` group are terminated, as an RFC 3339 timestamp. Required for `SCHEDULED` groups, cannot be set otherwise.",
+				Validators: []validator.String{
+					utils.TimestampValidator(),
+				},
+			},
+			"minimum_amount": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The minimum number of instances that should be running. Required for `CPU_BASED` groups, cannot be set otherwise.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"maximum_amount": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The maximum number of instances that can be running. Required for `CPU_BASED` groups, cannot be set otherwise.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"cpu_threshold": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The target average CPU utilization in percent. Required for `CPU_BASED` groups, cannot be set otherwise.",
+				Validators: []validator.Int32{
+					int32validator.Between(1, 100),
+				},
+			},
+			"warmup_time": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Warm-up time in seconds for new instances. Required for `CPU_BASED` groups, cannot be set otherwise.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"cooldown_time": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Cool-down time in seconds between scaling actions. Required for `CPU_BASED` groups, cannot be set otherwise.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"target_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The target group the instances of the group are registered in. Removing it deregisters the group from its target group.",
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures that exactly the attributes of the type of the group
+// are set, and that its amounts and schedule are in order.
+func (a *autoScalingGroupResource) ValidateConfig(
+	ctx context.Context,
+	request resource.ValidateConfigRequest,
+	response *resource.ValidateConfigResponse,
+) {
+	var config autoScalingGroupResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() || config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+
+	groupType := publiccloud.AutoScalingGroupType(config.Type.ValueString())
+	required, ok := autoScalingGroupAttributes[groupType]
+	if !ok {
+		return
+	}
+
+	configured := map[string]bool{
+		"desired_amount": !config.DesiredAmount.IsNull(),
+		"starts_at":      !config.StartsAt.IsNull(),
+		"ends_at":        !config.EndsAt.IsNull(),
+		"minimum_amount": !config.MinimumAmount.IsNull(),
+		"maximum_amount": !config.MaximumAmount.IsNull(),
+		"cpu_threshold":  !config.CPUThreshold.IsNull(),
+		"warmup_time":    !config.WarmupTime.IsNull(),
+		"cooldown_time":  !config.CooldownTime.IsNull(),
+	}
+	for _, attribute := range scalingAttributes {
+		isRequired := slices.Contains(required, attribute)
+
+		if isRequired && !configured[attribute] {
+			response.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s is required for %s auto scaling groups.", attribute, groupType),
+			)
+		}
+		if !isRequired && configured[attribute] {
+			response.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s cannot be set for %s auto scaling groups.", attribute, groupType),
+			)
+		}
+	}
+
+	if !config.MinimumAmount.IsNull() && !config.MinimumAmount.IsUnknown() &&
+		!config.MaximumAmount.IsNull() && !config.MaximumAmount.IsUnknown() &&
+		config.MinimumAmount.ValueInt32() > config.MaximumAmount.ValueInt32() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("maximum_amount"),
+			"Invalid Attribute Value",
+			fmt.Sprintf(
+				"maximum_amount %d must not be less than minimum_amount %d.",
+				config.MaximumAmount.ValueInt32(),
+				config.MinimumAmount.ValueInt32(),
+			),
+		)
+	}
+
+	startsAt := parseTimestamp(config.StartsAt)
+	endsAt := parseTimestamp(config.EndsAt)
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("ends_at"),
+			"Invalid Attribute Value",
+			fmt.Sprintf(
+				"ends_at %s must be after starts_at %s.",
+				config.EndsAt.ValueString(),
+				config.StartsAt.ValueString(),
+			),
+		)
+	}
+}
+
+func (a *autoScalingGroupResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	var plan autoScalingGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	details, httpResponse, err := a.PubliccloudAPI.CreateAutoScalingGroup(ctx).
+		CreateAutoScalingGroupOpts(plan.generateCreateOpts()).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptAutoScalingGroupDetailsToAutoScalingGroupResource(*details, plan)
+
+	// The group is kept in the state if the target group cannot be
+	// registered, so the next apply retries it.
+	state.TargetGroupID = a.registerTargetGroup(
+		ctx,
+		state.ID.ValueString(),
+		state.TargetGroupID,
+		plan.TargetGroupID,
+		&response.Diagnostics,
+	)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (a *autoScalingGroupResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	var currentState autoScalingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &currentState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	details, httpResponse, err := a.PubliccloudAPI.
+		GetAutoScalingGroup(ctx, currentState.ID.ValueString()).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptAutoScalingGroupDetailsToAutoScalingGroupResource(*details, currentState)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (a *autoScalingGroupResource) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	var plan autoScalingGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var currentState autoScalingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &currentState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	details, httpResponse, err := a.PubliccloudAPI.
+		UpdateAutoScalingGroup(ctx, plan.ID.ValueString()).
+		UpdateAutoScalingGroupOpts(plan.generateUpdateOpts()).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptAutoScalingGroupDetailsToAutoScalingGroupResource(*details, plan)
+	state.TargetGroupID = a.registerTargetGroup(
+		ctx,
+		state.ID.ValueString(),
+		currentState.TargetGroupID,
+		plan.TargetGroupID,
+		&response.Diagnostics,
+	)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+// registerTargetGroup moves the group from the current to the wanted target
+// group and returns the target group it ends up in.
+func (a *autoScalingGroupResource) registerTargetGroup(
+	ctx context.Context,
+	autoScalingGroupID string,
+	current types.String,
+	wanted types.String,
+	diags *diag.Diagnostics,
+) types.String {
+	if current.Equal(wanted) {
+		return current
+	}
+
+	if !current.IsNull() {
+		_, httpResponse, err := a.PubliccloudAPI.
+			DeregisterAutoScalingGroupTargetGroup(ctx, autoScalingGroupID).
+			TargetGroupIdOpts(*publiccloud.NewTargetGroupIdOpts(current.ValueString())).
+			Execute()
+		if err != nil {
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return current
+		}
+	}
+
+	if !wanted.IsNull() {
+		_, httpResponse, err := a.PubliccloudAPI.
+			RegisterAutoScalingGroupTargetGroup(ctx, autoScalingGroupID).
+			TargetGroupIdOpts(*publiccloud.NewTargetGroupIdOpts(wanted.ValueString())).
+			Execute()
+		if err != nil {
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return basetypes.NewStringNull()
+		}
+	}
+
+	return wanted
+}
+
+func (a *autoScalingGroupResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	var state autoScalingGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	httpResponse, err := a.PubliccloudAPI.
+		DeleteAutoScalingGroup(ctx, state.ID.ValueString()).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+	}
+}
+
+func NewAutoScalingGroupResource() resource.Resource {
+	return &autoScalingGroupResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "public_cloud_auto_scaling_group",
+		},
+	}
+}
@@ -0,0 +1,154 @@
+package publiccloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptTimestamp(t *testing.T) {
+	value := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+
+	t.Run("returns null if the value is not set", func(t *testing.T) {
+		got := adaptTimestamp(nil, basetypes.NewStringValue("2024-05-01T08:00:00Z"))
+
+		assert.True(t, got.IsNull())
+	})
+
+	t.Run("keeps the planned notation of the same moment", func(t *testing.T) {
+		got := adaptTimestamp(&value, basetypes.NewStringValue("2024-05-01T10:00:00+02:00"))
+
+		assert.Equal(t, "2024-05-01T10:00:00+02:00", got.ValueString())
+	})
+
+	t.Run("returns the value if it differs from the plan", func(t *testing.T) {
+		got := adaptTimestamp(&value, basetypes.NewStringValue("2024-05-01T09:00:00Z"))
+
+		assert.Equal(t, "2024-05-01T08:00:00Z", got.ValueString())
+	})
+}
+
+func Test_adaptAutoScalingGroupDetailsToAutoScalingGroupResource(t *testing.T) {
+	t.Run("main fields are set", func(t *testing.T) {
+		desiredAmount := int32(2)
+		details := publiccloud.AutoScalingGroupDetails{
+			Id:            "id",
+			Type:          publiccloud.AUTOSCALINGGROUPTYPE_MANUAL,
+			State:         publiccloud.AUTOSCALINGGROUPSTATE_ACTIVE,
+			DesiredAmount: *publiccloud.NewNullableInt32(&desiredAmount),
+			Region:        publiccloud.REGIONNAME_EU_WEST_3,
+			Reference:     "reference",
+			TargetGroups:  []publiccloud.TargetGroup{{Id: "targetGroupId"}},
+		}
+
+		got := adaptAutoScalingGroupDetailsToAutoScalingGroupResource(
+			details,
+			autoScalingGroupResourceModel{
+				InstanceID: basetypes.NewStringValue("instanceId"),
+			},
+		)
+
+		assert.Equal(t, "id", got.ID.ValueString())
+		assert.Equal(t, "MANUAL", got.Type.ValueString())
+		assert.Equal(t, "instanceId", got.InstanceID.ValueString())
+		assert.Equal(t, "reference", got.Reference.ValueString())
+		assert.Equal(t, "eu-west-3", got.Region.ValueString())
+		assert.Equal(t, "ACTIVE", got.State.ValueString())
+		assert.Equal(t, int32(2), got.DesiredAmount.ValueInt32())
+		assert.True(t, got.MinimumAmount.IsNull())
+		assert.True(t, got.StartsAt.IsNull())
+		assert.Equal(t, "targetGroupId", got.TargetGroupID.ValueString())
+	})
+
+	t.Run("the desired amount of CPU based groups is not kept", func(t *testing.T) {
+		desiredAmount := int32(3)
+		minimumAmount := int32(1)
+		details := publiccloud.AutoScalingGroupDetails{
+			Type:          publiccloud.AUTOSCALINGGROUPTYPE_CPU_BASED,
+			DesiredAmount: *publiccloud.NewNullableInt32(&desiredAmount),
+			MinimumAmount: *publiccloud.NewNullableInt32(&minimumAmount),
+		}
+
+		got := adaptAutoScalingGroupDetailsToAutoScalingGroupResource(
+			details,
+			autoScalingGroupResourceModel{},
+		)
+
+		assert.True(t, got.DesiredAmount.IsNull())
+		assert.Equal(t, int32(1), got.MinimumAmount.ValueInt32())
+		assert.True(t, got.TargetGroupID.IsNull())
+	})
+
+	t.Run("the schedule of scheduled groups is set", func(t *testing.T) {
+		startsAt := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+		endsAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		details := publiccloud.AutoScalingGroupDetails{
+			Type:     publiccloud.AUTOSCALINGGROUPTYPE_SCHEDULED,
+			StartsAt: *publiccloud.NewNullableTime(&startsAt),
+			EndsAt:   *publiccloud.NewNullableTime(&endsAt),
+		}
+
+		got := adaptAutoScalingGroupDetailsToAutoScalingGroupResource(
+			details,
+			autoScalingGroupResourceModel{},
+		)
+
+		assert.Equal(t, "2024-05-01T08:00:00Z", got.StartsAt.ValueString())
+		assert.Equal(t, "2024-05-01T12:00:00Z", got.EndsAt.ValueString())
+	})
+}
+
+func Test_autoScalingGroupResourceModel_generateCreateOpts(t *testing.T) {
+	model := autoScalingGroupResourceModel{
+		Type:          basetypes.NewStringValue("SCHEDULED"),
+		InstanceID:    basetypes.NewStringValue("instanceId"),
+		Reference:     basetypes.NewStringValue("reference"),
+		DesiredAmount: basetypes.NewInt32Value(2),
+		StartsAt:      basetypes.NewStringValue("2024-05-01T08:00:00Z"),
+		EndsAt:        basetypes.NewStringValue("2024-05-01T12:00:00Z"),
+		MinimumAmount: basetypes.NewInt32Null(),
+		MaximumAmount: basetypes.NewInt32Null(),
+		CPUThreshold:  basetypes.NewInt32Null(),
+		WarmupTime:    basetypes.NewInt32Null(),
+		CooldownTime:  basetypes.NewInt32Null(),
+	}
+
+	got := model.generateCreateOpts()
+
+	assert.Equal(t, "SCHEDULED", got.Type)
+	assert.Equal(t, "instanceId", got.InstanceId)
+	assert.Equal(t, "reference", got.Reference)
+	assert.Equal(t, int32(2), *got.DesiredAmount)
+	assert.Equal(t, time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC), *got.StartsAt)
+	assert.Equal(t, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), *got.EndsAt)
+	assert.Nil(t, got.MinimumAmount)
+	assert.Nil(t, got.CpuThreshold)
+}
+
+func Test_autoScalingGroupResourceModel_generateUpdateOpts(t *testing.T) {
+	model := autoScalingGroupResourceModel{
+		Reference:     basetypes.NewStringValue("reference"),
+		DesiredAmount: basetypes.NewInt32Null(),
+		StartsAt:      basetypes.NewStringNull(),
+		EndsAt:        basetypes.NewStringNull(),
+		MinimumAmount: basetypes.NewInt32Value(1),
+		MaximumAmount: basetypes.NewInt32Value(4),
+		CPUThreshold:  basetypes.NewInt32Value(60),
+		WarmupTime:    basetypes.NewInt32Value(400),
+		CooldownTime:  basetypes.NewInt32Value(300),
+	}
+
+	got := model.generateUpdateOpts()
+
+	assert.Equal(t, "reference", got.GetReference())
+	assert.Nil(t, got.DesiredAmount)
+	assert.Nil(t, got.StartsAt)
+	assert.Equal(t, int32(1), got.GetMinimumAmount())
+	assert.Equal(t, int32(4), got.GetMaximumAmount())
+	assert.Equal(t, int32(60), got.GetCpuThreshold())
+	assert.Equal(t, int32(400), got.GetWarmupTime())
+	assert.Equal(t, int32(300), got.GetCooldownTime())
+}
@@ -0,0 +1,252 @@
+package publiccloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &autoScalingGroupsDataSource{}
+)
+
+type autoScalingGroupsDataSourceModel struct {
+	ID                types.String                      `tfsdk:"id"`
+	InstanceID        types.String                      `tfsdk:"instance_id"`
+	Type              types.String                      `tfsdk:"type"`
+	Region            types.String                      `tfsdk:"region"`
+	Reference         types.String                      `tfsdk:"reference"`
+	AutoScalingGroups []autoScalingGroupDataSourceModel `tfsdk:"auto_scaling_groups"`
+}
+
+type autoScalingGroupDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Type          types.String `tfsdk:"type"`
+	State         types.String `tfsdk:"state"`
+	Region        types.String `tfsdk:"region"`
+	Reference     types.String `tfsdk:"reference"`
+	DesiredAmount types.Int32  `tfsdk:"desired_amount"`
+	StartsAt      types.String `tfsdk:"starts_at"`
+	EndsAt        types.String `tfsdk:"ends_at"`
+	MinimumAmount types.Int32  `tfsdk:"minimum_amount"`
+	MaximumAmount types.Int32  `tfsdk:"maximum_amount"`
+	CPUThreshold  types.Int32  `tfsdk:"cpu_threshold"`
+	WarmupTime    types.Int32  `tfsdk:"warmup_time"`
+	CooldownTime  types.Int32  `tfsdk:"cooldown_time"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+func adaptAutoScalingGroupToAutoScalingGroupDataSource(
+	autoScalingGroup publiccloud.AutoScalingGroup,
+) autoScalingGroupDataSourceModel {
+	createdAt := autoScalingGroup.GetCreatedAt()
+	updatedAt := autoScalingGroup.GetUpdatedAt()
+
+	return autoScalingGroupDataSourceModel{
+		ID:            basetypes.NewStringValue(autoScalingGroup.GetId()),
+		Type:          basetypes.NewStringValue(string(autoScalingGroup.GetType())),
+		State:         basetypes.NewStringValue(string(autoScalingGroup.GetState())),
+		Region:        basetypes.NewStringValue(string(autoScalingGroup.GetRegion())),
+		Reference:     basetypes.NewStringValue(autoScalingGroup.GetReference()),
+		DesiredAmount: basetypes.NewInt32PointerValue(autoScalingGroup.DesiredAmount.Get()),
+		StartsAt:      utils.AdaptNullableTimeToStringValue(autoScalingGroup.StartsAt.Get()),
+		EndsAt:        utils.AdaptNullableTimeToStringValue(autoScalingGroup.EndsAt.Get()),
+		MinimumAmount: basetypes.NewInt32PointerValue(autoScalingGroup.MinimumAmount.Get()),
+		MaximumAmount: basetypes.NewInt32PointerValue(autoScalingGroup.MaximumAmount.Get()),
+		CPUThreshold:  basetypes.NewInt32PointerValue(autoScalingGroup.CpuThreshold.Get()),
+		WarmupTime:    basetypes.NewInt32PointerValue(autoScalingGroup.WarmupTime.Get()),
+		CooldownTime:  basetypes.NewInt32PointerValue(autoScalingGroup.CooldownTime.Get()),
+		CreatedAt:     utils.AdaptNullableTimeToStringValue(&createdAt),
+		UpdatedAt:     utils.AdaptNullableTimeToStringValue(&updatedAt),
+	}
+}
+
+type autoScalingGroupsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (a *autoScalingGroupsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Auto Scaling Group ID",
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return the auto scaling group the instance belongs to",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedAutoScalingGroupTypeEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedAutoScalingGroupTypeEnumValues)...),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Region name. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedRegionNameEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedRegionNameEnumValues)...),
+				},
+			},
+			"reference": schema.StringAttribute{
+				Optional:    true,
+				Description: "The identifying name set to the auto scaling group",
+			},
+			"auto_scaling_groups": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Auto Scaling Group ID",
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"state": schema.StringAttribute{
+							Computed: true,
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Region name",
+						},
+						"reference": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifying name set to the auto scaling group",
+						},
+						"desired_amount": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of instances that should be running",
+						},
+						"starts_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Only for `SCHEDULED` groups. Date and time (UTC) that the instances need to be launched",
+						},
+						"ends_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Only for `SCHEDULED` groups. Date and time (UTC) that the instances need to be terminated",
+						},
+						"minimum_amount": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The minimum number of instances that should be running",
+						},
+						"maximum_amount": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Only for `CPU_BASED` groups. The maximum number of instances that can be running",
+						},
+						"cpu_threshold": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Only for `CPU_BASED` groups. The target average CPU utilization for scaling",
+						},
+						"warmup_time": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Only for `CPU_BASED` groups. Warm-up time in seconds for new instances",
+						},
+						"cooldown_time": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Only for `CPU_BASED` groups. Cool-down time in seconds for new instances",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time when the Auto Scaling Group was created",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time when the Auto Scaling Group was last updated",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *autoScalingGroupsDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config autoScalingGroupsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	autoScalingGroupsRequest := a.PubliccloudAPI.GetAutoScalingGroupList(ctx)
+	if !config.ID.IsNull() {
+		autoScalingGroupsRequest = autoScalingGroupsRequest.Id(config.ID.ValueString())
+	}
+	if !config.InstanceID.IsNull() {
+		autoScalingGroupsRequest = autoScalingGroupsRequest.InstanceId(config.InstanceID.ValueString())
+	}
+	if !config.Type.IsNull() {
+		autoScalingGroupsRequest = autoScalingGroupsRequest.Type_(config.Type.ValueString())
+	}
+	if !config.Region.IsNull() {
+		autoScalingGroupsRequest = autoScalingGroupsRequest.Region(publiccloud.RegionName(config.Region.ValueString()))
+	}
+	if !config.Reference.IsNull() {
+		autoScalingGroupsRequest = autoScalingGroupsRequest.Reference(config.Reference.ValueString())
+	}
+	var autoScalingGroups []publiccloud.AutoScalingGroup
+	var offset *int32
+	for {
+		result, httpResponse, err := autoScalingGroupsRequest.Execute()
+		if err != nil {
+			utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+			return
+		}
+
+		autoScalingGroups = append(autoScalingGroups, result.GetAutoScalingGroups()...)
+
+		metadata := result.GetMetadata()
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+		if offset == nil {
+			break
+		}
+
+		autoScalingGroupsRequest = autoScalingGroupsRequest.Offset(*offset)
+	}
+
+	state := autoScalingGroupsDataSourceModel{}
+	for _, autoScalingGroup := range autoScalingGroups {
+		state.AutoScalingGroups = append(
+			state.AutoScalingGroups,
+			adaptAutoScalingGroupToAutoScalingGroupDataSource(autoScalingGroup),
+		)
+	}
+	state.ID = config.ID
+	state.InstanceID = config.InstanceID
+	state.Type = config.Type
+	state.Region = config.Region
+	state.Reference = config.Reference
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func NewAutoScalingGroupsDataSource() datasource.DataSource {
+	return &autoScalingGroupsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_auto_scaling_groups",
+		},
+	}
+}
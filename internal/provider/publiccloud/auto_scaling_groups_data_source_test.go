@@ -0,0 +1,42 @@
+package publiccloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptAutoScalingGroupToAutoScalingGroupDataSource(t *testing.T) {
+	t.Run("CPU based groups are set", func(t *testing.T) {
+		minimumAmount := int32(1)
+		maximumAmount := int32(3)
+		cpuThreshold := int32(50)
+		sdkAutoScalingGroup := publiccloud.AutoScalingGroup{
+			Id:            "id",
+			Type:          publiccloud.AUTOSCALINGGROUPTYPE_CPU_BASED,
+			State:         publiccloud.AUTOSCALINGGROUPSTATE_SCALING,
+			Region:        publiccloud.REGIONNAME_EU_WEST_3,
+			Reference:     "reference",
+			MinimumAmount: *publiccloud.NewNullableInt32(&minimumAmount),
+			MaximumAmount: *publiccloud.NewNullableInt32(&maximumAmount),
+			CpuThreshold:  *publiccloud.NewNullableInt32(&cpuThreshold),
+			CreatedAt:     time.Date(2024, 5, 13, 15, 49, 52, 0, time.UTC),
+		}
+
+		got := adaptAutoScalingGroupToAutoScalingGroupDataSource(sdkAutoScalingGroup)
+
+		assert.Equal(t, "id", got.ID.ValueString())
+		assert.Equal(t, "CPU_BASED", got.Type.ValueString())
+		assert.Equal(t, "SCALING", got.State.ValueString())
+		assert.Equal(t, "eu-west-3", got.Region.ValueString())
+		assert.Equal(t, "reference", got.Reference.ValueString())
+		assert.Equal(t, int32(1), got.MinimumAmount.ValueInt32())
+		assert.Equal(t, int32(3), got.MaximumAmount.ValueInt32())
+		assert.Equal(t, int32(50), got.CPUThreshold.ValueInt32())
+		assert.True(t, got.DesiredAmount.IsNull())
+		assert.True(t, got.StartsAt.IsNull())
+		assert.Equal(t, "2024-05-13 15:49:52 +0000 UTC", got.CreatedAt.ValueString())
+	})
+}
@@ -0,0 +1,314 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/client"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &billingSummaryDataSource{}
+)
+
+const (
+	billingPeriodFormat = "2006-01"
+	billingDateFormat   = "2006-01-02"
+)
+
+type billingSummaryProductDataSourceModel struct {
+	ID           types.String  `tfsdk:"id"`
+	Reference    types.String  `tfsdk:"reference"`
+	ProductType  types.String  `tfsdk:"type"`
+	Hours        types.Int32   `tfsdk:"hours"`
+	Price        types.Float64 `tfsdk:"price"`
+	TrafficPrice types.Float64 `tfsdk:"traffic_price"`
+	Total        types.Float64 `tfsdk:"total"`
+}
+
+type billingSummaryDataSourceModel struct {
+	Period   types.String                           `tfsdk:"period"`
+	From     types.String                           `tfsdk:"from"`
+	To       types.String                           `tfsdk:"to"`
+	Total    types.Float64                          `tfsdk:"total"`
+	Products []billingSummaryProductDataSourceModel `tfsdk:"products"`
+}
+
+// billingProduct is a product that is billed by the hour.
+type billingProduct struct {
+	id          string
+	reference   *string
+	productType string
+}
+
+// roundAmount drops the floating point noise of summing prices.
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// adaptExpensesToBillingSummaryProduct sums up the costs of all billing
+// periods and the traffic tiers of a product.
+func adaptExpensesToBillingSummaryProduct(
+	product billingProduct,
+	expenses publiccloud.GetExpensesResult,
+) (*billingSummaryProductDataSourceModel, error) {
+	var hours int32
+	var price, trafficPrice float64
+
+	billing := expenses.GetBilling()
+	for _, instance := range billing.GetInstances() {
+		hours += instance.GetHours()
+
+		if instance.Price == nil {
+			continue
+		}
+		instancePrice, err := strconv.ParseFloat(instance.GetPrice(), 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse price %q of %s: %w", instance.GetPrice(), product.id, err)
+		}
+		price += instancePrice
+	}
+
+	traffic := billing.GetTraffic()
+	values := traffic.GetValues()
+	for _, tier := range []publiccloud.Tier{
+		values.GetTier0(),
+		values.GetTier1(),
+		values.GetTier2(),
+		values.GetTier3(),
+	} {
+		// Formatting as float32 avoids the conversion noise of float64(x).
+		tierPrice, _ := strconv.ParseFloat(
+			strconv.FormatFloat(float64(tier.GetPrice()), 'f', -1, 32),
+			64,
+		)
+		trafficPrice += tierPrice
+	}
+
+	return &billingSummaryProductDataSourceModel{
+		ID:           basetypes.NewStringValue(product.id),
+		Reference:    basetypes.NewStringPointerValue(product.reference),
+		ProductType:  basetypes.NewStringValue(product.productType),
+		Hours:        basetypes.NewInt32Value(hours),
+		Price:        basetypes.NewFloat64Value(roundAmount(price)),
+		TrafficPrice: basetypes.NewFloat64Value(roundAmount(trafficPrice)),
+		Total:        basetypes.NewFloat64Value(roundAmount(price + trafficPrice)),
+	}, nil
+}
+
+// getBillingPeriod returns the month to bill, the current one by default.
+func getBillingPeriod(period types.String, now time.Time) (time.Time, error) {
+	if period.IsNull() || period.IsUnknown() {
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+	}
+
+	return time.Parse(billingPeriodFormat, period.ValueString())
+}
+
+type billingSummaryDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (b *billingSummaryDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription + " Summarizes the charges of the Public Cloud instances and load balancers in the account for a month. Amounts are in the currency of the account and rounded to two decimals. If the API token cannot access the expenses, the summary is left null.",
+		Attributes: map[string]schema.Attribute{
+			"period": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The month to summarize in the format `yyyy-mm`. Defaults to the current month.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^\d{4}-(0[1-9]|1[0-2])$`),
+						"must be a month in the format yyyy-mm",
+					),
+				},
+			},
+			"from": schema.StringAttribute{
+				Computed:    true,
+				Description: "The first day of the period",
+			},
+			"to": schema.StringAttribute{
+				Computed:    true,
+				Description: "The first day after the period",
+			},
+			"total": schema.Float64Attribute{
+				Computed:    true,
+				Description: "The total charges of all products",
+			},
+			"products": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier of the product",
+						},
+						"reference": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifying name of the product",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The kind of product, `instance` or `load_balancer`",
+						},
+						"hours": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The number of billed hours",
+						},
+						"price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The charges for running the product",
+						},
+						"traffic_price": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The charges for data traffic",
+						},
+						"total": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The total charges of the product",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (b *billingSummaryDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config billingSummaryDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	from, err := getBillingPeriod(config.Period, time.Now().UTC())
+	if err != nil {
+		utils.GeneralError(&response.Diagnostics, ctx, err)
+		return
+	}
+	to := from.AddDate(0, 1, 0)
+
+	state := billingSummaryDataSourceModel{
+		Period: basetypes.NewStringValue(from.Format(billingPeriodFormat)),
+		From:   basetypes.NewStringValue(from.Format(billingDateFormat)),
+		To:     basetypes.NewStringValue(to.Format(billingDateFormat)),
+		Total:  basetypes.NewFloat64Null(),
+	}
+
+	products, httpResponse, err := b.getProducts(ctx)
+	if err != nil {
+		b.handleFetchError(ctx, state, err, httpResponse, response)
+		return
+	}
+
+	var total float64
+	state.Products = []billingSummaryProductDataSourceModel{}
+	for _, product := range products {
+		expenses, httpResponse, err := b.PubliccloudAPI.GetExpenses(ctx, product.id).
+			From(from.Format(billingDateFormat)).
+			To(to.Format(billingDateFormat)).
+			Execute()
+		if err != nil {
+			b.handleFetchError(ctx, state, err, httpResponse, response)
+			return
+		}
+
+		summary, err := adaptExpensesToBillingSummaryProduct(product, *expenses)
+		if err != nil {
+			utils.GeneralError(&response.Diagnostics, ctx, err)
+			return
+		}
+		total += summary.Total.ValueFloat64()
+		state.Products = append(state.Products, *summary)
+	}
+	state.Total = basetypes.NewFloat64Value(roundAmount(total))
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+// handleFetchError leaves the summary null with a warning if the token cannot
+// access the expenses, other errors fail the read.
+func (b *billingSummaryDataSource) handleFetchError(
+	ctx context.Context,
+	state billingSummaryDataSourceModel,
+	err error,
+	httpResponse *http.Response,
+	response *datasource.ReadResponse,
+) {
+	if client.ClassifyResponse(httpResponse, err) != client.ErrorClassAuthentication {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	response.Diagnostics.AddWarning(
+		"Public Cloud expenses are not accessible",
+		"The API token cannot access the Public Cloud expenses, the billing summary is left null.",
+	)
+
+	state.Products = nil
+	state.Total = basetypes.NewFloat64Null()
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (b *billingSummaryDataSource) getProducts(ctx context.Context) (
+	[]billingProduct,
+	*http.Response,
+	error,
+) {
+	instances, httpResponse, err := listInstances(ctx, b.PubliccloudAPI)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+	loadBalancers, httpResponse, err := listLoadBalancers(ctx, b.PubliccloudAPI)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	var products []billingProduct
+	for _, instance := range instances {
+		products = append(products, billingProduct{
+			id:          instance.GetId(),
+			reference:   instance.Reference.Get(),
+			productType: "instance",
+		})
+	}
+	for _, loadBalancer := range loadBalancers {
+		products = append(products, billingProduct{
+			id:          loadBalancer.GetId(),
+			reference:   loadBalancer.Reference.Get(),
+			productType: "load_balancer",
+		})
+	}
+
+	return products, httpResponse, nil
+}
+
+func NewBillingSummaryDataSource() datasource.DataSource {
+	return &billingSummaryDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_billing_summary",
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package publiccloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_adaptExpensesToBillingSummaryProduct(t *testing.T) {
+	reference := "reference"
+	product := billingProduct{
+		id:          "id",
+		reference:   &reference,
+		productType: "instance",
+	}
+
+	t.Run("billing periods and traffic are summed up", func(t *testing.T) {
+		tier := func(price float32) *publiccloud.Tier {
+			return &publiccloud.Tier{Price: &price}
+		}
+		expenses := publiccloud.GetExpensesResult{
+			Billing: &publiccloud.Billing{
+				Instances: []publiccloud.ExpenseResultInstance{
+					{Hours: publiccloud.PtrInt32(14), Price: publiccloud.PtrString("0.72")},
+					{Hours: publiccloud.PtrInt32(2), Price: publiccloud.PtrString("0.1")},
+				},
+				Traffic: &publiccloud.Traffic{
+					Values: &publiccloud.Values{
+						Tier0: tier(0),
+						Tier1: tier(15.54),
+						Tier2: tier(13.45),
+						Tier3: tier(2.34),
+					},
+				},
+			},
+		}
+
+		got, err := adaptExpensesToBillingSummaryProduct(product, expenses)
+
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			billingSummaryProductDataSourceModel{
+				ID:           basetypes.NewStringValue("id"),
+				Reference:    basetypes.NewStringValue("reference"),
+				ProductType:  basetypes.NewStringValue("instance"),
+				Hours:        basetypes.NewInt32Value(16),
+				Price:        basetypes.NewFloat64Value(0.82),
+				TrafficPrice: basetypes.NewFloat64Value(31.33),
+				Total:        basetypes.NewFloat64Value(32.15),
+			},
+			*got,
+		)
+	})
+
+	t.Run("products without costs are free", func(t *testing.T) {
+		got, err := adaptExpensesToBillingSummaryProduct(
+			product,
+			publiccloud.GetExpensesResult{},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), got.Hours.ValueInt32())
+		assert.Equal(t, float64(0), got.Total.ValueFloat64())
+	})
+
+	t.Run("invalid prices are reported", func(t *testing.T) {
+		expenses := publiccloud.GetExpensesResult{
+			Billing: &publiccloud.Billing{
+				Instances: []publiccloud.ExpenseResultInstance{
+					{Price: publiccloud.PtrString("free")},
+				},
+			},
+		}
+
+		_, err := adaptExpensesToBillingSummaryProduct(product, expenses)
+
+		assert.ErrorContains(t, err, `cannot parse price "free" of id`)
+	})
+}
+
+func Test_getBillingPeriod(t *testing.T) {
+	now := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+
+	t.Run("defaults to the current month", func(t *testing.T) {
+		got, err := getBillingPeriod(basetypes.NewStringNull(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), got)
+	})
+
+	t.Run("parses the configured month", func(t *testing.T) {
+		got, err := getBillingPeriod(basetypes.NewStringValue("2023-11"), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC), got)
+	})
+}
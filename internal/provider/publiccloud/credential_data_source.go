@@ -42,6 +42,8 @@ func (d *credentialDataSource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Description: utils.BetaDescription,
+		DeprecationMessage: "This data source stores the password in the Terraform state. " +
+			"Use the leaseweb_public_cloud_credential ephemeral resource instead, which does not.",
 		Attributes: map[string]schema.Attribute{
 			"instance_id": schema.StringAttribute{
 				Description: "The ID of the instance.",
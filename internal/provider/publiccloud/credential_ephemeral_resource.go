@@ -0,0 +1,97 @@
+package publiccloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = &credentialEphemeralResource{}
+)
+
+type credentialEphemeralResource struct {
+	utils.EphemeralResourceAPI
+}
+
+func NewCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &credentialEphemeralResource{
+		EphemeralResourceAPI: utils.EphemeralResourceAPI{
+			Name: "public_cloud_credential",
+		},
+	}
+}
+
+type credentialEphemeralResourceModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	Type       types.String `tfsdk:"type"`
+}
+
+func (e *credentialEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: utils.BetaDescription + " Fetches a credential of an instance without storing it in the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the instance.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the credential. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedCredentialTypeEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedCredentialTypeEnumValues)...),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The username for the credentials",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The password for the credentials",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (e *credentialEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var config credentialEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credential, response, err := e.PubliccloudAPI.GetCredential(
+		ctx,
+		config.InstanceID.ValueString(),
+		publiccloud.CredentialType(config.Type.ValueString()),
+		config.Username.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, response)
+		return
+	}
+
+	config.Password = types.StringValue(credential.GetPassword())
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
@@ -3,7 +3,9 @@ package publiccloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
@@ -16,8 +18,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &credentialResource{}
-	_ resource.ResourceWithConfigure = &credentialResource{}
+	_ resource.Resource                = &credentialResource{}
+	_ resource.ResourceWithConfigure   = &credentialResource{}
+	_ resource.ResourceWithImportState = &credentialResource{}
 )
 
 type credentialResource struct {
@@ -25,10 +28,46 @@ type credentialResource struct {
 }
 
 type credentialResourceModel struct {
-	InstanceID types.String `tfsdk:"instance_id"`
-	Username   types.String `tfsdk:"username"`
-	Type       types.String `tfsdk:"type"`
-	Password   types.String `tfsdk:"password"`
+	InstanceID        types.String `tfsdk:"instance_id"`
+	Username          types.String `tfsdk:"username"`
+	Type              types.String `tfsdk:"type"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int32  `tfsdk:"password_wo_version"`
+}
+
+// adaptCredentialToCredentialResource leaves the password out of the state
+// when it is set through the write-only password_wo attribute.
+func adaptCredentialToCredentialResource(
+	instanceID types.String,
+	credentialType string,
+	username string,
+	password string,
+	passwordWOVersion types.Int32,
+) credentialResourceModel {
+	state := credentialResourceModel{
+		InstanceID:        instanceID,
+		Type:              types.StringValue(credentialType),
+		Username:          types.StringValue(username),
+		Password:          types.StringValue(password),
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: passwordWOVersion,
+	}
+	if !passwordWOVersion.IsNull() {
+		state.Password = types.StringNull()
+	}
+
+	return state
+}
+
+// password returns the password to send to the API, which comes from the
+// configuration when the write-only password_wo attribute is used.
+func (c credentialResourceModel) password(config credentialResourceModel) string {
+	if c.Password.IsNull() {
+		return config.PasswordWO.ValueString()
+	}
+
+	return c.Password.ValueString()
 }
 
 func NewCredentialResource() resource.Resource {
@@ -39,6 +78,19 @@ func NewCredentialResource() resource.Resource {
 	}
 }
 
+func (c *credentialResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"instance_id", "type", "username"},
+		req,
+		resp,
+	)
+}
+
 func (c *credentialResource) Schema(
 	_ context.Context,
 	_ resource.SchemaRequest,
@@ -75,11 +127,29 @@ func (c *credentialResource) Schema(
 				},
 			},
 			"password": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: `The password for the credentials`,
+				Description: "The password for the credentials. Either `password` or `password_wo` must be set.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The password for the credentials. Unlike `password`, it is never stored in the state. Requires Terraform 1.11 or later.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Version of `password_wo`. As Terraform cannot detect changes to write-only attributes, change this value to update the password.",
+				Validators: []validator.Int32{
+					int32validator.AlsoRequires(path.MatchRoot("password_wo")),
 				},
 			},
 		},
@@ -91,8 +161,9 @@ func (c *credentialResource) Create(
 	req resource.CreateRequest,
 	resp *resource.CreateResponse,
 ) {
-	var plan credentialResourceModel
+	var plan, config credentialResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -100,7 +171,7 @@ func (c *credentialResource) Create(
 	opts := publiccloud.NewStoreCredentialOpts(
 		publiccloud.CredentialType(plan.Type.ValueString()),
 		plan.Username.ValueString(),
-		plan.Password.ValueString(),
+		plan.password(config),
 	)
 	request := c.PubliccloudAPI.StoreCredential(
 		ctx,
@@ -115,12 +186,13 @@ func (c *credentialResource) Create(
 	resp.Diagnostics.Append(
 		resp.State.Set(
 			ctx,
-			credentialResourceModel{
-				InstanceID: plan.InstanceID,
-				Type:       types.StringValue(string(result.GetType())),
-				Password:   types.StringValue(result.GetPassword()),
-				Username:   types.StringValue(result.GetUsername()),
-			},
+			adaptCredentialToCredentialResource(
+				plan.InstanceID,
+				string(result.GetType()),
+				result.GetUsername(),
+				result.GetPassword(),
+				plan.PasswordWOVersion,
+			),
 		)...,
 	)
 }
@@ -151,12 +223,13 @@ func (c *credentialResource) Read(
 	resp.Diagnostics.Append(
 		resp.State.Set(
 			ctx,
-			credentialResourceModel{
-				InstanceID: state.InstanceID,
-				Type:       types.StringValue(string(result.GetType())),
-				Password:   types.StringValue(result.GetPassword()),
-				Username:   types.StringValue(result.GetUsername()),
-			},
+			adaptCredentialToCredentialResource(
+				state.InstanceID,
+				string(result.GetType()),
+				result.GetUsername(),
+				result.GetPassword(),
+				state.PasswordWOVersion,
+			),
 		)...,
 	)
 }
@@ -166,14 +239,15 @@ func (c *credentialResource) Update(
 	req resource.UpdateRequest,
 	resp *resource.UpdateResponse,
 ) {
-	var plan credentialResourceModel
+	var plan, config credentialResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	opts := publiccloud.NewUpdateCredentialOpts(
-		plan.Password.ValueString(),
+		plan.password(config),
 	)
 	request := c.PubliccloudAPI.UpdateCredential(
 		ctx,
@@ -190,12 +264,13 @@ func (c *credentialResource) Update(
 	resp.Diagnostics.Append(
 		resp.State.Set(
 			ctx,
-			credentialResourceModel{
-				InstanceID: plan.InstanceID,
-				Type:       types.StringValue(string(result.GetType())),
-				Password:   types.StringValue(result.GetPassword()),
-				Username:   types.StringValue(result.GetUsername()),
-			},
+			adaptCredentialToCredentialResource(
+				plan.InstanceID,
+				string(result.GetType()),
+				result.GetUsername(),
+				result.GetPassword(),
+				plan.PasswordWOVersion,
+			),
 		)...,
 	)
 }
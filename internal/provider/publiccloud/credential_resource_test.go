@@ -0,0 +1,63 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptCredentialToCredentialResource(t *testing.T) {
+	t.Run("password is stored in the state", func(t *testing.T) {
+		got := adaptCredentialToCredentialResource(
+			types.StringValue("id"),
+			"OPERATING_SYSTEM",
+			"root",
+			"secret",
+			types.Int32Null(),
+		)
+
+		assert.Equal(t, "id", got.InstanceID.ValueString())
+		assert.Equal(t, "OPERATING_SYSTEM", got.Type.ValueString())
+		assert.Equal(t, "root", got.Username.ValueString())
+		assert.Equal(t, "secret", got.Password.ValueString())
+		assert.True(t, got.PasswordWO.IsNull())
+		assert.True(t, got.PasswordWOVersion.IsNull())
+	})
+
+	t.Run("write-only password is not stored in the state", func(t *testing.T) {
+		got := adaptCredentialToCredentialResource(
+			types.StringValue("id"),
+			"OPERATING_SYSTEM",
+			"root",
+			"secret",
+			types.Int32Value(2),
+		)
+
+		assert.True(t, got.Password.IsNull())
+		assert.True(t, got.PasswordWO.IsNull())
+		assert.Equal(t, int32(2), got.PasswordWOVersion.ValueInt32())
+	})
+}
+
+func Test_credentialResourceModel_password(t *testing.T) {
+	t.Run("password is taken from the plan", func(t *testing.T) {
+		plan := credentialResourceModel{Password: types.StringValue("plan")}
+		config := credentialResourceModel{Password: types.StringValue("plan")}
+
+		assert.Equal(t, "plan", plan.password(config))
+	})
+
+	t.Run("write-only password is taken from the configuration", func(t *testing.T) {
+		plan := credentialResourceModel{
+			Password:   types.StringNull(),
+			PasswordWO: types.StringNull(),
+		}
+		config := credentialResourceModel{
+			Password:   types.StringNull(),
+			PasswordWO: types.StringValue("config"),
+		}
+
+		assert.Equal(t, "config", plan.password(config))
+	})
+}
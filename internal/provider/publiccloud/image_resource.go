@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,7 +17,8 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure = &imageResource{}
+	_ resource.ResourceWithConfigure   = &imageResource{}
+	_ resource.ResourceWithImportState = &imageResource{}
 )
 
 type imageResourceModel struct {
@@ -97,9 +99,22 @@ The id of the instance which the custom image is based on. The following rules a
   - instance exists for instanceId
   - instance has state *STOPPED*
   - instance has a maximum rootDiskSize of 100 GB
-  - instance OS must not be *windows*`,
+  - instance OS must not be *windows*
+
+The API does not return it, so imported images take it from the configuration without being replaced.`,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						func(
+							_ context.Context,
+							request planmodifier.StringRequest,
+							response *stringplanmodifier.RequiresReplaceIfFuncResponse,
+						) {
+							// Imported images do not know their instance.
+							response.RequiresReplace = !request.StateValue.IsNull()
+						},
+						"",
+						"",
+					),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -246,6 +261,8 @@ func (i *imageResource) Update(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	// instanceId has to be set manually as it isn't returned from the API
+	state.InstanceID = plan.InstanceID
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -258,6 +275,14 @@ func (i *imageResource) Delete(
 ) {
 }
 
+func (i *imageResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
 func NewImageResource() resource.Resource {
 	return &imageResource{
 		ResourceAPI: utils.ResourceAPI{
@@ -2,10 +2,16 @@ package publiccloud
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
@@ -27,8 +33,151 @@ type imageModelDataSource struct {
 	Region       types.String `tfsdk:"region"`
 }
 
+const (
+	imageVisibilityPublic  = "public"
+	imageVisibilityPrivate = "private"
+)
+
+// storageSizeUnits converts the storage size units to GB.
+var storageSizeUnits = map[string]float64{
+	"MB": 1.0 / 1024,
+	"GB": 1,
+	"TB": 1024,
+}
+
 type imagesDataSourceModel struct {
-	Images []imageModelDataSource `tfsdk:"images"`
+	Region        types.String           `tfsdk:"region"`
+	OSFamily      types.String           `tfsdk:"os_family"`
+	Visibility    types.String           `tfsdk:"visibility"`
+	CreatedAfter  types.String           `tfsdk:"created_after"`
+	CreatedBefore types.String           `tfsdk:"created_before"`
+	MinSize       types.Float64          `tfsdk:"min_size"`
+	MaxSize       types.Float64          `tfsdk:"max_size"`
+	Images        []imageModelDataSource `tfsdk:"images"`
+}
+
+// validateFilters checks the client-side filters before any request is made.
+func (i imagesDataSourceModel) validateFilters(diags *diag.Diagnostics) {
+	createdAfter, afterErr := parseImageDate(i.CreatedAfter)
+	if afterErr != nil {
+		invalidImageDateError("created_after", i.CreatedAfter, diags)
+	}
+	createdBefore, beforeErr := parseImageDate(i.CreatedBefore)
+	if beforeErr != nil {
+		invalidImageDateError("created_before", i.CreatedBefore, diags)
+	}
+
+	if createdAfter != nil && createdBefore != nil && createdAfter.After(*createdBefore) {
+		diags.AddAttributeError(
+			path.Root("created_before"),
+			"Invalid date range",
+			"created_before must not be earlier than created_after.",
+		)
+	}
+
+	if !i.MinSize.IsNull() && !i.MaxSize.IsNull() && i.MinSize.ValueFloat64() > i.MaxSize.ValueFloat64() {
+		diags.AddAttributeError(
+			path.Root("max_size"),
+			"Invalid size range",
+			"max_size must not be smaller than min_size.",
+		)
+	}
+}
+
+func parseImageDate(value types.String) (*time.Time, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
+
+func invalidImageDateError(attribute string, value types.String, diags *diag.Diagnostics) {
+	diags.AddAttributeError(
+		path.Root(attribute),
+		"Invalid date",
+		fmt.Sprintf(
+			"The date must be specified using the RFC3339 format (`yyyy-mm-ddThh:mm:ssZ`). Got: %q",
+			value.ValueString(),
+		),
+	)
+}
+
+// storageSizeInGB returns the storage size of the image in GB, false if the
+// size is unknown.
+func storageSizeInGB(imageDetails publiccloud.ImageDetails) (float64, bool) {
+	storageSize := imageDetails.StorageSize.Get()
+	if storageSize == nil {
+		return 0, false
+	}
+
+	factor, ok := storageSizeUnits[storageSize.GetUnit()]
+	if !ok {
+		return 0, false
+	}
+
+	return float64(storageSize.GetSize()) * factor, true
+}
+
+// applyFilters passes the filters the API supports on to the request.
+func (i imagesDataSourceModel) applyFilters(
+	request publiccloud.ApiGetImageListRequest,
+) publiccloud.ApiGetImageListRequest {
+	if !i.Region.IsNull() {
+		request = request.Region(i.Region.ValueString())
+	}
+
+	switch i.Visibility.ValueString() {
+	case imageVisibilityPublic:
+		request = request.Standard(true)
+	case imageVisibilityPrivate:
+		request = request.Custom(true)
+	}
+
+	return request
+}
+
+// matches checks the filters the API cannot apply itself.
+func (i imagesDataSourceModel) matches(imageDetails publiccloud.ImageDetails) bool {
+	if !i.OSFamily.IsNull() && imageDetails.GetFamily() != i.OSFamily.ValueString() {
+		return false
+	}
+
+	// Images without a creation date or size never match those filters.
+	createdAfter, _ := parseImageDate(i.CreatedAfter)
+	createdBefore, _ := parseImageDate(i.CreatedBefore)
+	if createdAfter != nil || createdBefore != nil {
+		createdAt := imageDetails.CreatedAt.Get()
+		if createdAt == nil {
+			return false
+		}
+		if createdAfter != nil && createdAt.Before(*createdAfter) {
+			return false
+		}
+		if createdBefore != nil && createdAt.After(*createdBefore) {
+			return false
+		}
+	}
+
+	if !i.MinSize.IsNull() || !i.MaxSize.IsNull() {
+		size, ok := storageSizeInGB(imageDetails)
+		if !ok {
+			return false
+		}
+		if !i.MinSize.IsNull() && size < i.MinSize.ValueFloat64() {
+			return false
+		}
+		if !i.MaxSize.IsNull() && size > i.MaxSize.ValueFloat64() {
+			return false
+		}
+	}
+
+	return true
 }
 
 type imageDetailsList []publiccloud.ImageDetails
@@ -71,12 +220,18 @@ func getAllImages(
 	ctx context.Context,
 	api publiccloud.PubliccloudAPI,
 	diags *diag.Diagnostics,
+) imageDetailsList {
+	return getImages(ctx, api.GetImageList(ctx), diags)
+}
+
+func getImages(
+	ctx context.Context,
+	request publiccloud.ApiGetImageListRequest,
+	diags *diag.Diagnostics,
 ) imageDetailsList {
 	var images imageDetailsList
 	var offset *int32
 
-	request := api.GetImageList(ctx)
-
 	for {
 		result, httpResponse, err := request.Execute()
 		if err != nil {
@@ -150,6 +305,46 @@ func (i *imagesDataSource) Schema(
 	response.Schema = schema.Schema{
 		Description: utils.BetaDescription,
 		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only images usable in this region. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedRegionNameEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedRegionNameEnumValues)...),
+				},
+			},
+			"os_family": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only images of this operating system family, such as `linux` or `windows`",
+			},
+			"visibility": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only standard (`public`) or custom (`private`) images",
+				Validators: []validator.String{
+					stringvalidator.OneOf(imageVisibilityPublic, imageVisibilityPrivate),
+				},
+			},
+			"created_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only images created at or after this date and time, in the RFC3339 format. Images without a creation date, such as most standard images, are left out",
+			},
+			"created_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only images created at or before this date and time, in the RFC3339 format. Images without a creation date, such as most standard images, are left out",
+			},
+			"min_size": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Return only images with a storage size of at least this many GB. Images without a known size are left out",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_size": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Return only images with a storage size of at most this many GB. Images without a known size are left out",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
 			"images": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -162,16 +357,34 @@ func (i *imagesDataSource) Schema(
 
 func (i *imagesDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	request datasource.ReadRequest,
 	response *datasource.ReadResponse,
 ) {
-	images := getAllImages(ctx, i.PubliccloudAPI, &response.Diagnostics)
+	var state imagesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &state)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
-	var state imagesDataSourceModel
+	state.validateFilters(&response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	images := getImages(
+		ctx,
+		state.applyFilters(i.PubliccloudAPI.GetImageList(ctx)),
+		&response.Diagnostics,
+	)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.Images = []imageModelDataSource{}
 	for _, imageDetails := range images {
+		if !state.matches(imageDetails) {
+			continue
+		}
 		state.Images = append(
 			state.Images,
 			adaptImageDetailsToImageDataSource(imageDetails),
@@ -2,7 +2,9 @@ package publiccloud
 
 import (
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
 	"github.com/stretchr/testify/assert"
@@ -55,3 +57,228 @@ func Test_adaptImageDetailsToImageDataSource(t *testing.T) {
 
 	assert.Equal(t, want, got)
 }
+
+func Test_imagesDataSourceModel_matches(t *testing.T) {
+	image := publiccloud.ImageDetails{Id: "id", Family: "linux"}
+
+	t.Run("images match when no filters are set", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			OSFamily: basetypes.NewStringNull(),
+		}
+
+		assert.True(t, filters.matches(image))
+	})
+
+	t.Run("images of the os_family match", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			OSFamily: basetypes.NewStringValue("linux"),
+		}
+
+		assert.True(t, filters.matches(image))
+	})
+
+	t.Run("images of another os_family do not match", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			OSFamily: basetypes.NewStringValue("windows"),
+		}
+
+		assert.False(t, filters.matches(image))
+	})
+
+	t.Run("os_family is applied together with the API filters", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			Region:     basetypes.NewStringValue("eu-west-3"),
+			OSFamily:   basetypes.NewStringValue("linux"),
+			Visibility: basetypes.NewStringValue(imageVisibilityPublic),
+		}
+
+		assert.True(t, filters.matches(image))
+	})
+}
+
+func Test_imagesDataSourceModel_matches_createdAt(t *testing.T) {
+	createdAt, _ := time.Parse(time.RFC3339, "2024-07-05T10:44:08Z")
+	image := publiccloud.ImageDetails{
+		Id:        "id",
+		CreatedAt: *publiccloud.NewNullableTime(&createdAt),
+	}
+
+	t.Run("images created within the range match", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			CreatedAfter:  basetypes.NewStringValue("2024-07-01T00:00:00Z"),
+			CreatedBefore: basetypes.NewStringValue("2024-08-01T00:00:00Z"),
+		}
+
+		assert.True(t, filters.matches(image))
+	})
+
+	t.Run("the boundaries are inclusive", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			CreatedAfter:  basetypes.NewStringValue("2024-07-05T10:44:08Z"),
+			CreatedBefore: basetypes.NewStringValue("2024-07-05T10:44:08Z"),
+		}
+
+		assert.True(t, filters.matches(image))
+	})
+
+	t.Run("time zones are taken into account", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			CreatedBefore: basetypes.NewStringValue("2024-07-05T12:00:00+02:00"),
+		}
+
+		assert.False(t, filters.matches(image))
+	})
+
+	t.Run("images created before created_after do not match", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			CreatedAfter: basetypes.NewStringValue("2024-07-06T00:00:00Z"),
+		}
+
+		assert.False(t, filters.matches(image))
+	})
+
+	t.Run("images created after created_before do not match", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			CreatedBefore: basetypes.NewStringValue("2024-07-01T00:00:00Z"),
+		}
+
+		assert.False(t, filters.matches(image))
+	})
+
+	t.Run("images without a creation date do not match", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			CreatedAfter: basetypes.NewStringValue("2024-07-01T00:00:00Z"),
+		}
+
+		assert.False(t, filters.matches(publiccloud.ImageDetails{Id: "id"}))
+	})
+}
+
+func Test_imagesDataSourceModel_matches_size(t *testing.T) {
+	image := publiccloud.ImageDetails{
+		Id: "id",
+		StorageSize: *publiccloud.NewNullableStorageSize(
+			&publiccloud.StorageSize{Size: 2.5, Unit: "GB"},
+		),
+	}
+
+	t.Run("images within the range match", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			MinSize: basetypes.NewFloat64Value(1),
+			MaxSize: basetypes.NewFloat64Value(3),
+		}
+
+		assert.True(t, filters.matches(image))
+	})
+
+	t.Run("the boundaries are inclusive", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			MinSize: basetypes.NewFloat64Value(2.5),
+			MaxSize: basetypes.NewFloat64Value(2.5),
+		}
+
+		assert.True(t, filters.matches(image))
+	})
+
+	t.Run("smaller images do not match", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			MinSize: basetypes.NewFloat64Value(3),
+		}
+
+		assert.False(t, filters.matches(image))
+	})
+
+	t.Run("larger images do not match", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			MaxSize: basetypes.NewFloat64Value(2),
+		}
+
+		assert.False(t, filters.matches(image))
+	})
+
+	t.Run("images without a size do not match", func(t *testing.T) {
+		filters := imagesDataSourceModel{
+			MaxSize: basetypes.NewFloat64Value(100),
+		}
+
+		assert.False(t, filters.matches(publiccloud.ImageDetails{Id: "id"}))
+	})
+}
+
+func Test_storageSizeInGB(t *testing.T) {
+	t.Run("other units are converted to GB", func(t *testing.T) {
+		image := publiccloud.ImageDetails{
+			StorageSize: *publiccloud.NewNullableStorageSize(
+				&publiccloud.StorageSize{Size: 2, Unit: "TB"},
+			),
+		}
+
+		got, ok := storageSizeInGB(image)
+
+		assert.True(t, ok)
+		assert.Equal(t, float64(2048), got)
+	})
+
+	t.Run("unknown units are not converted", func(t *testing.T) {
+		image := publiccloud.ImageDetails{
+			StorageSize: *publiccloud.NewNullableStorageSize(
+				&publiccloud.StorageSize{Size: 2, Unit: "tralala"},
+			),
+		}
+
+		_, ok := storageSizeInGB(image)
+
+		assert.False(t, ok)
+	})
+}
+
+func Test_imagesDataSourceModel_validateFilters(t *testing.T) {
+	t.Run("valid filters pass", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		filters := imagesDataSourceModel{
+			CreatedAfter:  basetypes.NewStringValue("2024-07-01T00:00:00Z"),
+			CreatedBefore: basetypes.NewStringValue("2024-07-01T00:00:00Z"),
+			MinSize:       basetypes.NewFloat64Value(1),
+			MaxSize:       basetypes.NewFloat64Value(1),
+		}
+
+		filters.validateFilters(&diags)
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("invalid dates return an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		filters := imagesDataSourceModel{
+			CreatedAfter: basetypes.NewStringValue("2024-07-01"),
+		}
+
+		filters.validateFilters(&diags)
+
+		assert.Equal(t, "Invalid date", diags.Errors()[0].Summary())
+	})
+
+	t.Run("reversed date ranges return an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		filters := imagesDataSourceModel{
+			CreatedAfter:  basetypes.NewStringValue("2024-08-01T00:00:00Z"),
+			CreatedBefore: basetypes.NewStringValue("2024-07-01T00:00:00Z"),
+		}
+
+		filters.validateFilters(&diags)
+
+		assert.Equal(t, "Invalid date range", diags.Errors()[0].Summary())
+	})
+
+	t.Run("reversed size ranges return an error", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		filters := imagesDataSourceModel{
+			MinSize: basetypes.NewFloat64Value(2),
+			MaxSize: basetypes.NewFloat64Value(1),
+		}
+
+		filters.validateFilters(&diags)
+
+		assert.Equal(t, "Invalid size range", diags.Errors()[0].Summary())
+	})
+}
@@ -0,0 +1,339 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dns"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/client"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+// defaultAutoDNSTTL is the TTL of auto_dns records if neither the record nor
+// the provider sets one.
+const defaultAutoDNSTTL = dns.TTL__3600
+
+type autoDNSResourceModel struct {
+	DomainName types.String `tfsdk:"domain_name"`
+	Name       types.String `tfsdk:"name"`
+	TTL        types.Int32  `tfsdk:"ttl"`
+	FQDN       types.String `tfsdk:"fqdn"`
+	IP         types.String `tfsdk:"ip"`
+}
+
+func (a autoDNSResourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"domain_name": types.StringType,
+		"name":        types.StringType,
+		"ttl":         types.Int32Type,
+		"fqdn":        types.StringType,
+		"ip":          types.StringType,
+	}
+}
+
+// fqdn fills in the `{id}` and `{reference}` placeholders of the name and
+// qualifies it with the domain.
+func (a autoDNSResourceModel) fqdn(id string, reference *string) string {
+	var ref string
+	if reference != nil {
+		ref = *reference
+	}
+
+	name := strings.NewReplacer("{id}", id, "{reference}", ref).
+		Replace(a.Name.ValueString())
+
+	return name + "." + strings.TrimSuffix(a.DomainName.ValueString(), ".") + "."
+}
+
+// ttl returns the TTL of the record, falling back to the provider's
+// default_dns_ttl and then to defaultAutoDNSTTL.
+func (a autoDNSResourceModel) ttl(defaultDNSTTL int32) dns.Ttl {
+	if !a.TTL.IsNull() && !a.TTL.IsUnknown() {
+		return dns.Ttl(a.TTL.ValueInt32())
+	}
+	if defaultDNSTTL != 0 {
+		return dns.Ttl(defaultDNSTTL)
+	}
+
+	return defaultAutoDNSTTL
+}
+
+// getPublicIPv4 returns the public IPv4 address of the instance, preferring
+// its main IP. An empty string is returned if it has none.
+func getPublicIPv4(instanceDetails publiccloud.InstanceDetails) string {
+	var publicIP string
+	for _, ip := range instanceDetails.GetIps() {
+		if ip.GetVersion() != publiccloud.IPVERSION__4 || ip.GetNetworkType() != publiccloud.NETWORKTYPE_PUBLIC {
+			continue
+		}
+		if ip.GetMainIp() {
+			return ip.GetIp()
+		}
+		if publicIP == "" {
+			publicIP = ip.GetIp()
+		}
+	}
+
+	return publicIP
+}
+
+// validateAutoDNSDomain ensures that the auto_dns domain is hosted in the
+// account. Domains that are already in the state are not checked again.
+func (i *instanceResource) validateAutoDNSDomain(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// The provider is not configured yet.
+	if i.DNSAPI == nil {
+		return
+	}
+
+	domainPath := path.Root("auto_dns").AtName("domain_name")
+
+	var domainName types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, domainPath, &domainName)...)
+	if resp.Diagnostics.HasError() || domainName.IsNull() || domainName.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateDomainName types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, domainPath, &stateDomainName)...)
+		if resp.Diagnostics.HasError() || domainName.Equal(stateDomainName) {
+			return
+		}
+	}
+
+	_, httpResponse, err := i.DNSAPI.
+		GetResourceRecordSetList(ctx, domainName.ValueString()).
+		Execute()
+	if err == nil {
+		return
+	}
+	if client.ClassifyResponse(httpResponse, err) == client.ErrorClassNotFound {
+		resp.Diagnostics.AddAttributeError(
+			domainPath,
+			"Domain not hosted",
+			fmt.Sprintf("Domain %s is not hosted in the account.", domainName.ValueString()),
+		)
+		return
+	}
+
+	utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+}
+
+// reconcileAutoDNS plans an update if the auto_dns record went missing or no
+// longer points to the instance.
+func (i *instanceResource) reconcileAutoDNS(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var planAutoDNS, stateAutoDNS types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("auto_dns"), &planAutoDNS)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("auto_dns"), &stateAutoDNS)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planAutoDNS.IsNull() || planAutoDNS.IsUnknown() || stateAutoDNS.IsNull() {
+		return
+	}
+
+	var ip types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("auto_dns").AtName("ip"), &ip)...)
+	if resp.Diagnostics.HasError() || !ip.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(
+		resp.Plan.SetAttribute(ctx, path.Root("auto_dns").AtName("ip"), types.StringUnknown())...,
+	)
+}
+
+// registerAutoDNS points the auto_dns record at the instance and returns the
+// new value of auto_dns. The record of previous is removed if auto_dns is
+// removed or renamed.
+func (i *instanceResource) registerAutoDNS(
+	ctx context.Context,
+	autoDNS types.Object,
+	previous types.Object,
+	instanceDetails publiccloud.InstanceDetails,
+	diags *diag.Diagnostics,
+) types.Object {
+	previousModel := autoDNSResourceModel{}
+	if !previous.IsNull() && !previous.IsUnknown() {
+		diags.Append(previous.As(ctx, &previousModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return previous
+		}
+	}
+
+	if autoDNS.IsNull() || autoDNS.IsUnknown() {
+		if httpResponse, err := i.deregisterAutoDNS(ctx, previousModel); err != nil {
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return previous
+		}
+		return autoDNS
+	}
+
+	model := autoDNSResourceModel{}
+	diags.Append(autoDNS.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return previous
+	}
+
+	ip := getPublicIPv4(instanceDetails)
+	if ip == "" {
+		diags.AddAttributeError(
+			path.Root("auto_dns"),
+			"No public IPv4 address",
+			"The instance has no public IPv4 address to point the DNS record at.",
+		)
+		return previous
+	}
+
+	fqdn := model.fqdn(instanceDetails.GetId(), instanceDetails.Reference.Get())
+	renamed := previousModel.FQDN.ValueString() != fqdn ||
+		!previousModel.DomainName.Equal(model.DomainName)
+
+	if renamed {
+		if httpResponse, err := i.deregisterAutoDNS(ctx, previousModel); err != nil {
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return previous
+		}
+		previous = basetypes.NewObjectNull(autoDNSResourceModel{}.attributeTypes())
+	}
+
+	httpResponse, err := i.upsertAutoDNSRecord(
+		ctx,
+		model.DomainName.ValueString(),
+		fqdn,
+		ip,
+		model.ttl(i.DefaultDNSTTL),
+		!renamed,
+	)
+	if err != nil {
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return previous
+	}
+
+	model.FQDN = basetypes.NewStringValue(fqdn)
+	model.IP = basetypes.NewStringValue(ip)
+
+	object, objectDiags := types.ObjectValueFrom(ctx, model.attributeTypes(), model)
+	diags.Append(objectDiags...)
+
+	return object
+}
+
+// upsertAutoDNSRecord updates the record if it exists, and creates it
+// otherwise.
+func (i *instanceResource) upsertAutoDNSRecord(
+	ctx context.Context,
+	domainName string,
+	fqdn string,
+	ip string,
+	ttl dns.Ttl,
+	exists bool,
+) (*http.Response, error) {
+	if exists {
+		_, httpResponse, err := i.DNSAPI.
+			UpdateResourceRecordSet(ctx, domainName, fqdn, string(dns.RESOURCERECORDSETTYPE_A)).
+			UpdateResourceRecordSetOpts(*dns.NewUpdateResourceRecordSetOpts([]string{ip}, ttl)).
+			Execute()
+		if client.ClassifyResponse(httpResponse, err) != client.ErrorClassNotFound {
+			return httpResponse, err
+		}
+	}
+
+	_, httpResponse, err := i.DNSAPI.CreateResourceRecordSet(ctx, domainName).
+		ResourceRecordSet(*dns.NewResourceRecordSet(
+			fqdn,
+			dns.RESOURCERECORDSETTYPE_A,
+			[]string{ip},
+			ttl,
+		)).
+		Execute()
+
+	return httpResponse, err
+}
+
+// readAutoDNS refreshes the ip of auto_dns. It is null if the record is
+// missing or does not point to the instance anymore.
+func (i *instanceResource) readAutoDNS(
+	ctx context.Context,
+	autoDNS types.Object,
+	instanceDetails publiccloud.InstanceDetails,
+	diags *diag.Diagnostics,
+) types.Object {
+	if autoDNS.IsNull() || autoDNS.IsUnknown() {
+		return autoDNS
+	}
+
+	model := autoDNSResourceModel{}
+	diags.Append(autoDNS.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return autoDNS
+	}
+
+	model.IP = basetypes.NewStringNull()
+	resourceRecordSetDetails, httpResponse, err := i.DNSAPI.GetResourceRecordSet(
+		ctx,
+		model.DomainName.ValueString(),
+		model.FQDN.ValueString(),
+		string(dns.RESOURCERECORDSETTYPE_A),
+	).Execute()
+	if err != nil && client.ClassifyResponse(httpResponse, err) != client.ErrorClassNotFound {
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return autoDNS
+	}
+	if err == nil {
+		ip := getPublicIPv4(instanceDetails)
+		content := resourceRecordSetDetails.GetContent()
+		if len(content) == 1 && content[0] == ip {
+			model.IP = basetypes.NewStringValue(ip)
+		}
+	}
+
+	object, objectDiags := types.ObjectValueFrom(ctx, model.attributeTypes(), model)
+	diags.Append(objectDiags...)
+
+	return object
+}
+
+// deregisterAutoDNS removes the record, records that are gone already are
+// ignored.
+func (i *instanceResource) deregisterAutoDNS(
+	ctx context.Context,
+	autoDNS autoDNSResourceModel,
+) (*http.Response, error) {
+	if autoDNS.FQDN.IsNull() || autoDNS.FQDN.IsUnknown() {
+		return nil, nil
+	}
+
+	httpResponse, err := i.DNSAPI.DeleteResourceRecordSet(
+		ctx,
+		autoDNS.DomainName.ValueString(),
+		autoDNS.FQDN.ValueString(),
+		string(dns.RESOURCERECORDSETTYPE_A),
+	).Execute()
+	if err != nil && client.ClassifyResponse(httpResponse, err) != client.ErrorClassNotFound {
+		return httpResponse, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,91 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/dns"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_autoDNSResourceModel_fqdn(t *testing.T) {
+	t.Run("placeholders are replaced", func(t *testing.T) {
+		reference := "web"
+		model := autoDNSResourceModel{
+			DomainName: basetypes.NewStringValue("example.com"),
+			Name:       basetypes.NewStringValue("{reference}-{id}"),
+		}
+
+		got := model.fqdn("id", &reference)
+
+		assert.Equal(t, "web-id.example.com.", got)
+	})
+
+	t.Run("a missing reference is left empty", func(t *testing.T) {
+		model := autoDNSResourceModel{
+			DomainName: basetypes.NewStringValue("example.com."),
+			Name:       basetypes.NewStringValue("web{reference}"),
+		}
+
+		got := model.fqdn("id", nil)
+
+		assert.Equal(t, "web.example.com.", got)
+	})
+}
+
+func Test_autoDNSResourceModel_ttl(t *testing.T) {
+	t.Run("the ttl of the record is used", func(t *testing.T) {
+		model := autoDNSResourceModel{TTL: basetypes.NewInt32Value(300)}
+
+		assert.Equal(t, dns.TTL__300, model.ttl(60))
+	})
+
+	t.Run("the default of the provider is used", func(t *testing.T) {
+		model := autoDNSResourceModel{TTL: basetypes.NewInt32Null()}
+
+		assert.Equal(t, dns.TTL__60, model.ttl(60))
+	})
+
+	t.Run("an hour is used otherwise", func(t *testing.T) {
+		model := autoDNSResourceModel{TTL: basetypes.NewInt32Null()}
+
+		assert.Equal(t, dns.TTL__3600, model.ttl(0))
+	})
+}
+
+func Test_getPublicIPv4(t *testing.T) {
+	t.Run("the main IP is preferred", func(t *testing.T) {
+		instanceDetails := publiccloud.InstanceDetails{
+			Ips: []publiccloud.IpDetails{
+				{Ip: "10.0.0.1", Version: publiccloud.IPVERSION__4, NetworkType: publiccloud.NETWORKTYPE_INTERNAL, MainIp: true},
+				{Ip: "2001:db8::1", Version: publiccloud.IPVERSION__6, NetworkType: publiccloud.NETWORKTYPE_PUBLIC},
+				{Ip: "203.0.113.1", Version: publiccloud.IPVERSION__4, NetworkType: publiccloud.NETWORKTYPE_PUBLIC},
+				{Ip: "203.0.113.2", Version: publiccloud.IPVERSION__4, NetworkType: publiccloud.NETWORKTYPE_PUBLIC, MainIp: true},
+			},
+		}
+
+		assert.Equal(t, "203.0.113.2", getPublicIPv4(instanceDetails))
+	})
+
+	t.Run("the first public IPv4 address is used without a main IP", func(t *testing.T) {
+		instanceDetails := publiccloud.InstanceDetails{
+			Ips: []publiccloud.IpDetails{
+				{Ip: "203.0.113.1", Version: publiccloud.IPVERSION__4, NetworkType: publiccloud.NETWORKTYPE_PUBLIC},
+				{Ip: "203.0.113.2", Version: publiccloud.IPVERSION__4, NetworkType: publiccloud.NETWORKTYPE_PUBLIC},
+			},
+		}
+
+		assert.Equal(t, "203.0.113.1", getPublicIPv4(instanceDetails))
+	})
+
+	t.Run("empty without a public IPv4 address", func(t *testing.T) {
+		instanceDetails := publiccloud.InstanceDetails{
+			Ips: []publiccloud.IpDetails{
+				{Ip: "10.0.0.1", Version: publiccloud.IPVERSION__4, NetworkType: publiccloud.NETWORKTYPE_INTERNAL},
+			},
+		}
+
+		assert.Empty(t, getPublicIPv4(instanceDetails))
+	})
+}
@@ -1,32 +1,61 @@
 package publiccloud
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/leaseweb/leaseweb-go-sdk/dns"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
 	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
 )
 
+// defaultShutdownTimeout is how long Delete waits for a graceful shutdown
+// when no shutdown_timeout is set.
+const defaultShutdownTimeout = 5 * time.Minute
+
+// drainTimeout is how long Delete waits for the instance to leave its target
+// groups when drain_on_destroy is set.
+const drainTimeout = 5 * time.Minute
+
+// finalImageTimeout is how long Delete waits for the custom image named by
+// final_image_name to be ready.
+const finalImageTimeout = 30 * time.Minute
+
+// defaultWaitTimeout is how long Create and Update wait for the instance to
+// reach the expected state when no timeout is set.
+const defaultWaitTimeout = 5 * time.Minute
+
 var (
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 )
 
 type isoResourceModel struct {
@@ -63,19 +92,45 @@ func adaptContractToContractResource(contract publiccloud.InstanceContract) cont
 }
 
 type instanceResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Region              types.String `tfsdk:"region"`
-	Reference           types.String `tfsdk:"reference"`
-	Image               types.Object `tfsdk:"image"`
-	ISO                 types.Object `tfsdk:"iso"`
-	State               types.String `tfsdk:"state"`
-	Type                types.String `tfsdk:"type"`
-	RootDiskSize        types.Int32  `tfsdk:"root_disk_size"`
-	RootDiskStorageType types.String `tfsdk:"root_disk_storage_type"`
-	IPs                 types.List   `tfsdk:"ips"`
-	Contract            types.Object `tfsdk:"contract"`
-	MarketAppID         types.String `tfsdk:"market_app_id"`
-	HasPrivateNetwork   types.Bool   `tfsdk:"has_private_network"`
+	ID                  types.String   `tfsdk:"id"`
+	Region              types.String   `tfsdk:"region"`
+	Reference           types.String   `tfsdk:"reference"`
+	Image               types.Object   `tfsdk:"image"`
+	ISO                 types.Object   `tfsdk:"iso"`
+	State               types.String   `tfsdk:"state"`
+	Type                types.String   `tfsdk:"type"`
+	RootDiskSize        types.Int32    `tfsdk:"root_disk_size"`
+	RootDiskStorageType types.String   `tfsdk:"root_disk_storage_type"`
+	IPs                 types.List     `tfsdk:"ips"`
+	Contract            types.Object   `tfsdk:"contract"`
+	MarketAppID         types.String   `tfsdk:"market_app_id"`
+	HasPrivateNetwork   types.Bool     `tfsdk:"has_private_network"`
+	IPv6Address         types.String   `tfsdk:"ipv6_address"`
+	DNSServers          types.List     `tfsdk:"dns_servers"`
+	UserData            types.String   `tfsdk:"user_data"`
+	UserDataBase64      types.String   `tfsdk:"user_data_base64"`
+	SSHKey              types.String   `tfsdk:"ssh_key"`
+	GracefulShutdown    types.Bool     `tfsdk:"graceful_shutdown"`
+	ShutdownTimeout     types.String   `tfsdk:"shutdown_timeout"`
+	DrainOnDestroy      types.Bool     `tfsdk:"drain_on_destroy"`
+	FinalImageName      types.String   `tfsdk:"final_image_name"`
+	RestoreSnapshotID   types.String   `tfsdk:"restore_snapshot_id"`
+	AutoDNS             types.Object   `tfsdk:"auto_dns"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// shutdownTimeout returns how long Delete waits for a graceful shutdown.
+func (i instanceResourceModel) shutdownTimeout() time.Duration {
+	if i.ShutdownTimeout.IsNull() || i.ShutdownTimeout.IsUnknown() {
+		return defaultShutdownTimeout
+	}
+
+	timeout, err := time.ParseDuration(i.ShutdownTimeout.ValueString())
+	if err != nil {
+		return defaultShutdownTimeout
+	}
+
+	return timeout
 }
 
 func adaptInstanceDetailsToInstanceResource(
@@ -93,6 +148,25 @@ func adaptInstanceDetailsToInstanceResource(
 		RootDiskStorageType: basetypes.NewStringValue(string(instanceDetails.GetRootDiskStorageType())),
 		MarketAppID:         basetypes.NewStringPointerValue(instanceDetails.MarketAppId.Get()),
 		HasPrivateNetwork:   basetypes.NewBoolValue(instanceDetails.GetHasPrivateNetwork()),
+		IPv6Address:         basetypes.NewStringNull(),
+		DNSServers:          basetypes.NewListNull(types.StringType),
+		UserData:            basetypes.NewStringNull(),
+		UserDataBase64:      basetypes.NewStringNull(),
+		SSHKey:              basetypes.NewStringNull(),
+		GracefulShutdown:    basetypes.NewBoolNull(),
+		ShutdownTimeout:     basetypes.NewStringNull(),
+		DrainOnDestroy:      basetypes.NewBoolNull(),
+		FinalImageName:      basetypes.NewStringNull(),
+		RestoreSnapshotID:   basetypes.NewStringNull(),
+		AutoDNS:             basetypes.NewObjectNull(autoDNSResourceModel{}.attributeTypes()),
+		Timeouts:            utils.NewTimeoutsNull(),
+	}
+
+	for _, ip := range instanceDetails.GetIps() {
+		if ip.GetVersion() == publiccloud.IPVERSION__6 && ip.GetNetworkType() == publiccloud.NETWORKTYPE_PUBLIC {
+			instance.IPv6Address = basetypes.NewStringValue(ip.GetIp())
+			break
+		}
 	}
 
 	image := utils.AdaptSdkModelToResourceObject(
@@ -180,6 +254,56 @@ func adaptInstanceDetailsToInstanceResource(
 	return &instance
 }
 
+// adaptDNSServersToUserData builds the cloud-init user data that configures
+// the instance's DNS resolvers on first boot.
+func adaptDNSServersToUserData(dnsServers []string) string {
+	var userData strings.Builder
+
+	userData.WriteString("#cloud-config\n")
+	userData.WriteString("manage_resolv_conf: true\n")
+	userData.WriteString("resolv_conf:\n")
+	userData.WriteString("  nameservers:\n")
+	for _, dnsServer := range dnsServers {
+		userData.WriteString(fmt.Sprintf("    - %q\n", dnsServer))
+	}
+
+	return userData.String()
+}
+
+// maxUserDataSize is the largest user data, in bytes, cloud-init accepts.
+const maxUserDataSize = 16 * 1024
+
+// decodeUserDataBase64 returns the plain text of base64 encoded user data,
+// as the API only accepts plain text. Gzip compressed user data, such as
+// that rendered by the cloudinit_config data source, is decompressed.
+func decodeUserDataBase64(value string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("the value must be base64 encoded: %w", err)
+	}
+
+	if bytes.HasPrefix(decoded, []byte{0x1f, 0x8b}) {
+		reader, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return "", fmt.Errorf("the gzip compressed value cannot be read: %w", err)
+		}
+		defer reader.Close()
+
+		// Reading one byte more than allowed is enough to detect user data
+		// that is too large.
+		decoded, err = io.ReadAll(io.LimitReader(reader, maxUserDataSize+1))
+		if err != nil {
+			return "", fmt.Errorf("the gzip compressed value cannot be read: %w", err)
+		}
+	}
+
+	if len(decoded) > maxUserDataSize {
+		return "", fmt.Errorf("the decoded value must be at most %d bytes", maxUserDataSize)
+	}
+
+	return string(decoded), nil
+}
+
 func NewInstanceResource() resource.Resource {
 	return &instanceResource{
 		ResourceAPI: utils.ResourceAPI{
@@ -231,6 +355,45 @@ func (i *instanceResource) Create(
 	opts.Reference = utils.AdaptStringPointerValueToNullableString(plan.Reference)
 	opts.RootDiskSize = utils.AdaptInt32PointerValueToNullableInt32(plan.RootDiskSize)
 
+	// The API has no setting for the DNS resolvers, so they are configured
+	// by cloud-init.
+	if !plan.DNSServers.IsNull() && !plan.DNSServers.IsUnknown() {
+		var dnsServers []string
+		resp.Diagnostics.Append(plan.DNSServers.ElementsAs(ctx, &dnsServers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		userData := adaptDNSServersToUserData(dnsServers)
+		opts.UserData = &userData
+	}
+	if !plan.UserData.IsNull() {
+		opts.UserData = plan.UserData.ValueStringPointer()
+	}
+	if !plan.UserDataBase64.IsNull() {
+		userData, err := decodeUserDataBase64(plan.UserDataBase64.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("user_data_base64"),
+				"Invalid user data",
+				err.Error(),
+			)
+			return
+		}
+		opts.UserData = &userData
+	}
+	if !plan.SSHKey.IsNull() {
+		sshKey := strings.TrimSpace(plan.SSHKey.ValueString())
+		opts.SshKey = &sshKey
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	instance, httpResponse, err := i.PubliccloudAPI.LaunchInstance(ctx).
 		LaunchInstanceOpts(*opts).
 		Execute()
@@ -245,7 +408,7 @@ func (i *instanceResource) Create(
 	if !plan.HasPrivateNetwork.IsUnknown() && plan.HasPrivateNetwork.ValueBool() {
 
 		// If the instance is created with a private network, we need to wait for it to be running
-		instanceDetails, res, err = i.waitUntilPropertyValueEquals(ctx, instance.GetId(), "state", string(publiccloud.STATE_RUNNING))
+		instanceDetails, res, err = i.waitUntilPropertyValueEquals(ctx, instance.GetId(), "state", string(publiccloud.STATE_RUNNING), timeout)
 		if err != nil {
 			utils.SdkError(ctx, &resp.Diagnostics, err, res)
 			return
@@ -259,7 +422,7 @@ func (i *instanceResource) Create(
 		}
 
 		// Wait until the private network is added
-		instanceDetails, res, err = i.waitUntilPropertyValueEquals(ctx, instanceDetails.Id, "has_private_network", true)
+		instanceDetails, res, err = i.waitUntilPropertyValueEquals(ctx, instanceDetails.Id, "has_private_network", true, timeout)
 		if err != nil {
 			utils.SdkError(ctx, &resp.Diagnostics, err, res)
 			return
@@ -285,6 +448,25 @@ func (i *instanceResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.DNSServers = plan.DNSServers
+	state.UserData = plan.UserData
+	state.UserDataBase64 = plan.UserDataBase64
+	state.SSHKey = plan.SSHKey
+	state.GracefulShutdown = plan.GracefulShutdown
+	state.ShutdownTimeout = plan.ShutdownTimeout
+	state.DrainOnDestroy = plan.DrainOnDestroy
+	state.FinalImageName = plan.FinalImageName
+	state.RestoreSnapshotID = plan.RestoreSnapshotID
+	state.Timeouts = plan.Timeouts
+
+	// The instance is kept in the state if the record cannot be registered.
+	state.AutoDNS = i.registerAutoDNS(
+		ctx,
+		plan.AutoDNS,
+		state.AutoDNS,
+		*instanceDetails,
+		&resp.Diagnostics,
+	)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 
@@ -301,6 +483,109 @@ func (i *instanceResource) Delete(
 		return
 	}
 
+	// The delete timeout bounds the waits before the instance is terminated.
+	timeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deadline := time.Now().Add(timeout)
+	remaining := func(limit time.Duration) time.Duration {
+		if timeout == 0 {
+			return limit
+		}
+		return min(limit, time.Until(deadline))
+	}
+	// A step is not started once the delete timeout has passed, as it could
+	// only fail after its request has been sent.
+	errTimeoutReached := fmt.Errorf("the delete timeout of %s has been reached", timeout)
+
+	if state.DrainOnDestroy.ValueBool() {
+		err := errTimeoutReached
+		if remaining(drainTimeout) > 0 {
+			err = i.drain(ctx, state.ID.ValueString(), state.Region.ValueString(), remaining(drainTimeout))
+		}
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Draining failed",
+				fmt.Sprintf("The instance is terminated without being drained from its target groups: %s", err),
+			)
+		}
+	}
+
+	stopped := state.State.ValueString() == string(publiccloud.STATE_STOPPED)
+	if state.GracefulShutdown.ValueBool() && state.State.ValueString() == string(publiccloud.STATE_RUNNING) {
+		err := errTimeoutReached
+		if remaining(state.shutdownTimeout()) > 0 {
+			err = i.shutdown(ctx, state.ID.ValueString(), remaining(state.shutdownTimeout()))
+		}
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Graceful shutdown failed",
+				fmt.Sprintf("The instance is terminated without a graceful shutdown: %s", err),
+			)
+		}
+		stopped = err == nil
+	}
+
+	// Unlike draining and shutting down, the image protects data, so the
+	// instance is kept if it cannot be created.
+	if !state.FinalImageName.IsNull() {
+		// Images can only be created from stopped instances.
+		if !stopped {
+			err := errTimeoutReached
+			if remaining(state.shutdownTimeout()) > 0 {
+				err = i.shutdown(ctx, state.ID.ValueString(), remaining(state.shutdownTimeout()))
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Final image failed",
+					fmt.Sprintf("The instance is not terminated as it could not be stopped to create its final image: %s", err),
+				)
+				return
+			}
+		}
+
+		if remaining(finalImageTimeout) <= 0 {
+			resp.Diagnostics.AddError(
+				"Final image failed",
+				fmt.Sprintf("The instance is not terminated as its final image could not be created: %s", errTimeoutReached),
+			)
+			return
+		}
+		imageID, err := i.createFinalImage(
+			ctx,
+			state.ID.ValueString(),
+			state.FinalImageName.ValueString(),
+			remaining(finalImageTimeout),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Final image failed",
+				fmt.Sprintf("The instance is not terminated as its final image could not be created: %s", err),
+			)
+			return
+		}
+		tflog.Info(ctx, "Created final image", map[string]any{
+			"instance_id": state.ID.ValueString(),
+			"image_id":    imageID,
+		})
+	}
+
+	autoDNS := autoDNSResourceModel{}
+	if !state.AutoDNS.IsNull() {
+		resp.Diagnostics.Append(state.AutoDNS.As(ctx, &autoDNS, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	// The record is removed first, so it never points to an IP that is
+	// handed out again.
+	if httpResponse, err := i.deregisterAutoDNS(ctx, autoDNS); err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+		return
+	}
+
 	opts := publiccloud.NewTerminateInstanceOpts()
 
 	opts.SetReasonCode("CANCEL_OTHER")
@@ -315,11 +600,234 @@ func (i *instanceResource) Delete(
 	}
 }
 
+// shutdown stops the instance and waits until it is stopped or the timeout
+// is reached.
+func (i *instanceResource) shutdown(
+	ctx context.Context,
+	instanceId string,
+	timeout time.Duration,
+) error {
+	_, err := i.PubliccloudAPI.StopInstance(ctx, instanceId).Execute()
+	if err != nil {
+		return err
+	}
+
+	// Create a constant backoff with a 10-second retry interval
+	bo := backoff.NewConstantBackOff(10 * time.Second)
+	deadline := time.Now().Add(timeout)
+
+	for {
+		instanceDetails, _, err := i.PubliccloudAPI.
+			GetInstance(ctx, instanceId).
+			Execute()
+		if err != nil {
+			return err
+		}
+
+		if instanceDetails.GetState() == publiccloud.STATE_STOPPED {
+			return nil
+		}
+
+		wait := bo.NextBackOff()
+		if time.Now().Add(wait).After(deadline) {
+			return fmt.Errorf("timed out waiting for the instance to stop after %s", timeout)
+		}
+
+		// Sleep for the backoff interval before retrying
+		time.Sleep(wait)
+	}
+}
+
+// createFinalImage creates a custom image from the instance and waits until
+// it is ready or the timeout is reached. It returns the id of the image.
+func (i *instanceResource) createFinalImage(
+	ctx context.Context,
+	instanceId string,
+	name string,
+	timeout time.Duration,
+) (string, error) {
+	image, _, err := i.PubliccloudAPI.CreateImage(ctx).
+		CreateImageOpts(*publiccloud.NewCreateImageOpts(name, instanceId)).
+		Execute()
+	if err != nil {
+		return "", err
+	}
+
+	// Create a constant backoff with a 10-second retry interval
+	bo := backoff.NewConstantBackOff(10 * time.Second)
+	deadline := time.Now().Add(timeout)
+
+	for {
+		switch image.GetState() {
+		case publiccloud.IMAGESTATENAME_READY:
+			return image.GetId(), nil
+		case publiccloud.IMAGESTATENAME_FAILED:
+			return "", fmt.Errorf("image %s has failed: %s", image.GetId(), image.GetStateReason())
+		}
+
+		wait := bo.NextBackOff()
+		if time.Now().Add(wait).After(deadline) {
+			return "", fmt.Errorf("timed out waiting for image %s to be ready after %s", image.GetId(), timeout)
+		}
+
+		// Sleep for the backoff interval before retrying
+		time.Sleep(wait)
+
+		result, _, err := i.PubliccloudAPI.GetImageList(ctx).
+			Custom(true).
+			Name(name).
+			Execute()
+		if err != nil {
+			return "", err
+		}
+
+		imageId := image.GetId()
+		image = imageDetailsList(result.GetImages()).findById(imageId)
+		if image == nil {
+			return "", fmt.Errorf("image %s not found", imageId)
+		}
+	}
+}
+
+// restoreSnapshot restores the snapshot of the instance and waits until the
+// snapshot is ready again. It returns the instance as it is afterwards.
+func (i *instanceResource) restoreSnapshot(
+	ctx context.Context,
+	instanceId string,
+	snapshotId string,
+	timeout time.Duration,
+) (*publiccloud.InstanceDetails, *http.Response, error) {
+	httpResponse, err := i.PubliccloudAPI.
+		RestoreSnapshot(ctx, instanceId, snapshotId).
+		Execute()
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	_, err = waitForSnapshot(
+		ctx,
+		func(ctx context.Context) (*publiccloud.Snapshot, *http.Response, error) {
+			return i.PubliccloudAPI.GetSnapshot(ctx, instanceId, snapshotId).Execute()
+		},
+		10*time.Second,
+		timeout,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return i.PubliccloudAPI.GetInstance(ctx, instanceId).Execute()
+}
+
+// drain deregisters the instance from all target groups it belongs to and
+// waits until none of them list it anymore.
+func (i *instanceResource) drain(
+	ctx context.Context,
+	instanceId string,
+	region string,
+	timeout time.Duration,
+) error {
+	targetGroupIds, err := i.getTargetGroupIdsOfInstance(ctx, instanceId, region)
+	if err != nil {
+		return err
+	}
+
+	for _, targetGroupId := range targetGroupIds {
+		_, err := i.PubliccloudAPI.
+			DeregisterTargets(ctx, targetGroupId).
+			RequestBody([]string{instanceId}).
+			Execute()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Create a constant backoff with a 10-second retry interval
+	bo := backoff.NewConstantBackOff(10 * time.Second)
+	deadline := time.Now().Add(timeout)
+
+	for len(targetGroupIds) > 0 {
+		wait := bo.NextBackOff()
+		if time.Now().Add(wait).After(deadline) {
+			return fmt.Errorf("timed out waiting for the instance to be drained after %s", timeout)
+		}
+
+		// Sleep for the backoff interval before retrying
+		time.Sleep(wait)
+
+		targetGroupIds, err = i.getTargetGroupIdsOfInstance(ctx, instanceId, region)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getTargetGroupIdsOfInstance returns the IDs of the target groups in the
+// region that have the instance as a target.
+func (i *instanceResource) getTargetGroupIdsOfInstance(
+	ctx context.Context,
+	instanceId string,
+	region string,
+) ([]string, error) {
+	var targetGroupIds []string
+	var offset *int32
+
+	request := i.PubliccloudAPI.GetTargetGroupList(ctx).
+		Region(publiccloud.RegionName(region))
+	for {
+		result, _, err := request.Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, targetGroup := range result.GetTargetGroups() {
+			targets, _, err := listTargets(ctx, i.PubliccloudAPI, targetGroup.GetId())
+			if err != nil {
+				return nil, err
+			}
+			if containsTarget(targets, instanceId) {
+				targetGroupIds = append(targetGroupIds, targetGroup.GetId())
+			}
+		}
+
+		metadata := result.GetMetadata()
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+		if offset == nil {
+			return targetGroupIds, nil
+		}
+
+		request = request.Offset(*offset)
+	}
+}
+
+func containsTarget(targets []publiccloud.Target, id string) bool {
+	for _, target := range targets {
+		if target.GetId() == id {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (i *instanceResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
+	// Fail early with a clear message, Read fills in the state afterwards.
+	_, httpResponse, err := i.PubliccloudAPI.GetInstance(ctx, req.ID).Execute()
+	if err != nil {
+		utils.ImportError(ctx, &resp.Diagnostics, req.ID, err, httpResponse)
+		return
+	}
+
 	resource.ImportStatePassthroughID(
 		ctx,
 		path.Root("id"),
@@ -328,6 +836,217 @@ func (i *instanceResource) ImportState(
 	)
 }
 
+// ModifyPlan validates the instance type and the auto_dns domain, and plans
+// an update if the auto_dns record drifted.
+func (i *instanceResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	i.validateInstanceType(ctx, req, resp)
+	i.validateRestoreSnapshot(ctx, req, resp)
+	i.validateContract(ctx, req, resp)
+	i.validateAutoDNSDomain(ctx, req, resp)
+	i.reconcileAutoDNS(ctx, req, resp)
+}
+
+// contractError reports why the planned contract is not allowed, either on
+// its own or as a change of the prior contract, which is nil for new
+// instances. The returned path is that of the offending attribute.
+func contractError(
+	prior *contractResourceModel,
+	planned contractResourceModel,
+) (path.Path, error) {
+	if planned.Type.IsUnknown() || planned.Term.IsUnknown() {
+		return path.Empty(), nil
+	}
+
+	contractType := publiccloud.ContractType(planned.Type.ValueString())
+	term := planned.Term.ValueInt32()
+	switch {
+	case contractType == publiccloud.CONTRACTTYPE_HOURLY && term != 0:
+		return path.Root("contract").AtName("term"), fmt.Errorf(
+			"the term of an HOURLY contract must be 0, got %d",
+			term,
+		)
+	case contractType == publiccloud.CONTRACTTYPE_MONTHLY && term == 0:
+		return path.Root("contract").AtName("term"), errors.New(
+			"a MONTHLY contract needs a term of at least 1 month",
+		)
+	}
+
+	if prior == nil || publiccloud.ContractType(prior.Type.ValueString()) != publiccloud.CONTRACTTYPE_MONTHLY {
+		return path.Empty(), nil
+	}
+
+	switch {
+	case contractType == publiccloud.CONTRACTTYPE_HOURLY:
+		return path.Root("contract").AtName("type"), errors.New(
+			"a MONTHLY contract cannot be changed to HOURLY, terminate the instance and launch a new one instead",
+		)
+	case term < prior.Term.ValueInt32():
+		return path.Root("contract").AtName("term"), fmt.Errorf(
+			"the term of a MONTHLY contract can only be extended, not shortened from %d to %d months",
+			prior.Term.ValueInt32(),
+			term,
+		)
+	}
+
+	return path.Empty(), nil
+}
+
+// validateContract ensures that the contract is a valid combination of type
+// and term, and that an existing contract is only changed in the ways the
+// API allows, so invalid changes fail while planning instead of halfway
+// through an apply.
+func (i *instanceResource) validateContract(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plannedContract types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("contract"), &plannedContract)...)
+	if resp.Diagnostics.HasError() || plannedContract.IsNull() || plannedContract.IsUnknown() {
+		return
+	}
+
+	planned := contractResourceModel{}
+	resp.Diagnostics.Append(plannedContract.As(ctx, &planned, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior *contractResourceModel
+	if !req.State.Raw.IsNull() {
+		var priorContract types.Object
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("contract"), &priorContract)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !priorContract.IsNull() {
+			prior = &contractResourceModel{}
+			resp.Diagnostics.Append(priorContract.As(ctx, prior, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	if attributePath, err := contractError(prior, planned); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			attributePath,
+			"Invalid Contract",
+			fmt.Sprintf("The contract is not allowed: %s.", err),
+		)
+	}
+}
+
+// validateRestoreSnapshot ensures that restore_snapshot_id is not set on new
+// instances, as snapshots belong to the instance they were taken of.
+func (i *instanceResource) validateRestoreSnapshot(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if !req.State.Raw.IsNull() {
+		return
+	}
+
+	var restoreSnapshotID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("restore_snapshot_id"), &restoreSnapshotID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !restoreSnapshotID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("restore_snapshot_id"),
+			"Invalid Attribute Configuration",
+			"restore_snapshot_id can only be set on existing instances, as snapshots belong to the instance they were taken of.",
+		)
+	}
+}
+
+// validateInstanceType ensures that the type is offered in the region, unless
+// the provider's validate_instance_type is disabled. Instances whose type and
+// region are unchanged are not checked again.
+func (i *instanceResource) validateInstanceType(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// The provider is not configured yet or validation is disabled.
+	if i.InstanceTypes == nil {
+		return
+	}
+
+	var instanceType, region types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &instanceType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if instanceType.IsUnknown() || instanceType.IsNull() || region.IsUnknown() || region.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateType, stateRegion types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("region"), &stateRegion)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if instanceType.Equal(stateType) && region.Equal(stateRegion) {
+			return
+		}
+	}
+
+	var httpResponse *http.Response
+	instanceTypes, err := i.InstanceTypes.Get(
+		region.ValueString(),
+		func() ([]string, error) {
+			sdkInstanceTypes, response, err := listInstanceTypes(
+				ctx,
+				i.PubliccloudAPI,
+				publiccloud.RegionName(region.ValueString()),
+			)
+			httpResponse = response
+			if err != nil {
+				return nil, err
+			}
+
+			var names []string
+			for _, sdkInstanceType := range sdkInstanceTypes {
+				names = append(names, string(sdkInstanceType.GetName()))
+			}
+			return names, nil
+		},
+	)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+		return
+	}
+
+	if !slices.Contains(instanceTypes, instanceType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid instance type",
+			fmt.Sprintf(
+				"Instance type %s is not offered in region %s. Offered instance types are: %s",
+				instanceType.ValueString(),
+				region.ValueString(),
+				strings.Join(instanceTypes, ", "),
+			),
+		)
+	}
+}
+
 func (i *instanceResource) Read(
 	ctx context.Context,
 	req resource.ReadRequest,
@@ -355,6 +1074,20 @@ func (i *instanceResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newState.DNSServers = state.DNSServers
+	newState.UserData = state.UserData
+	newState.UserDataBase64 = state.UserDataBase64
+	newState.SSHKey = state.SSHKey
+	newState.GracefulShutdown = state.GracefulShutdown
+	newState.ShutdownTimeout = state.ShutdownTimeout
+	newState.DrainOnDestroy = state.DrainOnDestroy
+	newState.FinalImageName = state.FinalImageName
+	newState.RestoreSnapshotID = state.RestoreSnapshotID
+	newState.Timeouts = state.Timeouts
+	newState.AutoDNS = i.readAutoDNS(ctx, state.AutoDNS, *instanceDetails, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
@@ -363,6 +1096,7 @@ func (i *instanceResource) TogglePrivateNetwork(
 	plan instanceResourceModel,
 	instanceDetails *publiccloud.InstanceDetails,
 	ctx context.Context,
+	timeout time.Duration,
 ) (*http.Response, error) {
 
 	if plan.HasPrivateNetwork.ValueBool() && !instanceDetails.HasPrivateNetwork {
@@ -373,7 +1107,7 @@ func (i *instanceResource) TogglePrivateNetwork(
 			return res, err
 		}
 
-		updated, res, err := i.waitUntilPropertyValueEquals(ctx, instanceDetails.Id, "has_private_network", true)
+		updated, res, err := i.waitUntilPropertyValueEquals(ctx, instanceDetails.Id, "has_private_network", true, timeout)
 
 		if err != nil {
 			return res, err
@@ -391,7 +1125,7 @@ func (i *instanceResource) TogglePrivateNetwork(
 			return res, err
 		}
 
-		updated, res, err := i.waitUntilPropertyValueEquals(ctx, instanceDetails.Id, "has_private_network", false)
+		updated, res, err := i.waitUntilPropertyValueEquals(ctx, instanceDetails.Id, "has_private_network", false, timeout)
 
 		if err != nil {
 			return res, err
@@ -410,21 +1144,15 @@ func (i *instanceResource) waitUntilPropertyValueEquals(
 	instanceId string,
 	propertyName string,
 	expectedValue any,
+	timeout time.Duration,
 ) (*publiccloud.InstanceDetails, *http.Response, error) {
 
-	// Create a constant backoff with a 5-second retry interval
+	// Create a constant backoff with a 10-second retry interval
 	bo := backoff.NewConstantBackOff(10 * time.Second)
-
-	// Set the retry limit to 30 retries (5 minutes)
-	retryCount := 0
-	maxRetries := 30
+	deadline := time.Now().Add(timeout)
 
 	// Start polling and retrying
 	for {
-		if retryCount >= maxRetries {
-			return nil, nil, errors.New("timed out waiting for property to change after 5 minutes")
-		}
-
 		instanceDetails, httpResponse, err := i.PubliccloudAPI.
 			GetInstance(ctx, instanceId).
 			Execute()
@@ -447,13 +1175,43 @@ func (i *instanceResource) waitUntilPropertyValueEquals(
 			return instanceDetails, httpResponse, nil
 		}
 
+		wait := bo.NextBackOff()
+		if time.Now().Add(wait).After(deadline) {
+			return nil, nil, newWaitTimeoutError(
+				timeout,
+				propertyName,
+				expectedValue,
+				currentValue,
+				instanceDetails.GetState(),
+			)
+		}
+
 		// Sleep for the backoff interval before retrying
-		time.Sleep(bo.NextBackOff())
-		retryCount++
+		time.Sleep(wait)
 	}
 
 }
 
+// newWaitTimeoutError reports what the instance last looked like, so users
+// can tell a slow instance from a stuck one.
+func newWaitTimeoutError(
+	timeout time.Duration,
+	propertyName string,
+	expectedValue any,
+	currentValue any,
+	state publiccloud.State,
+) error {
+	return fmt.Errorf(
+		"timed out after %s waiting for %s to become %v, last observed %s was %v with the instance in state %s",
+		timeout,
+		propertyName,
+		expectedValue,
+		propertyName,
+		currentValue,
+		state,
+	)
+}
+
 func (i *instanceResource) Update(
 	ctx context.Context,
 	req resource.UpdateRequest,
@@ -465,11 +1223,19 @@ func (i *instanceResource) Update(
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Update(ctx, defaultWaitTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	opts := publiccloud.NewUpdateInstanceOpts()
 	opts.Reference = utils.AdaptStringPointerValueToNullableString(plan.Reference)
 	opts.RootDiskSize = utils.AdaptInt32PointerValueToNullableInt32(plan.RootDiskSize)
 	contract := contractResourceModel{}
-	diags := plan.Contract.As(
+	diags = plan.Contract.As(
 		ctx,
 		&contract,
 		basetypes.ObjectAsOptions{},
@@ -501,7 +1267,7 @@ func (i *instanceResource) Update(
 	}
 
 	if !plan.HasPrivateNetwork.IsUnknown() {
-		res, err := i.TogglePrivateNetwork(plan, instanceDetails, ctx)
+		res, err := i.TogglePrivateNetwork(plan, instanceDetails, ctx, timeout)
 		if err != nil {
 			utils.SdkError(ctx, &resp.Diagnostics, err, res)
 			return
@@ -509,6 +1275,25 @@ func (i *instanceResource) Update(
 
 	}
 
+	var previousRestoreSnapshotID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("restore_snapshot_id"), &previousRestoreSnapshotID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.RestoreSnapshotID.IsNull() && !plan.RestoreSnapshotID.Equal(previousRestoreSnapshotID) {
+		restored, httpResponse, err := i.restoreSnapshot(
+			ctx,
+			plan.ID.ValueString(),
+			plan.RestoreSnapshotID.ValueString(),
+			timeout,
+		)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+			return
+		}
+		instanceDetails = restored
+	}
+
 	state := adaptInstanceDetailsToInstanceResource(
 		*instanceDetails,
 		ctx,
@@ -517,15 +1302,39 @@ func (i *instanceResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.DNSServers = plan.DNSServers
+	state.UserData = plan.UserData
+	state.UserDataBase64 = plan.UserDataBase64
+	state.SSHKey = plan.SSHKey
+	state.GracefulShutdown = plan.GracefulShutdown
+	state.ShutdownTimeout = plan.ShutdownTimeout
+	state.DrainOnDestroy = plan.DrainOnDestroy
+	state.FinalImageName = plan.FinalImageName
+	state.RestoreSnapshotID = plan.RestoreSnapshotID
+	state.Timeouts = plan.Timeouts
+
+	var previousAutoDNS types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("auto_dns"), &previousAutoDNS)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.AutoDNS = i.registerAutoDNS(
+		ctx,
+		plan.AutoDNS,
+		previousAutoDNS,
+		*instanceDetails,
+		&resp.Diagnostics,
+	)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (i *instanceResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
+	dnsTTLs := utils.NewIntMarkdownList(dns.AllowedTtlEnumValues)
 	// 0 has to be prepended manually as it's a valid option.
 	billingFrequencies := utils.NewIntMarkdownList(
 		append(
@@ -538,6 +1347,7 @@ func (i *instanceResource) Schema(
 
 	resp.Schema = schema.Schema{
 		Description: utils.BetaDescription,
+		Version:     instanceSchemaVersion,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
@@ -679,14 +1489,14 @@ func (i *instanceResource) Schema(
 					},
 					"term": schema.Int32Attribute{
 						Required:    true,
-						Description: "Contract term (in months). Used only when type is *MONTHLY*. Valid options are " + contractTerms.Markdown(),
+						Description: "Contract term (in months). Must be *0* when type is *HOURLY* and at least *1* when type is *MONTHLY*. The term of a *MONTHLY* contract can be extended in place, but not shortened. Valid options are " + contractTerms.Markdown(),
 						Validators: []validator.Int32{
 							int32validator.OneOf(contractTerms.ToInt32()...),
 						},
 					},
 					"type": schema.StringAttribute{
 						Required:    true,
-						Description: "Select *HOURLY* for billing based on hourly usage, else *MONTHLY* for billing per month usage",
+						Description: "Select *HOURLY* for billing based on hourly usage, else *MONTHLY* for billing per month usage. An *HOURLY* contract can be changed to *MONTHLY* in place, but not the other way around",
 						Validators: []validator.String{
 							stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedContractTypeEnumValues)...),
 						},
@@ -698,9 +1508,16 @@ func (i *instanceResource) Schema(
 				},
 			},
 			"market_app_id": schema.StringAttribute{
-				Computed:    true,
-				Optional:    true,
-				Description: "Market App ID that must be installed into the instance. " + warningError,
+				Computed: true,
+				Optional: true,
+				Description: fmt.Sprintf(
+					"Market App ID that must be installed into the instance. The available Market Apps and the image each one requires are listed by the `leaseweb_public_cloud_market_apps` data source. %s Valid options are %s",
+					warningError,
+					utils.StringTypeArrayToMarkdown(publiccloud.AllowedMarketAppIdEnumValues),
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedMarketAppIdEnumValues)...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
@@ -708,8 +1525,132 @@ func (i *instanceResource) Schema(
 			"has_private_network": schema.BoolAttribute{
 				Computed:    true,
 				Optional:    true,
-				Description: "Indicates whether the instance is connected to a private network",
+				Description: "Indicates whether the instance is connected to the private network. Setting it to true adds the instance to the private network and false removes it. Instances with snapshots cannot be added.",
+			},
+			"ipv6_address": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public IPv6 address assigned to the instance, if any",
+			},
+			"graceful_shutdown": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the instance is stopped and given `shutdown_timeout` to shut down before it is terminated on destroy. If it does not stop in time it is terminated anyway. Defaults to false.",
+			},
+			"drain_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the instance is deregistered from all target groups it belongs to on destroy, and up to 5 minutes are given for it to be drained before it is terminated. Defaults to false.",
+			},
+			"final_image_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "If set, the instance is stopped on destroy and a custom image with this name is created from it. Up to `shutdown_timeout` is given for the instance to stop and up to 30 minutes for the image to be ready before the instance is terminated. The instance is not terminated if the image cannot be created. The image is kept after the instance is gone and can be found with the `leaseweb_public_cloud_images` data source. Its ID is not tracked, as the state of the instance is removed once the instance is terminated.",
+			},
+			"restore_snapshot_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Setting it, or changing it, restores the snapshot with this ID to the instance and waits until the snapshot is ready again. Only snapshots of the instance itself can be restored, so it cannot be set when the instance is created. Removing it does not change the instance.",
+			},
+			"shutdown_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long to wait for a graceful shutdown on destroy, as a duration string such as \"10m\". Defaults to \"5m\".",
+				Validators: []validator.String{
+					utils.DurationValidator(),
+				},
+			},
+			"dns_servers": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IPv4 or IPv6 addresses of the DNS resolvers that cloud-init configures when the instance is provisioned. The addresses are not reported back by the API, so they are not refreshed or imported. " + warningError,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(ipAddress()),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_data": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Plain text user data, such as a cloud-init configuration, to bootstrap the instance with when it is provisioned. At most %d bytes. The user data is not reported back by the API, so it is not refreshed or imported. Conflicts with `user_data_base64`, `dns_servers` and `ssh_key`. ", maxUserDataSize) + warningError,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, maxUserDataSize),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("user_data_base64"),
+						path.MatchRoot("dns_servers"),
+						path.MatchRoot("ssh_key"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_data_base64": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Base64 encoded user data, optionally gzip compressed, such as the `rendered` attribute of the `cloudinit_config` data source. It is decoded before it is sent, and must be at most %d bytes once decoded. Conflicts with `user_data`, `dns_servers` and `ssh_key`. ", maxUserDataSize) + warningError,
+				Validators: []validator.String{
+					userDataBase64(),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("dns_servers"),
+						path.MatchRoot("ssh_key"),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Public SSH key to install into the instance, so it can be logged in to without a password. Only supported by Linux and FreeBSD images. The key is not reported back by the API, so it is not refreshed or imported. The API does not accept it together with user data, so it conflicts with `user_data`, `user_data_base64` and `dns_servers`. " + warningError,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(ssh-(rsa|ed25519|dss)|ecdsa-sha2-nistp(256|384|521)|sk-(ssh-ed25519|ecdsa-sha2-nistp256)@openssh\.com) \S+`),
+						"must be a public SSH key in OpenSSH format",
+					),
+					stringvalidator.ConflictsWith(path.MatchRoot("dns_servers")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_dns": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "An A record pointing at the public IPv4 address of the instance. It is created after the instance is launched, corrected if it is changed or removed outside of Terraform, and removed before the instance is terminated.",
+				Attributes: map[string]schema.Attribute{
+					"domain_name": schema.StringAttribute{
+						Required:    true,
+						Description: "The domain to create the record in, it must be hosted in the account",
+					},
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the record relative to the domain. `{id}` and `{reference}` are replaced by the ID and reference of the instance.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"ttl": schema.Int32Attribute{
+						Optional:    true,
+						Description: "Time to live of the record. Defaults to the provider's `default_dns_ttl`, or 3600 if that is not set either. Valid options are " + dnsTTLs.Markdown(),
+						Validators: []validator.Int32{
+							int32validator.OneOf(dnsTTLs.ToInt32()...),
+						},
+					},
+					"fqdn": schema.StringAttribute{
+						Computed:    true,
+						Description: "The fully qualified name of the record",
+					},
+					"ip": schema.StringAttribute{
+						Computed:    true,
+						Description: "The IP address the record points to, null if the record is missing or no longer points to the instance",
+					},
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				Update:            true,
+				Delete:            true,
+				CreateDescription: "How long to wait for the instance to be running and connected to its private network. Defaults to \"5m\" for each wait.",
+				UpdateDescription: "How long to wait for the instance to be connected to or disconnected from its private network. Defaults to \"5m\".",
+				DeleteDescription: "How long draining, the graceful shutdown and the final image may take together on destroy. A step that has not started when the timeout is reached is skipped, and the instance is kept if that step is the final image. By default each is bounded by its own timeout only.",
+			}),
+		},
 	}
 }
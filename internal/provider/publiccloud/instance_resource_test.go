@@ -1,7 +1,11 @@
 package publiccloud
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"strings"
 	"testing"
 	"time"
 
@@ -102,4 +106,203 @@ func Test_adaptInstanceDetailsToInstanceResource(t *testing.T) {
 	assert.Equal(t, "isoId", iso.ID.ValueString())
 
 	assert.True(t, got.HasPrivateNetwork.ValueBool())
+	assert.True(t, got.IPv6Address.IsNull())
+}
+
+func Test_adaptInstanceDetailsToInstanceResource_ipv6(t *testing.T) {
+	instance := publiccloud.InstanceDetails{
+		Ips: []publiccloud.IpDetails{
+			{
+				Ip:          "127.0.0.1",
+				Version:     publiccloud.IPVERSION__4,
+				NetworkType: publiccloud.NETWORKTYPE_PUBLIC,
+			},
+			{
+				Ip:          "fd00::1",
+				Version:     publiccloud.IPVERSION__6,
+				NetworkType: publiccloud.NETWORKTYPE_INTERNAL,
+			},
+			{
+				Ip:          "2001:db8::1",
+				Version:     publiccloud.IPVERSION__6,
+				NetworkType: publiccloud.NETWORKTYPE_PUBLIC,
+			},
+		},
+	}
+
+	diags := diag.Diagnostics{}
+
+	got := adaptInstanceDetailsToInstanceResource(
+		instance,
+		context.TODO(),
+		&diags,
+	)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, "2001:db8::1", got.IPv6Address.ValueString())
+}
+
+func Test_adaptDNSServersToUserData(t *testing.T) {
+	got := adaptDNSServersToUserData([]string{"1.1.1.1", "2606:4700:4700::1111"})
+
+	want := `#cloud-config
+manage_resolv_conf: true
+resolv_conf:
+  nameservers:
+    - "1.1.1.1"
+    - "2606:4700:4700::1111"
+`
+	assert.Equal(t, want, got)
+}
+
+func Test_decodeUserDataBase64(t *testing.T) {
+	t.Run("base64 encoded user data is decoded", func(t *testing.T) {
+		got, err := decodeUserDataBase64(base64.StdEncoding.EncodeToString([]byte("#cloud-config\n")))
+
+		require.NoError(t, err)
+		assert.Equal(t, "#cloud-config\n", got)
+	})
+
+	t.Run("gzip compressed user data is decompressed", func(t *testing.T) {
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		_, err := writer.Write([]byte("#cloud-config\n"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		got, err := decodeUserDataBase64(base64.StdEncoding.EncodeToString(compressed.Bytes()))
+
+		require.NoError(t, err)
+		assert.Equal(t, "#cloud-config\n", got)
+	})
+
+	t.Run("values that are not base64 encoded are rejected", func(t *testing.T) {
+		_, err := decodeUserDataBase64("#cloud-config")
+
+		assert.ErrorContains(t, err, "the value must be base64 encoded")
+	})
+
+	t.Run("user data that is too large is rejected", func(t *testing.T) {
+		userData := strings.Repeat("a", maxUserDataSize+1)
+
+		_, err := decodeUserDataBase64(base64.StdEncoding.EncodeToString([]byte(userData)))
+
+		assert.EqualError(t, err, "the decoded value must be at most 16384 bytes")
+	})
+}
+
+func Test_instanceResourceModel_shutdownTimeout(t *testing.T) {
+	t.Run("defaults to 5 minutes", func(t *testing.T) {
+		instance := instanceResourceModel{ShutdownTimeout: basetypes.NewStringNull()}
+
+		assert.Equal(t, 5*time.Minute, instance.shutdownTimeout())
+	})
+
+	t.Run("uses the configured timeout", func(t *testing.T) {
+		instance := instanceResourceModel{ShutdownTimeout: basetypes.NewStringValue("90s")}
+
+		assert.Equal(t, 90*time.Second, instance.shutdownTimeout())
+	})
+}
+
+func Test_containsTarget(t *testing.T) {
+	targets := []publiccloud.Target{{Id: "one"}, {Id: "two"}}
+
+	t.Run("returns true if the instance is a target", func(t *testing.T) {
+		assert.True(t, containsTarget(targets, "two"))
+	})
+
+	t.Run("returns false if the instance is not a target", func(t *testing.T) {
+		assert.False(t, containsTarget(targets, "three"))
+	})
+}
+
+func Test_contractError(t *testing.T) {
+	contract := func(contractType string, term int32) contractResourceModel {
+		return contractResourceModel{
+			Type: basetypes.NewStringValue(contractType),
+			Term: basetypes.NewInt32Value(term),
+		}
+	}
+
+	t.Run("allows valid contracts", func(t *testing.T) {
+		_, err := contractError(nil, contract("HOURLY", 0))
+		require.NoError(t, err)
+
+		_, err = contractError(nil, contract("MONTHLY", 12))
+		require.NoError(t, err)
+	})
+
+	t.Run("an HOURLY contract must not have a term", func(t *testing.T) {
+		attributePath, err := contractError(nil, contract("HOURLY", 3))
+
+		assert.EqualError(t, err, "the term of an HOURLY contract must be 0, got 3")
+		assert.Equal(t, "contract.term", attributePath.String())
+	})
+
+	t.Run("a MONTHLY contract must have a term", func(t *testing.T) {
+		attributePath, err := contractError(nil, contract("MONTHLY", 0))
+
+		assert.EqualError(t, err, "a MONTHLY contract needs a term of at least 1 month")
+		assert.Equal(t, "contract.term", attributePath.String())
+	})
+
+	t.Run("allows changing HOURLY to MONTHLY", func(t *testing.T) {
+		prior := contract("HOURLY", 0)
+		_, err := contractError(&prior, contract("MONTHLY", 1))
+
+		require.NoError(t, err)
+	})
+
+	t.Run("allows extending the term", func(t *testing.T) {
+		prior := contract("MONTHLY", 3)
+		_, err := contractError(&prior, contract("MONTHLY", 12))
+
+		require.NoError(t, err)
+	})
+
+	t.Run("MONTHLY cannot be changed to HOURLY", func(t *testing.T) {
+		prior := contract("MONTHLY", 3)
+		attributePath, err := contractError(&prior, contract("HOURLY", 0))
+
+		require.Error(t, err)
+		assert.Equal(t, "contract.type", attributePath.String())
+	})
+
+	t.Run("the term cannot be shortened", func(t *testing.T) {
+		prior := contract("MONTHLY", 12)
+		attributePath, err := contractError(&prior, contract("MONTHLY", 3))
+
+		assert.EqualError(
+			t,
+			err,
+			"the term of a MONTHLY contract can only be extended, not shortened from 12 to 3 months",
+		)
+		assert.Equal(t, "contract.term", attributePath.String())
+	})
+
+	t.Run("skips unknown values", func(t *testing.T) {
+		_, err := contractError(nil, contractResourceModel{
+			Type: basetypes.NewStringValue("MONTHLY"),
+			Term: basetypes.NewInt32Unknown(),
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func Test_newWaitTimeoutError(t *testing.T) {
+	got := newWaitTimeoutError(
+		20*time.Minute,
+		"state",
+		"RUNNING",
+		"CREATING",
+		publiccloud.STATE_CREATING,
+	)
+
+	assert.EqualError(
+		t,
+		got,
+		"timed out after 20m0s waiting for state to become RUNNING, last observed state was CREATING with the instance in state CREATING",
+	)
 }
@@ -0,0 +1,146 @@
+package publiccloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+// instanceSchemaVersion is the version of the current instance schema. Bump
+// it and add an upgrader whenever attributes of the instance are renamed or
+// restructured.
+const instanceSchemaVersion = 1
+
+var (
+	_ resource.ResourceWithUpgradeState = &instanceResource{}
+)
+
+// instanceResourceModelV0 is the layout of instances before the schema was
+// versioned.
+type instanceResourceModelV0 struct {
+	ID                  types.String `tfsdk:"id"`
+	Region              types.String `tfsdk:"region"`
+	Reference           types.String `tfsdk:"reference"`
+	Image               types.Object `tfsdk:"image"`
+	ISO                 types.Object `tfsdk:"iso"`
+	State               types.String `tfsdk:"state"`
+	Type                types.String `tfsdk:"type"`
+	RootDiskSize        types.Int32  `tfsdk:"root_disk_size"`
+	RootDiskStorageType types.String `tfsdk:"root_disk_storage_type"`
+	IPs                 types.List   `tfsdk:"ips"`
+	Contract            types.Object `tfsdk:"contract"`
+	MarketAppID         types.String `tfsdk:"market_app_id"`
+	HasPrivateNetwork   types.Bool   `tfsdk:"has_private_network"`
+}
+
+// instanceSchemaV0 returns the schema that matches instanceResourceModelV0.
+// Only the types matter, so validators and plan modifiers are left out.
+func instanceSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":        schema.StringAttribute{Computed: true},
+			"region":    schema.StringAttribute{Required: true},
+			"reference": schema.StringAttribute{Optional: true, Computed: true},
+			"image": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"id":          schema.StringAttribute{Required: true},
+					"instance_id": schema.StringAttribute{Computed: true},
+					"name":        schema.StringAttribute{Computed: true},
+					"custom":      schema.BoolAttribute{Computed: true},
+					"state":       schema.StringAttribute{Computed: true},
+					"market_apps": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"storage_types": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"flavour": schema.StringAttribute{Computed: true},
+					"region":  schema.StringAttribute{Computed: true},
+				},
+			},
+			"iso": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"id":   schema.StringAttribute{Computed: true},
+					"name": schema.StringAttribute{Computed: true},
+				},
+			},
+			"state":                  schema.StringAttribute{Computed: true},
+			"type":                   schema.StringAttribute{Required: true},
+			"root_disk_size":         schema.Int32Attribute{Optional: true, Computed: true},
+			"root_disk_storage_type": schema.StringAttribute{Required: true},
+			"ips": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip":             schema.StringAttribute{Computed: true},
+						"instance_id":    schema.StringAttribute{Computed: true},
+						"reverse_lookup": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+			"contract": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"billing_frequency": schema.Int32Attribute{Required: true},
+					"term":              schema.Int32Attribute{Required: true},
+					"type":              schema.StringAttribute{Required: true},
+					"ends_at":           schema.StringAttribute{Computed: true},
+					"state":             schema.StringAttribute{Computed: true},
+				},
+			},
+			"market_app_id":       schema.StringAttribute{Optional: true, Computed: true},
+			"has_private_network": schema.BoolAttribute{Optional: true, Computed: true},
+		},
+	}
+}
+
+// adaptInstanceResourceV0ToInstanceResource carries a version 0 state over.
+// Attributes added since are null, as they are when left out of the
+// configuration, so the upgrade does not cause a diff.
+func adaptInstanceResourceV0ToInstanceResource(prior instanceResourceModelV0) instanceResourceModel {
+	return instanceResourceModel{
+		ID:                  prior.ID,
+		Region:              prior.Region,
+		Reference:           prior.Reference,
+		Image:               prior.Image,
+		ISO:                 prior.ISO,
+		State:               prior.State,
+		Type:                prior.Type,
+		RootDiskSize:        prior.RootDiskSize,
+		RootDiskStorageType: prior.RootDiskStorageType,
+		IPs:                 prior.IPs,
+		Contract:            prior.Contract,
+		MarketAppID:         prior.MarketAppID,
+		HasPrivateNetwork:   prior.HasPrivateNetwork,
+		// Filled in by the refresh that follows the upgrade.
+		IPv6Address:       basetypes.NewStringNull(),
+		DNSServers:        basetypes.NewListNull(types.StringType),
+		UserData:          basetypes.NewStringNull(),
+		UserDataBase64:    basetypes.NewStringNull(),
+		SSHKey:            basetypes.NewStringNull(),
+		GracefulShutdown:  basetypes.NewBoolNull(),
+		ShutdownTimeout:   basetypes.NewStringNull(),
+		DrainOnDestroy:    basetypes.NewBoolNull(),
+		FinalImageName:    basetypes.NewStringNull(),
+		RestoreSnapshotID: basetypes.NewStringNull(),
+		AutoDNS:           basetypes.NewObjectNull(autoDNSResourceModel{}.attributeTypes()),
+		Timeouts:          utils.NewTimeoutsNull(),
+	}
+}
+
+func (i *instanceResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: utils.NewStateUpgrader(
+			instanceSchemaV0(),
+			adaptInstanceResourceV0ToInstanceResource,
+		),
+	}
+}
@@ -0,0 +1,112 @@
+package publiccloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// instanceStateV0 is the state of an instance written before the schema was
+// versioned.
+const instanceStateV0 = `{
+  "id": "ace712e9-a166-47f1-9065-4af0f7e7fce1",
+  "region": "eu-west-3",
+  "reference": "my webserver",
+  "image": {
+    "id": "UBUNTU_24_04_64BIT",
+    "instance_id": null,
+    "name": "Ubuntu 24.04 LTS (x86_64)",
+    "custom": false,
+    "state": null,
+    "market_apps": [],
+    "storage_types": [],
+    "flavour": "ubuntu",
+    "region": null
+  },
+  "iso": null,
+  "state": "RUNNING",
+  "type": "lsw.m3.large",
+  "root_disk_size": 50,
+  "root_disk_storage_type": "CENTRAL",
+  "ips": [
+    {"ip": "10.32.60.12", "instance_id": null, "reverse_lookup": null}
+  ],
+  "contract": {
+    "billing_frequency": 1,
+    "term": 0,
+    "type": "HOURLY",
+    "ends_at": null,
+    "state": "ACTIVE"
+  },
+  "market_app_id": null,
+  "has_private_network": false
+}`
+
+func TestInstanceResource_UpgradeState(t *testing.T) {
+	ctx := context.TODO()
+	instance := instanceResource{}
+
+	upgrader, ok := instance.UpgradeState(ctx)[0]
+	require.True(t, ok, "version 0 can be upgraded")
+
+	priorType := upgrader.PriorSchema.Type().TerraformType(ctx)
+	priorValue, err := tftypes.ValueFromJSONWithOpts(
+		[]byte(instanceStateV0),
+		priorType,
+		tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	)
+	require.NoError(t, err)
+
+	schemaResponse := resource.SchemaResponse{}
+	instance.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	require.Equal(t, int64(instanceSchemaVersion), schemaResponse.Schema.Version)
+
+	request := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Raw: priorValue, Schema: *upgrader.PriorSchema},
+	}
+	response := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+			Schema: schemaResponse.Schema,
+		},
+	}
+	upgrader.StateUpgrader(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	var got instanceResourceModel
+	require.False(t, response.State.Get(ctx, &got).HasError())
+
+	t.Run("attributes are carried over", func(t *testing.T) {
+		assert.Equal(t, "ace712e9-a166-47f1-9065-4af0f7e7fce1", got.ID.ValueString())
+		assert.Equal(t, "my webserver", got.Reference.ValueString())
+		assert.Equal(t, "lsw.m3.large", got.Type.ValueString())
+		assert.Equal(t, int32(50), got.RootDiskSize.ValueInt32())
+
+		image := imageResourceModel{}
+		got.Image.As(ctx, &image, basetypes.ObjectAsOptions{})
+		assert.Equal(t, "UBUNTU_24_04_64BIT", image.ID.ValueString())
+
+		contract := contractResourceModel{}
+		got.Contract.As(ctx, &contract, basetypes.ObjectAsOptions{})
+		assert.Equal(t, "HOURLY", contract.Type.ValueString())
+		assert.Len(t, got.IPs.Elements(), 1)
+	})
+
+	t.Run("new attributes are null so they plan no changes", func(t *testing.T) {
+		assert.True(t, got.DNSServers.IsNull(), "dns_servers forces replacement if set")
+		assert.True(t, got.GracefulShutdown.IsNull())
+		assert.True(t, got.ShutdownTimeout.IsNull())
+		assert.True(t, got.DrainOnDestroy.IsNull())
+		assert.True(t, got.FinalImageName.IsNull())
+		assert.True(t, got.RestoreSnapshotID.IsNull())
+		assert.True(t, got.AutoDNS.IsNull())
+		assert.True(t, got.Timeouts.IsNull())
+		assert.True(t, got.IPv6Address.IsNull())
+	})
+}
@@ -0,0 +1,324 @@
+package publiccloud
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &instanceTypesDataSource{}
+)
+
+type instanceTypePriceDataSourceModel struct {
+	HourlyPrice  types.String `tfsdk:"hourly_price"`
+	MonthlyPrice types.String `tfsdk:"monthly_price"`
+}
+
+type instanceTypePricesDataSourceModel struct {
+	Currency       types.String                     `tfsdk:"currency"`
+	Compute        instanceTypePriceDataSourceModel `tfsdk:"compute"`
+	LocalStorage   instanceTypePriceDataSourceModel `tfsdk:"local_storage"`
+	CentralStorage instanceTypePriceDataSourceModel `tfsdk:"central_storage"`
+}
+
+type instanceTypeDataSourceModel struct {
+	Name         types.String                      `tfsdk:"name"`
+	Region       types.String                      `tfsdk:"region"`
+	CPU          types.Int32                       `tfsdk:"cpu"`
+	Memory       types.Float64                     `tfsdk:"memory"`
+	StorageTypes []types.String                    `tfsdk:"storage_types"`
+	Prices       instanceTypePricesDataSourceModel `tfsdk:"prices"`
+}
+
+type instanceTypesDataSourceModel struct {
+	Region        types.String                  `tfsdk:"region"`
+	InstanceID    types.String                  `tfsdk:"instance_id"`
+	InstanceTypes []instanceTypeDataSourceModel `tfsdk:"instance_types"`
+}
+
+func adaptPriceToInstanceTypePriceDataSource(price publiccloud.Price) instanceTypePriceDataSourceModel {
+	return instanceTypePriceDataSourceModel{
+		HourlyPrice:  basetypes.NewStringValue(price.GetHourlyPrice()),
+		MonthlyPrice: basetypes.NewStringValue(price.GetMonthlyPrice()),
+	}
+}
+
+func adaptInstanceTypeToInstanceTypeDataSource(
+	instanceType publiccloud.InstanceType,
+	region publiccloud.RegionName,
+) instanceTypeDataSourceModel {
+	resources := instanceType.GetResources()
+	cpu := resources.GetCpu()
+	memory := resources.GetMemory()
+	prices := instanceType.GetPrices()
+	storage := prices.GetStorage()
+
+	storageTypes := []types.String{}
+	for _, storageType := range instanceType.GetStorageTypes() {
+		storageTypes = append(storageTypes, basetypes.NewStringValue(string(storageType)))
+	}
+
+	return instanceTypeDataSourceModel{
+		Name:         basetypes.NewStringValue(string(instanceType.GetName())),
+		Region:       basetypes.NewStringValue(string(region)),
+		CPU:          basetypes.NewInt32Value(cpu.GetValue()),
+		Memory:       basetypes.NewFloat64Value(float64(memory.GetValue())),
+		StorageTypes: storageTypes,
+		Prices: instanceTypePricesDataSourceModel{
+			Currency:       basetypes.NewStringValue(prices.GetCurrency()),
+			Compute:        adaptPriceToInstanceTypePriceDataSource(prices.GetCompute()),
+			LocalStorage:   adaptPriceToInstanceTypePriceDataSource(storage.GetLocal()),
+			CentralStorage: adaptPriceToInstanceTypePriceDataSource(storage.GetCentral()),
+		},
+	}
+}
+
+// listInstanceTypes fetches all instance types available in the region.
+func listInstanceTypes(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	region publiccloud.RegionName,
+) ([]publiccloud.InstanceType, *http.Response, error) {
+	instanceTypes := []publiccloud.InstanceType{}
+	var offset *int32
+
+	request := api.GetInstanceTypeList(ctx).Region(region)
+	for {
+		result, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		instanceTypes = append(instanceTypes, result.GetInstanceTypes()...)
+
+		metadata := result.GetMetadata()
+
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+
+		if offset == nil {
+			return instanceTypes, httpResponse, nil
+		}
+
+		request = request.Offset(*offset)
+	}
+}
+
+// listUpdateInstanceTypes fetches all instance types the instance can be
+// updated to.
+func listUpdateInstanceTypes(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	instanceID string,
+) ([]publiccloud.InstanceType, *http.Response, error) {
+	instanceTypes := []publiccloud.InstanceType{}
+	var offset *int32
+
+	request := api.GetUpdateInstanceTypeList(ctx, instanceID)
+	for {
+		result, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		instanceTypes = append(instanceTypes, result.GetInstanceTypes()...)
+
+		metadata := result.GetMetadata()
+
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+
+		if offset == nil {
+			return instanceTypes, httpResponse, nil
+		}
+
+		request = request.Offset(*offset)
+	}
+}
+
+type instanceTypesDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (i *instanceTypesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	price := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: description,
+			Attributes: map[string]schema.Attribute{
+				"hourly_price": schema.StringAttribute{
+					Computed:    true,
+					Description: "The price per hour",
+				},
+				"monthly_price": schema.StringAttribute{
+					Computed:    true,
+					Description: "The price per month",
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Description: "Lists the instance types that can be launched, per region, or the instance types an existing instance can be updated to.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only instance types available in this region. Defaults to all regions. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedRegionNameEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedRegionNameEnumValues)...),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only the instance types this instance can be updated to, in the region of the instance. Cannot be combined with `region`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("region")),
+				},
+			},
+			"instance_types": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The instance type name. Can be used as the `type` of an instance.",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "The region the instance type is available in",
+						},
+						"cpu": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The number of vCPUs",
+						},
+						"memory": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The memory in GiB",
+						},
+						"storage_types": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The root disk storage types the instance type supports",
+						},
+						"prices": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"currency": schema.StringAttribute{
+									Computed:    true,
+									Description: "The currency of the prices",
+								},
+								"compute":         price("The price of running the instance"),
+								"local_storage":   price("The price per GiB of local storage"),
+								"central_storage": price("The price per GiB of central storage"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (i *instanceTypesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config instanceTypesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.InstanceID.IsNull() {
+		instance, httpResponse, err := i.PubliccloudAPI.GetInstance(
+			ctx,
+			config.InstanceID.ValueString(),
+		).Execute()
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+			return
+		}
+
+		instanceTypes, httpResponse, err := listUpdateInstanceTypes(
+			ctx,
+			i.PubliccloudAPI,
+			config.InstanceID.ValueString(),
+		)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+			return
+		}
+
+		config.InstanceTypes = []instanceTypeDataSourceModel{}
+		for _, instanceType := range instanceTypes {
+			config.InstanceTypes = append(
+				config.InstanceTypes,
+				adaptInstanceTypeToInstanceTypeDataSource(instanceType, instance.GetRegion()),
+			)
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+		return
+	}
+
+	var regions []publiccloud.RegionName
+	if config.Region.IsNull() {
+		sdkRegions, httpResponse, err := listRegions(ctx, i.PubliccloudAPI)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+			return
+		}
+		for _, region := range sdkRegions {
+			regions = append(regions, region.GetName())
+		}
+	} else {
+		regions = []publiccloud.RegionName{publiccloud.RegionName(config.Region.ValueString())}
+	}
+
+	config.InstanceTypes = []instanceTypeDataSourceModel{}
+	for _, region := range regions {
+		instanceTypes, httpResponse, err := listInstanceTypes(ctx, i.PubliccloudAPI, region)
+		if err != nil {
+			utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+			return
+		}
+
+		for _, instanceType := range instanceTypes {
+			config.InstanceTypes = append(
+				config.InstanceTypes,
+				adaptInstanceTypeToInstanceTypeDataSource(instanceType, region),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+func NewInstanceTypesDataSource() datasource.DataSource {
+	return &instanceTypesDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_instance_types",
+		},
+	}
+}
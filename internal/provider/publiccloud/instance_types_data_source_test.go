@@ -0,0 +1,56 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptInstanceTypeToInstanceTypeDataSource(t *testing.T) {
+	sdkInstanceType := publiccloud.InstanceType{
+		Name: "lsw.c3.large",
+		Resources: publiccloud.Resources{
+			Cpu:    publiccloud.Cpu{Value: 2, Unit: "vCPU"},
+			Memory: publiccloud.Memory{Value: 3.5, Unit: "GiB"},
+		},
+		StorageTypes: []publiccloud.StorageType{
+			publiccloud.STORAGETYPE_LOCAL,
+			publiccloud.STORAGETYPE_CENTRAL,
+		},
+		Prices: publiccloud.Prices{
+			Currency: "EUR",
+			Compute: publiccloud.Price{
+				HourlyPrice:  "0.0395",
+				MonthlyPrice: "26.0200",
+			},
+			Storage: publiccloud.Storage{
+				Local: publiccloud.Price{
+					HourlyPrice:  "0.00004",
+					MonthlyPrice: "0.03000",
+				},
+				Central: publiccloud.Price{
+					HourlyPrice:  "0.00011",
+					MonthlyPrice: "0.08000",
+				},
+			},
+		},
+	}
+
+	got := adaptInstanceTypeToInstanceTypeDataSource(
+		sdkInstanceType,
+		publiccloud.REGIONNAME_EU_WEST_3,
+	)
+
+	assert.Equal(t, "lsw.c3.large", got.Name.ValueString())
+	assert.Equal(t, "eu-west-3", got.Region.ValueString())
+	assert.Equal(t, int32(2), got.CPU.ValueInt32())
+	assert.Equal(t, 3.5, got.Memory.ValueFloat64())
+	assert.Len(t, got.StorageTypes, 2)
+	assert.Equal(t, "CENTRAL", got.StorageTypes[1].ValueString())
+	assert.Equal(t, "EUR", got.Prices.Currency.ValueString())
+	assert.Equal(t, "0.0395", got.Prices.Compute.HourlyPrice.ValueString())
+	assert.Equal(t, "26.0200", got.Prices.Compute.MonthlyPrice.ValueString())
+	assert.Equal(t, "0.03000", got.Prices.LocalStorage.MonthlyPrice.ValueString())
+	assert.Equal(t, "0.00011", got.Prices.CentralStorage.HourlyPrice.ValueString())
+}
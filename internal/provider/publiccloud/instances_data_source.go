@@ -65,7 +65,57 @@ type ipDataSourceModel struct {
 }
 
 type instancesDataSourceModel struct {
-	Instances []instanceDataSourceModel `tfsdk:"instances"`
+	Limit         types.Int32               `tfsdk:"limit"`
+	Reference     types.String              `tfsdk:"reference"`
+	IP            types.String              `tfsdk:"ip"`
+	ImageID       types.String              `tfsdk:"image_id"`
+	State         types.String              `tfsdk:"state"`
+	Region        types.String              `tfsdk:"region"`
+	Type          types.String              `tfsdk:"type"`
+	ContractType  types.String              `tfsdk:"contract_type"`
+	ContractState types.String              `tfsdk:"contract_state"`
+	Instances     []instanceDataSourceModel `tfsdk:"instances"`
+}
+
+// filterInstanceList applies the configured filters to the request, so
+// only the matching instances are fetched from the API.
+func filterInstanceList(
+	request publiccloud.ApiGetInstanceListRequest,
+	config instancesDataSourceModel,
+) publiccloud.ApiGetInstanceListRequest {
+	if !config.Reference.IsNull() && !config.Reference.IsUnknown() {
+		request = request.Reference(config.Reference.ValueString())
+	}
+
+	if !config.IP.IsNull() && !config.IP.IsUnknown() {
+		request = request.Ip(config.IP.ValueString())
+	}
+
+	if !config.ImageID.IsNull() && !config.ImageID.IsUnknown() {
+		request = request.ImageId(config.ImageID.ValueString())
+	}
+
+	if !config.State.IsNull() && !config.State.IsUnknown() {
+		request = request.State(publiccloud.State(config.State.ValueString()))
+	}
+
+	if !config.Region.IsNull() && !config.Region.IsUnknown() {
+		request = request.Region(publiccloud.RegionName(config.Region.ValueString()))
+	}
+
+	if !config.Type.IsNull() && !config.Type.IsUnknown() {
+		request = request.Type_(publiccloud.TypeName(config.Type.ValueString()))
+	}
+
+	if !config.ContractType.IsNull() && !config.ContractType.IsUnknown() {
+		request = request.ContractType(publiccloud.ContractType(config.ContractType.ValueString()))
+	}
+
+	if !config.ContractState.IsNull() && !config.ContractState.IsUnknown() {
+		request = request.ContractState(publiccloud.ContractState(config.ContractState.ValueString()))
+	}
+
+	return request
 }
 
 func NewInstancesDataSource() datasource.DataSource {
@@ -82,14 +132,20 @@ type instancesDataSource struct {
 
 func (d *instancesDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
+	var config instancesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var instances []publiccloud.Instance
 	var offset *int32
 
 	// Get instances
-	request := d.PubliccloudAPI.GetInstanceList(ctx)
+	request := filterInstanceList(d.PubliccloudAPI.GetInstanceList(ctx), config)
 	for {
 		result, httpResponse, err := request.Execute()
 		if err != nil {
@@ -97,7 +153,14 @@ func (d *instancesDataSource) Read(
 			return
 		}
 
-		instances = append(instances, result.Instances...)
+		var limitReached bool
+		instances, limitReached = utils.ApplyLimit(
+			append(instances, result.Instances...),
+			config.Limit,
+		)
+		if limitReached {
+			break
+		}
 
 		metadata := result.GetMetadata()
 		offset = utils.NewOffset(
@@ -147,7 +210,8 @@ func (d *instancesDataSource) Read(
 		}
 	}
 
-	var state instancesDataSourceModel
+	state := config
+	state.Instances = []instanceDataSourceModel{}
 
 	sort.Slice(instanceDetailsList, func(i, j int) bool {
 		return instanceDetailsList[i].Id < instanceDetailsList[j].Id
@@ -216,6 +280,55 @@ func (d *instancesDataSource) Schema(
 	resp.Schema = schema.Schema{
 		Description: utils.BetaDescription,
 		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int32Attribute{
+				Optional:    true,
+				Description: "Maximum number of instances to return. All instances are returned by default.",
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+			},
+			"reference": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of instances by reference.",
+			},
+			"ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of instances by ip address.",
+			},
+			"image_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of instances by image ID.",
+			},
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of instances by state. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedStateEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedStateEnumValues)...),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of instances by region. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedRegionNameEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedRegionNameEnumValues)...),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of instances by instance type, e.g. `lsw.m3.large`.",
+			},
+			"contract_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of instances by contract type. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedContractTypeEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedContractTypeEnumValues)...),
+				},
+			},
+			"contract_state": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter the list of instances by contract state. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedContractStateEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedContractStateEnumValues)...),
+				},
+			},
 			"instances": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
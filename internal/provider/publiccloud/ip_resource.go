@@ -2,9 +2,7 @@ package publiccloud
 
 import (
 	"context"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,27 +39,12 @@ func (i *ipResource) ImportState(
 	request resource.ImportStateRequest,
 	response *resource.ImportStateResponse,
 ) {
-	idParts := strings.Split(request.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		utils.UnexpectedImportIdentifierError(
-			&response.Diagnostics,
-			"instance_id,ip",
-			request.ID,
-		)
-		return
-	}
-
-	response.Diagnostics.Append(response.State.SetAttribute(
+	utils.ImportCompositeID(
 		ctx,
-		path.Root("instance_id"),
-		idParts[0],
-	)...)
-	response.Diagnostics.Append(response.State.SetAttribute(
-		ctx,
-		path.Root("ip"),
-		idParts[1],
-	)...)
+		[]string{"instance_id", "ip"},
+		request,
+		response,
+	)
 }
 
 func (i *ipResource) Schema(
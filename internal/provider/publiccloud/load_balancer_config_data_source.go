@@ -0,0 +1,550 @@
+package publiccloud
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &loadBalancerConfigDataSource{}
+)
+
+// loadBalancerConfigConcurrency is how many target groups are fetched at the
+// same time.
+const loadBalancerConfigConcurrency = 4
+
+type loadBalancerConfigDataSourceModel struct {
+	LoadBalancerID     types.String                                   `tfsdk:"load_balancer_id"`
+	BalancingAlgorithm types.String                                   `tfsdk:"balancing_algorithm"`
+	XForwardedFor      types.Bool                                     `tfsdk:"x_forwarded_for"`
+	IdleTimeout        types.Int32                                    `tfsdk:"idle_timeout"`
+	StickySession      *stickySessionResourceModel                    `tfsdk:"sticky_session"`
+	Listeners          []loadBalancerConfigListenerDataSourceModel    `tfsdk:"listeners"`
+	TargetGroups       []loadBalancerConfigTargetGroupDataSourceModel `tfsdk:"target_groups"`
+}
+
+type loadBalancerConfigListenerDataSourceModel struct {
+	ID       types.String                            `tfsdk:"id"`
+	Protocol types.String                            `tfsdk:"protocol"`
+	Port     types.Int32                             `tfsdk:"port"`
+	Rules    []loadBalancerConfigRuleDataSourceModel `tfsdk:"rules"`
+}
+
+type loadBalancerConfigRuleDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Default       types.Bool   `tfsdk:"default"`
+	TargetGroupID types.String `tfsdk:"target_group_id"`
+}
+
+type loadBalancerConfigTargetGroupDataSourceModel struct {
+	ID          types.String                              `tfsdk:"id"`
+	Name        types.String                              `tfsdk:"name"`
+	Protocol    types.String                              `tfsdk:"protocol"`
+	Port        types.Int32                               `tfsdk:"port"`
+	Region      types.String                              `tfsdk:"region"`
+	HealthCheck *healthCheckDataSourceModel               `tfsdk:"health_check"`
+	Members     []loadBalancerConfigMemberDataSourceModel `tfsdk:"members"`
+}
+
+type loadBalancerConfigMemberDataSourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Reference         types.String   `tfsdk:"reference"`
+	State             types.String   `tfsdk:"state"`
+	IPs               []types.String `tfsdk:"ips"`
+	HealthStatus      types.String   `tfsdk:"health_status"`
+	HealthDescription types.String   `tfsdk:"health_description"`
+}
+
+// loadBalancerTargetGroup is a target group that the rules of a load
+// balancer forward to, with its registered targets.
+type loadBalancerTargetGroup struct {
+	targetGroup publiccloud.TargetGroup
+	targets     []publiccloud.Target
+}
+
+func adaptLoadBalancerListenerToLoadBalancerConfigListener(
+	listener publiccloud.LoadBalancerListener,
+) loadBalancerConfigListenerDataSourceModel {
+	model := loadBalancerConfigListenerDataSourceModel{
+		ID:       basetypes.NewStringValue(listener.GetId()),
+		Protocol: basetypes.NewStringValue(string(listener.GetProtocol())),
+		Port:     basetypes.NewInt32Value(listener.GetPort()),
+		Rules:    []loadBalancerConfigRuleDataSourceModel{},
+	}
+	for _, rule := range listener.GetRules() {
+		model.Rules = append(model.Rules, loadBalancerConfigRuleDataSourceModel{
+			ID:            basetypes.NewStringValue(rule.GetId()),
+			Default:       basetypes.NewBoolValue(rule.GetDefault()),
+			TargetGroupID: basetypes.NewStringValue(rule.GetTargetGroupId()),
+		})
+	}
+
+	return model
+}
+
+func adaptTargetToLoadBalancerConfigMember(
+	target publiccloud.Target,
+) loadBalancerConfigMemberDataSourceModel {
+	member := loadBalancerConfigMemberDataSourceModel{
+		ID:                basetypes.NewStringValue(target.GetId()),
+		Reference:         basetypes.NewStringValue(target.GetReference()),
+		State:             basetypes.NewStringValue(target.GetState()),
+		IPs:               []types.String{},
+		HealthStatus:      basetypes.NewStringNull(),
+		HealthDescription: basetypes.NewStringNull(),
+	}
+	for _, ip := range target.GetIps() {
+		member.IPs = append(member.IPs, basetypes.NewStringValue(ip.GetIp()))
+	}
+	if healthCheck, _ := target.GetHealthCheckOk(); healthCheck != nil {
+		member.HealthStatus = basetypes.NewStringValue(string(healthCheck.GetState()))
+		member.HealthDescription = basetypes.NewStringValue(healthCheck.GetDescription())
+	}
+
+	return member
+}
+
+func adaptLoadBalancerTargetGroupToLoadBalancerConfigTargetGroup(
+	loadBalancerTargetGroup loadBalancerTargetGroup,
+) loadBalancerConfigTargetGroupDataSourceModel {
+	targetGroup := adaptTargetGroupToTargetGroupDataSource(loadBalancerTargetGroup.targetGroup)
+
+	model := loadBalancerConfigTargetGroupDataSourceModel{
+		ID:          targetGroup.ID,
+		Name:        targetGroup.Name,
+		Protocol:    targetGroup.Protocol,
+		Port:        targetGroup.Port,
+		Region:      targetGroup.Region,
+		HealthCheck: targetGroup.HealthCheck,
+		Members:     []loadBalancerConfigMemberDataSourceModel{},
+	}
+	for _, target := range loadBalancerTargetGroup.targets {
+		model.Members = append(model.Members, adaptTargetToLoadBalancerConfigMember(target))
+	}
+
+	return model
+}
+
+func adaptLoadBalancerConfig(
+	loadBalancerDetails publiccloud.LoadBalancerDetails,
+	listeners []publiccloud.LoadBalancerListener,
+	targetGroups []loadBalancerTargetGroup,
+) loadBalancerConfigDataSourceModel {
+	config := loadBalancerConfigDataSourceModel{
+		LoadBalancerID:     basetypes.NewStringValue(loadBalancerDetails.GetId()),
+		BalancingAlgorithm: basetypes.NewStringNull(),
+		XForwardedFor:      basetypes.NewBoolNull(),
+		IdleTimeout:        basetypes.NewInt32Null(),
+		Listeners:          []loadBalancerConfigListenerDataSourceModel{},
+		TargetGroups:       []loadBalancerConfigTargetGroupDataSourceModel{},
+	}
+
+	if configuration := loadBalancerDetails.Configuration.Get(); configuration != nil {
+		stickySession := adaptStickySessionToStickySessionResource(configuration.StickySession.Get())
+
+		config.BalancingAlgorithm = basetypes.NewStringValue(string(configuration.GetBalance()))
+		config.XForwardedFor = basetypes.NewBoolValue(configuration.GetXForwardedFor())
+		config.IdleTimeout = basetypes.NewInt32Value(configuration.GetIdleTimeOut())
+		config.StickySession = &stickySession
+	}
+
+	for _, listener := range listeners {
+		config.Listeners = append(
+			config.Listeners,
+			adaptLoadBalancerListenerToLoadBalancerConfigListener(listener),
+		)
+	}
+	for _, targetGroup := range targetGroups {
+		config.TargetGroups = append(
+			config.TargetGroups,
+			adaptLoadBalancerTargetGroupToLoadBalancerConfigTargetGroup(targetGroup),
+		)
+	}
+
+	return config
+}
+
+// getTargetGroupIDs returns the target groups the rules of the listeners
+// forward to, in the order they are first referred to.
+func getTargetGroupIDs(listeners []publiccloud.LoadBalancerListener) []string {
+	var ids []string
+	seen := map[string]bool{}
+	for _, listener := range listeners {
+		for _, rule := range listener.GetRules() {
+			id := rule.GetTargetGroupId()
+			if id == "" || seen[id] {
+				continue
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// listLoadBalancerListeners fetches all listeners of a load balancer.
+func listLoadBalancerListeners(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	loadBalancerID string,
+) ([]publiccloud.LoadBalancerListener, *http.Response, error) {
+	listeners := []publiccloud.LoadBalancerListener{}
+	var offset *int32
+
+	request := api.GetLoadBalancerListenerList(ctx, loadBalancerID)
+	for {
+		result, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		listeners = append(listeners, result.GetListeners()...)
+
+		metadata := result.GetMetadata()
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+		if offset == nil {
+			return listeners, httpResponse, nil
+		}
+
+		request = request.Offset(*offset)
+	}
+}
+
+// listTargets fetches all targets registered in a target group.
+func listTargets(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	targetGroupID string,
+) ([]publiccloud.Target, *http.Response, error) {
+	targets := []publiccloud.Target{}
+	var offset *int32
+
+	request := api.GetTargetList(ctx, targetGroupID)
+	for {
+		result, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		targets = append(targets, result.GetTargets()...)
+
+		metadata := result.GetMetadata()
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+		if offset == nil {
+			return targets, httpResponse, nil
+		}
+
+		request = request.Offset(*offset)
+	}
+}
+
+// listLoadBalancerTargetGroups fetches the target groups with their targets,
+// with up to concurrency target groups in flight. The first error cancels the
+// target groups that did not start yet. The order of ids is kept.
+func listLoadBalancerTargetGroups(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	ids []string,
+	concurrency int,
+) ([]loadBalancerTargetGroup, *http.Response, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	var firstResponse *http.Response
+	semaphore := make(chan struct{}, concurrency)
+	targetGroups := make([]loadBalancerTargetGroup, len(ids))
+
+	fail := func(httpResponse *http.Response, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			firstResponse = httpResponse
+			cancel()
+		}
+	}
+
+	for i, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			targetGroup, httpResponse, err := api.GetTargetGroup(ctx, id).Execute()
+			if err != nil {
+				fail(httpResponse, err)
+				return
+			}
+			targets, httpResponse, err := listTargets(ctx, api, id)
+			if err != nil {
+				fail(httpResponse, err)
+				return
+			}
+
+			targetGroups[i] = loadBalancerTargetGroup{
+				targetGroup: *targetGroup,
+				targets:     targets,
+			}
+		}(i, id)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstResponse, firstErr
+	}
+
+	return targetGroups, nil, nil
+}
+
+type loadBalancerConfigDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (l *loadBalancerConfigDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription + " The effective configuration of a load balancer: its listeners with their rules, and the target groups the rules forward to with their health checks and members.",
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Load balancer ID",
+			},
+			"balancing_algorithm": schema.StringAttribute{
+				Computed:    true,
+				Description: "The algorithm used to distribute requests over the targets",
+			},
+			"x_forwarded_for": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the load balancer adds the `X-Forwarded-For` header to requests forwarded to the targets",
+			},
+			"idle_timeout": schema.Int32Attribute{
+				Computed:    true,
+				Description: "How long an idle connection is kept open (in seconds)",
+			},
+			"sticky_session": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Session affinity of all listeners",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed: true,
+					},
+					"max_lifetime": schema.Int32Attribute{
+						Computed:    true,
+						Description: "How long a client sticks to the same target (in seconds), null if sticky sessions are disabled",
+					},
+				},
+			},
+			"listeners": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The listener unique identifier",
+						},
+						"protocol": schema.StringAttribute{
+							Computed: true,
+						},
+						"port": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The port the listener listens on",
+						},
+						"rules": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:    true,
+										Description: "The rule unique identifier",
+									},
+									"default": schema.BoolAttribute{
+										Computed:    true,
+										Description: "Whether the rule applies to requests that match no other rule",
+									},
+									"target_group_id": schema.StringAttribute{
+										Computed:    true,
+										Description: "The target group requests are forwarded to",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"target_groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The target groups the rules forward to, in the order they are first referred to",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Target group ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the target group",
+						},
+						"protocol": schema.StringAttribute{
+							Computed: true,
+						},
+						"port": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The port of the target group",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Region name",
+						},
+						"health_check": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "How the targets are checked, null if they are not",
+							Attributes: map[string]schema.Attribute{
+								"protocol": schema.StringAttribute{
+									Computed: true,
+								},
+								"method": schema.StringAttribute{
+									Computed:    true,
+									Description: "The HTTP method of HTTP and HTTPS health checks",
+								},
+								"uri": schema.StringAttribute{
+									Computed:    true,
+									Description: "URI to check in the target instances",
+								},
+								"host": schema.StringAttribute{
+									Computed:    true,
+									Description: "Host for the health check if any",
+								},
+								"port": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Port number",
+								},
+							},
+						},
+						"members": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The targets registered in the target group",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:    true,
+										Description: "The instance unique identifier",
+									},
+									"reference": schema.StringAttribute{
+										Computed:    true,
+										Description: "The identifying name of the instance",
+									},
+									"state": schema.StringAttribute{
+										Computed:    true,
+										Description: "The state of the instance",
+									},
+									"ips": schema.ListAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+									"health_status": schema.StringAttribute{
+										Computed:    true,
+										Description: "The result of the last health check, null if the target group has no health check",
+									},
+									"health_description": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (l *loadBalancerConfigDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config loadBalancerConfigDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	loadBalancerID := config.LoadBalancerID.ValueString()
+
+	var wg sync.WaitGroup
+	var loadBalancerDetails *publiccloud.LoadBalancerDetails
+	var listeners []publiccloud.LoadBalancerListener
+	var loadBalancerResponse, listenersResponse *http.Response
+	var loadBalancerErr, listenersErr error
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		loadBalancerDetails, loadBalancerResponse, loadBalancerErr = l.PubliccloudAPI.
+			GetLoadBalancer(ctx, loadBalancerID).
+			Execute()
+	}()
+	go func() {
+		defer wg.Done()
+		listeners, listenersResponse, listenersErr = listLoadBalancerListeners(ctx, l.PubliccloudAPI, loadBalancerID)
+	}()
+	wg.Wait()
+
+	if loadBalancerErr != nil {
+		utils.SdkError(ctx, &response.Diagnostics, loadBalancerErr, loadBalancerResponse)
+		return
+	}
+	if listenersErr != nil {
+		utils.SdkError(ctx, &response.Diagnostics, listenersErr, listenersResponse)
+		return
+	}
+
+	targetGroups, httpResponse, err := listLoadBalancerTargetGroups(
+		ctx,
+		l.PubliccloudAPI,
+		getTargetGroupIDs(listeners),
+		loadBalancerConfigConcurrency,
+	)
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptLoadBalancerConfig(*loadBalancerDetails, listeners, targetGroups)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func NewLoadBalancerConfigDataSource() datasource.DataSource {
+	return &loadBalancerConfigDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_load_balancer_config",
+		},
+	}
+}
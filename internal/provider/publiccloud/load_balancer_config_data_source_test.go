@@ -0,0 +1,139 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getTargetGroupIDs(t *testing.T) {
+	listeners := []publiccloud.LoadBalancerListener{
+		{
+			Id: "listener1",
+			Rules: []publiccloud.LoadBalancerListenerRule{
+				{Id: "rule1", Default: true, TargetGroupId: "targetGroup1"},
+				{Id: "rule2", TargetGroupId: "targetGroup2"},
+			},
+		},
+		{
+			Id: "listener2",
+			Rules: []publiccloud.LoadBalancerListenerRule{
+				{Id: "rule3", Default: true, TargetGroupId: "targetGroup2"},
+				{Id: "rule4"},
+			},
+		},
+	}
+
+	got := getTargetGroupIDs(listeners)
+
+	assert.Equal(t, []string{"targetGroup1", "targetGroup2"}, got)
+}
+
+func Test_adaptTargetToLoadBalancerConfigMember(t *testing.T) {
+	t.Run("health check is set", func(t *testing.T) {
+		target := publiccloud.Target{
+			Id:        "id",
+			Reference: "reference",
+			State:     "RUNNING",
+			Ips:       []publiccloud.Ip{{Ip: "10.0.0.1"}},
+			HealthCheck: *publiccloud.NewNullableSchemasHealthCheckStatus(
+				publiccloud.NewSchemasHealthCheckStatus(
+					publiccloud.HEALTHCHECKSTATUS_UNHEALTHY,
+					"Connection refused",
+				),
+			),
+		}
+
+		got := adaptTargetToLoadBalancerConfigMember(target)
+
+		assert.Equal(t, "id", got.ID.ValueString())
+		assert.Equal(t, "reference", got.Reference.ValueString())
+		assert.Equal(t, "RUNNING", got.State.ValueString())
+		assert.Len(t, got.IPs, 1)
+		assert.Equal(t, "10.0.0.1", got.IPs[0].ValueString())
+		assert.Equal(t, "UNHEALTHY", got.HealthStatus.ValueString())
+		assert.Equal(t, "Connection refused", got.HealthDescription.ValueString())
+	})
+
+	t.Run("health check is not set", func(t *testing.T) {
+		got := adaptTargetToLoadBalancerConfigMember(publiccloud.Target{Id: "id"})
+
+		assert.Empty(t, got.IPs)
+		assert.True(t, got.HealthStatus.IsNull())
+		assert.True(t, got.HealthDescription.IsNull())
+	})
+}
+
+func Test_adaptLoadBalancerConfig(t *testing.T) {
+	t.Run("configuration is set", func(t *testing.T) {
+		loadBalancerDetails := publiccloud.LoadBalancerDetails{
+			Id: "id",
+			Configuration: *publiccloud.NewNullableLoadBalancerConfiguration(
+				&publiccloud.LoadBalancerConfiguration{
+					StickySession: *publiccloud.NewNullableStickySession(
+						publiccloud.NewStickySession(true, 1000),
+					),
+					Balance:       publiccloud.BALANCE_SOURCE,
+					XForwardedFor: true,
+					IdleTimeOut:   60,
+				},
+			),
+		}
+		listeners := []publiccloud.LoadBalancerListener{
+			{
+				Id:       "listener",
+				Protocol: publiccloud.PROTOCOL_HTTP,
+				Port:     80,
+				Rules: []publiccloud.LoadBalancerListenerRule{
+					{Id: "rule", Default: true, TargetGroupId: "targetGroup"},
+				},
+			},
+		}
+		targetGroups := []loadBalancerTargetGroup{
+			{
+				targetGroup: publiccloud.TargetGroup{
+					Id:       "targetGroup",
+					Name:     "name",
+					Protocol: publiccloud.PROTOCOL_HTTP,
+					Port:     8080,
+					Region:   publiccloud.REGIONNAME_EU_WEST_3,
+				},
+				targets: []publiccloud.Target{{Id: "target"}},
+			},
+		}
+
+		got := adaptLoadBalancerConfig(loadBalancerDetails, listeners, targetGroups)
+
+		assert.Equal(t, "id", got.LoadBalancerID.ValueString())
+		assert.Equal(t, "source", got.BalancingAlgorithm.ValueString())
+		assert.True(t, got.XForwardedFor.ValueBool())
+		assert.Equal(t, int32(60), got.IdleTimeout.ValueInt32())
+		assert.True(t, got.StickySession.Enabled.ValueBool())
+		assert.Equal(t, int32(1000), got.StickySession.MaxLifetime.ValueInt32())
+
+		assert.Len(t, got.Listeners, 1)
+		assert.Equal(t, "HTTP", got.Listeners[0].Protocol.ValueString())
+		assert.Equal(t, int32(80), got.Listeners[0].Port.ValueInt32())
+		assert.Len(t, got.Listeners[0].Rules, 1)
+		assert.True(t, got.Listeners[0].Rules[0].Default.ValueBool())
+		assert.Equal(t, "targetGroup", got.Listeners[0].Rules[0].TargetGroupID.ValueString())
+
+		assert.Len(t, got.TargetGroups, 1)
+		assert.Equal(t, "name", got.TargetGroups[0].Name.ValueString())
+		assert.Nil(t, got.TargetGroups[0].HealthCheck)
+		assert.Len(t, got.TargetGroups[0].Members, 1)
+		assert.Equal(t, "target", got.TargetGroups[0].Members[0].ID.ValueString())
+	})
+
+	t.Run("configuration is not set", func(t *testing.T) {
+		got := adaptLoadBalancerConfig(publiccloud.LoadBalancerDetails{Id: "id"}, nil, nil)
+
+		assert.True(t, got.BalancingAlgorithm.IsNull())
+		assert.True(t, got.XForwardedFor.IsNull())
+		assert.True(t, got.IdleTimeout.IsNull())
+		assert.Nil(t, got.StickySession)
+		assert.Empty(t, got.Listeners)
+		assert.Empty(t, got.TargetGroups)
+	})
+}
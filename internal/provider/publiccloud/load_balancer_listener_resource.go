@@ -2,13 +2,11 @@ package publiccloud
 
 import (
 	"context"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -139,7 +137,7 @@ func (l *loadBalancerListenerResource) Schema(
 			},
 			"certificate": schema.SingleNestedAttribute{
 				Optional:    true,
-				Description: "Required only if protocol is HTTPS",
+				Description: "Required only if protocol is HTTPS. Changing the certificate replaces it on the listener in place, the listener is not recreated.",
 				Attributes: map[string]schema.Attribute{
 					"private_key": schema.StringAttribute{
 						Optional:    true,
@@ -159,11 +157,12 @@ func (l *loadBalancerListenerResource) Schema(
 				},
 			},
 			"default_rule": schema.SingleNestedAttribute{
-				Required: true,
+				Required:    true,
+				Description: "The rule applied to requests that match no other rule",
 				Attributes: map[string]schema.Attribute{
 					"target_group_id": schema.StringAttribute{
 						Optional:    true,
-						Description: "Client Private Key. Required only if protocol is `HTTPS`",
+						Description: "The target group requests are forwarded to",
 					},
 				},
 			},
@@ -309,6 +308,9 @@ func (l *loadBalancerListenerResource) Read(
 	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
 }
 
+// Update sends the certificate along with the other listener options, the API
+// swaps it on the running listener so rotating a certificate causes no
+// downtime.
 func (l *loadBalancerListenerResource) Update(
 	ctx context.Context,
 	request resource.UpdateRequest,
@@ -411,27 +413,12 @@ func (l *loadBalancerListenerResource) ImportState(
 	request resource.ImportStateRequest,
 	response *resource.ImportStateResponse,
 ) {
-	idParts := strings.Split(request.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		utils.UnexpectedImportIdentifierError(
-			&response.Diagnostics,
-			"load_balancer_id,listener_id",
-			request.ID,
-		)
-		return
-	}
-
-	response.Diagnostics.Append(response.State.SetAttribute(
+	utils.ImportCompositeID(
 		ctx,
-		path.Root("load_balancer_id"),
-		idParts[0],
-	)...)
-	response.Diagnostics.Append(response.State.SetAttribute(
-		ctx,
-		path.Root("listener_id"),
-		idParts[1],
-	)...)
+		[]string{"load_balancer_id", "listener_id"},
+		request,
+		response,
+	)
 }
 
 func NewLoadBalancerListenerResource() resource.Resource {
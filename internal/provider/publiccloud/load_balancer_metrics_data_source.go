@@ -0,0 +1,231 @@
+package publiccloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure      = &loadBalancerMetricsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &loadBalancerMetricsDataSource{}
+)
+
+type metricValueDataSourceModel struct {
+	Timestamp types.String  `tfsdk:"timestamp"`
+	Value     types.Float64 `tfsdk:"value"`
+}
+
+type loadBalancerMetricsDataSourceModel struct {
+	LoadBalancerID types.String `tfsdk:"load_balancer_id"`
+	From           types.String `tfsdk:"from"`
+	To             types.String `tfsdk:"to"`
+	Granularity    types.String `tfsdk:"granularity"`
+
+	Requests     []metricValueDataSourceModel `tfsdk:"requests"`
+	Connections  []metricValueDataSourceModel `tfsdk:"connections"`
+	Responses2xx []metricValueDataSourceModel `tfsdk:"responses_2xx"`
+	Responses3xx []metricValueDataSourceModel `tfsdk:"responses_3xx"`
+	Responses4xx []metricValueDataSourceModel `tfsdk:"responses_4xx"`
+	Responses5xx []metricValueDataSourceModel `tfsdk:"responses_5xx"`
+}
+
+// adaptMetricsPropertiesToMetricValuesDataSource returns an empty list when
+// the API has no values for the requested range.
+func adaptMetricsPropertiesToMetricValuesDataSource(
+	metrics *publiccloud.MetricsProperties,
+) []metricValueDataSourceModel {
+	values := []metricValueDataSourceModel{}
+
+	for _, metricValue := range metrics.GetValues() {
+		value := basetypes.NewFloat64Null()
+		if metricValue.Value != nil {
+			value = basetypes.NewFloat64Value(float64(metricValue.GetValue()))
+		}
+
+		values = append(values, metricValueDataSourceModel{
+			Timestamp: utils.AdaptNullableTimeToStringValue(metricValue.Timestamp),
+			Value:     value,
+		})
+	}
+
+	return values
+}
+
+type loadBalancerMetricsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (l *loadBalancerMetricsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	valuesAttribute := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			Computed:    true,
+			Description: description,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"timestamp": schema.StringAttribute{
+						Computed: true,
+					},
+					"value": schema.Float64Attribute{
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"load_balancer_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Load balancer ID",
+			},
+			"from": schema.StringAttribute{
+				Optional:    true,
+				Description: "The start of the interval, as an RFC 3339 timestamp",
+				Validators: []validator.String{
+					utils.TimestampValidator(),
+				},
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "The end of the interval, as an RFC 3339 timestamp",
+				Validators: []validator.String{
+					utils.TimestampValidator(),
+				},
+			},
+			"granularity": schema.StringAttribute{
+				Optional: true,
+				Description: "The interval of each value. Valid options are " + utils.StringTypeArrayToMarkdown(
+					publiccloud.AllowedLoadBalancerMetricsGranularityEnumValues,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedLoadBalancerMetricsGranularityEnumValues)...),
+				},
+			},
+			"requests":      valuesAttribute("Number of requests"),
+			"connections":   valuesAttribute("Number of active connections"),
+			"responses_2xx": valuesAttribute("Number of 2xx responses"),
+			"responses_3xx": valuesAttribute("Number of 3xx responses"),
+			"responses_4xx": valuesAttribute("Number of 4xx responses"),
+			"responses_5xx": valuesAttribute("Number of 5xx responses"),
+		},
+	}
+}
+
+func (l *loadBalancerMetricsDataSource) ValidateConfig(
+	ctx context.Context,
+	request datasource.ValidateConfigRequest,
+	response *datasource.ValidateConfigResponse,
+) {
+	var from, to types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("from"), &from)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("to"), &to)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if from.IsNull() || from.IsUnknown() || to.IsNull() || to.IsUnknown() {
+		return
+	}
+
+	fromTime, err := time.Parse(time.RFC3339, from.ValueString())
+	if err != nil {
+		return
+	}
+	toTime, err := time.Parse(time.RFC3339, to.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !fromTime.Before(toTime) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("to"),
+			"Invalid Interval",
+			"to must be later than from.",
+		)
+	}
+}
+
+func (l *loadBalancerMetricsDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config loadBalancerMetricsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	loadBalancerID := config.LoadBalancerID.ValueString()
+
+	requestsRequest := l.PubliccloudAPI.GetRequestsMetrics(ctx, loadBalancerID)
+	connectionsRequest := l.PubliccloudAPI.GetConnectionsMetrics(ctx, loadBalancerID)
+	responseCodesRequest := l.PubliccloudAPI.GetResponseCodesMetrics(ctx, loadBalancerID)
+	if !config.From.IsNull() {
+		requestsRequest = requestsRequest.From(config.From.ValueString())
+		connectionsRequest = connectionsRequest.From(config.From.ValueString())
+		responseCodesRequest = responseCodesRequest.From(config.From.ValueString())
+	}
+	if !config.To.IsNull() {
+		requestsRequest = requestsRequest.To(config.To.ValueString())
+		connectionsRequest = connectionsRequest.To(config.To.ValueString())
+		responseCodesRequest = responseCodesRequest.To(config.To.ValueString())
+	}
+	if !config.Granularity.IsNull() {
+		requestsRequest = requestsRequest.Granularity(config.Granularity.ValueString())
+		connectionsRequest = connectionsRequest.Granularity(config.Granularity.ValueString())
+		responseCodesRequest = responseCodesRequest.Granularity(config.Granularity.ValueString())
+	}
+
+	requests, httpResponse, err := requestsRequest.Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	connections, httpResponse, err := connectionsRequest.Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	responseCodes, httpResponse, err := responseCodesRequest.Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+	responseCodeMetrics := responseCodes.GetMetrics()
+
+	config.Requests = adaptMetricsPropertiesToMetricValuesDataSource(requests.Metrics)
+	config.Connections = adaptMetricsPropertiesToMetricValuesDataSource(connections.Metrics)
+	config.Responses2xx = adaptMetricsPropertiesToMetricValuesDataSource(responseCodeMetrics.Var2xx)
+	config.Responses3xx = adaptMetricsPropertiesToMetricValuesDataSource(responseCodeMetrics.Var3xx)
+	config.Responses4xx = adaptMetricsPropertiesToMetricValuesDataSource(responseCodeMetrics.Var4xx)
+	config.Responses5xx = adaptMetricsPropertiesToMetricValuesDataSource(responseCodeMetrics.Var5xx)
+
+	response.Diagnostics.Append(response.State.Set(ctx, config)...)
+}
+
+func NewLoadBalancerMetricsDataSource() datasource.DataSource {
+	return &loadBalancerMetricsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_load_balancer_metrics",
+		},
+	}
+}
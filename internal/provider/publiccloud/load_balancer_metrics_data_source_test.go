@@ -0,0 +1,36 @@
+package publiccloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptMetricsPropertiesToMetricValuesDataSource(t *testing.T) {
+	t.Run("adapts the values", func(t *testing.T) {
+		value := float32(12)
+		timestamp, _ := time.Parse(time.RFC3339, "2024-01-01T00:00:00Z")
+		metrics := publiccloud.MetricsProperties{
+			Values: []publiccloud.MetricsValues{
+				{Value: &value, Timestamp: &timestamp},
+				{Timestamp: &timestamp},
+			},
+		}
+
+		got := adaptMetricsPropertiesToMetricValuesDataSource(&metrics)
+
+		assert.Len(t, got, 2)
+		assert.Equal(t, "2024-01-01 00:00:00 +0000 UTC", got[0].Timestamp.ValueString())
+		assert.Equal(t, float64(12), got[0].Value.ValueFloat64())
+		assert.True(t, got[1].Value.IsNull())
+	})
+
+	t.Run("returns an empty list for an empty range", func(t *testing.T) {
+		got := adaptMetricsPropertiesToMetricValuesDataSource(nil)
+
+		assert.NotNil(t, got)
+		assert.Empty(t, got)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -11,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -21,8 +25,9 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &loadBalancerResource{}
-	_ resource.ResourceWithImportState = &loadBalancerResource{}
+	_ resource.ResourceWithConfigure      = &loadBalancerResource{}
+	_ resource.ResourceWithImportState    = &loadBalancerResource{}
+	_ resource.ResourceWithValidateConfig = &loadBalancerResource{}
 )
 
 type loadBalancerIPResourceModel struct {
@@ -38,6 +43,81 @@ type loadBalancerResourceModel struct {
 	Reference types.String `tfsdk:"reference"`
 	Contract  types.Object `tfsdk:"contract"`
 	IPs       types.List   `tfsdk:"ips"`
+
+	BalancingAlgorithm types.String `tfsdk:"balancing_algorithm"`
+	XForwardedFor      types.Bool   `tfsdk:"x_forwarded_for"`
+	IdleTimeout        types.Int32  `tfsdk:"idle_timeout"`
+	StickySession      types.Object `tfsdk:"sticky_session"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+type stickySessionResourceModel struct {
+	Enabled     types.Bool  `tfsdk:"enabled"`
+	MaxLifetime types.Int32 `tfsdk:"max_lifetime"`
+}
+
+func (s stickySessionResourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":      types.BoolType,
+		"max_lifetime": types.Int32Type,
+	}
+}
+
+// adaptStickySessionToStickySessionResource treats a missing sticky session
+// as a disabled one, the lifetime is only kept while it is enabled.
+func adaptStickySessionToStickySessionResource(
+	sdkStickySession *publiccloud.StickySession,
+) stickySessionResourceModel {
+	stickySession := stickySessionResourceModel{
+		Enabled:     basetypes.NewBoolValue(false),
+		MaxLifetime: basetypes.NewInt32Null(),
+	}
+	if sdkStickySession != nil && sdkStickySession.GetEnabled() {
+		stickySession.Enabled = basetypes.NewBoolValue(true)
+		stickySession.MaxLifetime = basetypes.NewInt32Value(sdkStickySession.GetMaxLifeTime())
+	}
+
+	return stickySession
+}
+
+// configurationOpts returns the options to update the configuration with,
+// and whether any configuration is set in the plan at all.
+func (l loadBalancerResourceModel) configurationOpts(ctx context.Context) (
+	*publiccloud.UpdateLoadBalancerOpts,
+	bool,
+	diag.Diagnostics,
+) {
+	opts := publiccloud.NewUpdateLoadBalancerOpts()
+	configured := false
+	var diags diag.Diagnostics
+
+	if !l.BalancingAlgorithm.IsUnknown() && !l.BalancingAlgorithm.IsNull() {
+		opts.SetBalance(publiccloud.Balance(l.BalancingAlgorithm.ValueString()))
+		configured = true
+	}
+	if !l.XForwardedFor.IsUnknown() && !l.XForwardedFor.IsNull() {
+		opts.SetXForwardedFor(l.XForwardedFor.ValueBool())
+		configured = true
+	}
+	if !l.IdleTimeout.IsUnknown() && !l.IdleTimeout.IsNull() {
+		opts.SetIdleTimeOut(l.IdleTimeout.ValueInt32())
+		configured = true
+	}
+	if !l.StickySession.IsUnknown() && !l.StickySession.IsNull() {
+		stickySession := stickySessionResourceModel{}
+		diags = l.StickySession.As(ctx, &stickySession, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return opts, configured, diags
+		}
+		opts.SetStickySession(*publiccloud.NewStickySession(
+			stickySession.Enabled.ValueBool(),
+			stickySession.MaxLifetime.ValueInt32(),
+		))
+		configured = true
+	}
+
+	return opts, configured, diags
 }
 
 func adaptLoadBalancerDetailsToLoadBalancerResource(
@@ -50,6 +130,31 @@ func adaptLoadBalancerDetailsToLoadBalancerResource(
 		Region:    basetypes.NewStringValue(string(loadBalancerDetails.GetRegion())),
 		Type:      basetypes.NewStringValue(string(loadBalancerDetails.GetType())),
 		Reference: basetypes.NewStringPointerValue(loadBalancerDetails.Reference.Get()),
+
+		BalancingAlgorithm: basetypes.NewStringNull(),
+		XForwardedFor:      basetypes.NewBoolNull(),
+		IdleTimeout:        basetypes.NewInt32Null(),
+		StickySession:      basetypes.NewObjectNull(stickySessionResourceModel{}.attributeTypes()),
+
+		Timeouts: utils.NewTimeoutsNull(),
+	}
+
+	if configuration := loadBalancerDetails.Configuration.Get(); configuration != nil {
+		loadBalancer.BalancingAlgorithm = basetypes.NewStringValue(string(configuration.GetBalance()))
+		loadBalancer.XForwardedFor = basetypes.NewBoolValue(configuration.GetXForwardedFor())
+		loadBalancer.IdleTimeout = basetypes.NewInt32Value(configuration.GetIdleTimeOut())
+
+		stickySession := utils.AdaptSdkModelToResourceObject(
+			configuration.StickySession.Get(),
+			stickySessionResourceModel{}.attributeTypes(),
+			ctx,
+			adaptStickySessionToStickySessionResource,
+			diags,
+		)
+		if diags.HasError() {
+			return nil
+		}
+		loadBalancer.StickySession = stickySession
 	}
 
 	contract := utils.AdaptSdkModelToResourceObject(
@@ -104,7 +209,7 @@ func (l *loadBalancerResource) ImportState(
 }
 
 func (l *loadBalancerResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	response *resource.SchemaResponse,
 ) {
@@ -206,10 +311,106 @@ func (l *loadBalancerResource) Schema(
 					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedTypeNameEnumValues)...),
 				},
 			},
+			"balancing_algorithm": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The algorithm used to distribute requests over the targets. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedBalanceEnumValues),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedBalanceEnumValues)...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"x_forwarded_for": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the load balancer adds the `X-Forwarded-For` header to requests forwarded to the targets.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idle_timeout": schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How long an idle connection is kept open (in seconds).",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"sticky_session": schema.SingleNestedAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Session affinity, which sends all requests of a client to the same target. It applies to all listeners of the load balancer.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether requests of a client stick to the same target",
+					},
+					"max_lifetime": schema.Int32Attribute{
+						Optional:    true,
+						Description: "How long a client sticks to the same target (in seconds). Required if `enabled` is true, and can only be set then.",
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				Update:            true,
+				Delete:            true,
+				CreateDescription: "How long launching and configuring the load balancer may take. Unbounded by default.",
+				UpdateDescription: "How long updating the load balancer may take. Unbounded by default.",
+				DeleteDescription: "How long terminating the load balancer may take. Unbounded by default.",
+			}),
 		},
 	}
 }
 
+// ValidateConfig ensures that max_lifetime is set exactly when the sticky
+// session is enabled.
+func (l *loadBalancerResource) ValidateConfig(
+	ctx context.Context,
+	request resource.ValidateConfigRequest,
+	response *resource.ValidateConfigResponse,
+) {
+	var enabled types.Bool
+	var maxLifetime types.Int32
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("sticky_session").AtName("enabled"), &enabled)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("sticky_session").AtName("max_lifetime"), &maxLifetime)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if enabled.IsNull() || enabled.IsUnknown() || maxLifetime.IsUnknown() {
+		return
+	}
+
+	attributePath := path.Root("sticky_session").AtName("max_lifetime")
+	if enabled.ValueBool() && maxLifetime.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			attributePath,
+			"Missing Attribute Configuration",
+			"sticky_session.max_lifetime must be set if sticky_session.enabled is true.",
+		)
+	}
+	if !enabled.ValueBool() && !maxLifetime.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Combination",
+			"sticky_session.max_lifetime can only be set if sticky_session.enabled is true.",
+		)
+	}
+}
+
 func (l *loadBalancerResource) Create(
 	ctx context.Context,
 	request resource.CreateRequest,
@@ -227,6 +428,14 @@ func (l *loadBalancerResource) Create(
 		response.Diagnostics.Append(contractDiags...)
 		return
 	}
+	timeout, diags := plan.Timeouts.Create(ctx, 0)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	opts := publiccloud.NewLaunchLoadBalancerOpts(
 		publiccloud.RegionName(plan.Region.ValueString()),
 		publiccloud.TypeName(plan.Type.ValueString()),
@@ -245,6 +454,23 @@ func (l *loadBalancerResource) Create(
 		return
 	}
 
+	// The configuration cannot be passed on launch, it is set right after.
+	updateOpts, configured, diags := plan.configurationOpts(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if configured {
+		loadBalancer, httpResponse, err = l.PubliccloudAPI.
+			UpdateLoadBalancer(ctx, loadBalancer.GetId()).
+			UpdateLoadBalancerOpts(*updateOpts).
+			Execute()
+		if err != nil {
+			utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+			return
+		}
+	}
+
 	state := adaptLoadBalancerDetailsToLoadBalancerResource(
 		*loadBalancer,
 		ctx,
@@ -253,6 +479,7 @@ func (l *loadBalancerResource) Create(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	state.Timeouts = plan.Timeouts
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -284,6 +511,7 @@ func (l *loadBalancerResource) Read(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	newState.Timeouts = state.Timeouts
 
 	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
 }
@@ -299,7 +527,19 @@ func (l *loadBalancerResource) Update(
 		return
 	}
 
-	opts := publiccloud.NewUpdateLoadBalancerOpts()
+	timeout, diags := plan.Timeouts.Update(ctx, 0)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	opts, _, diags := plan.configurationOpts(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 	opts.Reference = utils.AdaptStringPointerValueToNullableString(plan.Reference)
 	if plan.Type.ValueString() != "" {
 		opts.SetType(publiccloud.TypeName(plan.Type.ValueString()))
@@ -321,6 +561,7 @@ func (l *loadBalancerResource) Update(
 	if response.Diagnostics.HasError() {
 		return
 	}
+	state.Timeouts = plan.Timeouts
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -336,6 +577,14 @@ func (l *loadBalancerResource) Delete(
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, 0)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	opts := publiccloud.NewTerminateLoadBalancerOpts("CANCEL_OTHER")
 	opts.SetReason("Terraform")
 
@@ -5,9 +5,13 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_adaptLoadBalancerDetailsToLoadBalancerResource(t *testing.T) {
@@ -35,6 +39,8 @@ func Test_adaptLoadBalancerDetailsToLoadBalancerResource(t *testing.T) {
 		assert.Equal(t, "region", got.Region.ValueString())
 		assert.Equal(t, "lsw.c3.2xlarge", got.Type.ValueString())
 		assert.Nil(t, got.Reference.ValueStringPointer())
+		assert.True(t, got.BalancingAlgorithm.IsNull())
+		assert.True(t, got.XForwardedFor.IsNull())
 
 		contract := contractResourceModel{}
 		got.Contract.As(context.TODO(), &contract, basetypes.ObjectAsOptions{})
@@ -65,6 +71,166 @@ func Test_adaptLoadBalancerDetailsToLoadBalancerResource(t *testing.T) {
 		assert.False(t, diags.HasError())
 		assert.Equal(t, "reference", got.Reference.ValueString())
 	})
+
+	t.Run("configuration is set", func(t *testing.T) {
+		loadBalancerDetails := publiccloud.LoadBalancerDetails{
+			Id:     "id",
+			Region: "region",
+			Type:   publiccloud.TYPENAME_C3_2XLARGE,
+			Configuration: *publiccloud.NewNullableLoadBalancerConfiguration(
+				&publiccloud.LoadBalancerConfiguration{
+					Balance:       publiccloud.BALANCE_LEASTCONN,
+					XForwardedFor: true,
+					IdleTimeOut:   60,
+				},
+			),
+			Contract: publiccloud.InstanceContract{
+				Type: publiccloud.CONTRACTTYPE_MONTHLY,
+			},
+		}
+
+		diags := diag.Diagnostics{}
+
+		got := adaptLoadBalancerDetailsToLoadBalancerResource(
+			loadBalancerDetails,
+			context.TODO(),
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "leastconn", got.BalancingAlgorithm.ValueString())
+		assert.True(t, got.XForwardedFor.ValueBool())
+		assert.Equal(t, int32(60), got.IdleTimeout.ValueInt32())
+
+		stickySession := stickySessionResourceModel{}
+		got.StickySession.As(context.TODO(), &stickySession, basetypes.ObjectAsOptions{})
+		assert.False(t, stickySession.Enabled.ValueBool())
+		assert.True(t, stickySession.MaxLifetime.IsNull())
+	})
+
+	t.Run("sticky session is set", func(t *testing.T) {
+		loadBalancerDetails := publiccloud.LoadBalancerDetails{
+			Id:     "id",
+			Region: "region",
+			Type:   publiccloud.TYPENAME_C3_2XLARGE,
+			Configuration: *publiccloud.NewNullableLoadBalancerConfiguration(
+				&publiccloud.LoadBalancerConfiguration{
+					StickySession: *publiccloud.NewNullableStickySession(
+						publiccloud.NewStickySession(true, 1000),
+					),
+					Balance: publiccloud.BALANCE_ROUNDROBIN,
+				},
+			),
+			Contract: publiccloud.InstanceContract{
+				Type: publiccloud.CONTRACTTYPE_MONTHLY,
+			},
+		}
+
+		diags := diag.Diagnostics{}
+
+		got := adaptLoadBalancerDetailsToLoadBalancerResource(
+			loadBalancerDetails,
+			context.TODO(),
+			&diags,
+		)
+
+		assert.False(t, diags.HasError())
+
+		stickySession := stickySessionResourceModel{}
+		got.StickySession.As(context.TODO(), &stickySession, basetypes.ObjectAsOptions{})
+		assert.True(t, stickySession.Enabled.ValueBool())
+		assert.Equal(t, int32(1000), stickySession.MaxLifetime.ValueInt32())
+	})
+}
+
+func Test_adaptStickySessionToStickySessionResource(t *testing.T) {
+	t.Run("a missing sticky session is disabled", func(t *testing.T) {
+		got := adaptStickySessionToStickySessionResource(nil)
+
+		assert.False(t, got.Enabled.ValueBool())
+		assert.True(t, got.MaxLifetime.IsNull())
+	})
+
+	t.Run("the lifetime of a disabled sticky session is dropped", func(t *testing.T) {
+		got := adaptStickySessionToStickySessionResource(
+			publiccloud.NewStickySession(false, 0),
+		)
+
+		assert.False(t, got.Enabled.ValueBool())
+		assert.True(t, got.MaxLifetime.IsNull())
+	})
+}
+
+func Test_loadBalancerResourceModel_configurationOpts(t *testing.T) {
+	t.Run("nothing is configured if the configuration is not set", func(t *testing.T) {
+		model := loadBalancerResourceModel{
+			BalancingAlgorithm: basetypes.NewStringUnknown(),
+			XForwardedFor:      basetypes.NewBoolNull(),
+		}
+
+		got, configured, diags := model.configurationOpts(context.TODO())
+
+		assert.False(t, diags.HasError())
+
+		assert.False(t, configured)
+		assert.False(t, got.HasBalance())
+		assert.False(t, got.HasXForwardedFor())
+		assert.False(t, got.HasIdleTimeOut())
+	})
+
+	t.Run("x_forwarded_for is sent when set", func(t *testing.T) {
+		model := loadBalancerResourceModel{
+			BalancingAlgorithm: basetypes.NewStringNull(),
+			XForwardedFor:      basetypes.NewBoolValue(false),
+		}
+
+		got, configured, diags := model.configurationOpts(context.TODO())
+
+		assert.False(t, diags.HasError())
+
+		assert.True(t, configured)
+		assert.False(t, got.HasBalance())
+		assert.False(t, got.GetXForwardedFor())
+		assert.True(t, got.HasXForwardedFor())
+	})
+
+	t.Run("idle_timeout is sent when set", func(t *testing.T) {
+		model := loadBalancerResourceModel{
+			BalancingAlgorithm: basetypes.NewStringNull(),
+			XForwardedFor:      basetypes.NewBoolNull(),
+			IdleTimeout:        basetypes.NewInt32Value(120),
+		}
+
+		got, configured, diags := model.configurationOpts(context.TODO())
+
+		assert.False(t, diags.HasError())
+
+		assert.True(t, configured)
+		assert.Equal(t, int32(120), got.GetIdleTimeOut())
+	})
+
+	t.Run("sticky_session is sent when set", func(t *testing.T) {
+		stickySession, _ := basetypes.NewObjectValueFrom(
+			context.TODO(),
+			stickySessionResourceModel{}.attributeTypes(),
+			stickySessionResourceModel{
+				Enabled:     basetypes.NewBoolValue(true),
+				MaxLifetime: basetypes.NewInt32Value(300),
+			},
+		)
+		model := loadBalancerResourceModel{
+			BalancingAlgorithm: basetypes.NewStringNull(),
+			XForwardedFor:      basetypes.NewBoolNull(),
+			StickySession:      stickySession,
+		}
+
+		got, configured, diags := model.configurationOpts(context.TODO())
+
+		assert.False(t, diags.HasError())
+		assert.True(t, configured)
+		assert.True(t, got.StickySession.Get().GetEnabled())
+		assert.Equal(t, int32(300), got.StickySession.Get().GetMaxLifeTime())
+	})
 }
 
 func Test_adaptIpDetailsToLoadBalancerIPResource(t *testing.T) {
@@ -99,3 +265,49 @@ func Test_adaptIpDetailsToLoadBalancerIPResource(t *testing.T) {
 		assert.Equal(t, want, got)
 	})
 }
+
+// Load balancers only gained attributes since they were added, which
+// Terraform reads as null from older state, so their schema has not been
+// versioned.
+func TestLoadBalancerResource_stateWithoutSettings(t *testing.T) {
+	ctx := context.TODO()
+	stateWithoutSettings := `{
+  "id": "32082a4d-8b96-4b1d-a4d1-ae0b8bfc1b87",
+  "region": "eu-west-3",
+  "type": "lsw.m3.large",
+  "reference": "my loadbalancer",
+  "contract": {
+    "billing_frequency": 1,
+    "term": 0,
+    "type": "HOURLY",
+    "ends_at": null,
+    "state": "ACTIVE"
+  },
+  "ips": [
+    {"ip": "10.32.60.12", "load_balancer_id": null, "reverse_lookup": null}
+  ]
+}`
+
+	schemaResponse := resource.SchemaResponse{}
+	(&loadBalancerResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	require.Equal(t, int64(0), schemaResponse.Schema.Version)
+
+	value, err := tftypes.ValueFromJSONWithOpts(
+		[]byte(stateWithoutSettings),
+		schemaResponse.Schema.Type().TerraformType(ctx),
+		tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	)
+	require.NoError(t, err)
+
+	state := tfsdk.State{Raw: value, Schema: schemaResponse.Schema}
+	got := loadBalancerResourceModel{}
+	diags := state.Get(ctx, &got)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "my loadbalancer", got.Reference.ValueString())
+	assert.True(t, got.BalancingAlgorithm.IsNull())
+	assert.True(t, got.XForwardedFor.IsNull())
+	assert.True(t, got.IdleTimeout.IsNull())
+	assert.True(t, got.StickySession.IsNull())
+	assert.True(t, got.Timeouts.IsNull())
+}
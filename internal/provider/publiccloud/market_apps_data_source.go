@@ -0,0 +1,156 @@
+package publiccloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &marketAppsDataSource{}
+)
+
+type marketAppDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Category types.String `tfsdk:"category"`
+	Version  types.String `tfsdk:"version"`
+	Family   types.String `tfsdk:"family"`
+	ImageID  types.String `tfsdk:"image_id"`
+}
+
+type marketAppsDataSourceModel struct {
+	Category types.String `tfsdk:"category"`
+	Name     types.String `tfsdk:"name"`
+
+	MarketApps []marketAppDataSourceModel `tfsdk:"market_apps"`
+}
+
+// matches reports whether the Market App passes the configured filters.
+func (m marketAppsDataSourceModel) matches(marketApp publiccloud.MarketApp) bool {
+	if !m.Category.IsNull() && marketApp.GetCategory() != m.Category.ValueString() {
+		return false
+	}
+
+	if !m.Name.IsNull() && !strings.Contains(
+		strings.ToLower(marketApp.GetName()),
+		strings.ToLower(m.Name.ValueString()),
+	) {
+		return false
+	}
+
+	return true
+}
+
+func adaptMarketAppToMarketAppDataSource(marketApp publiccloud.MarketApp) marketAppDataSourceModel {
+	image := marketApp.GetImage()
+
+	return marketAppDataSourceModel{
+		ID:       basetypes.NewStringValue(marketApp.GetId()),
+		Name:     basetypes.NewStringValue(marketApp.GetName()),
+		Category: basetypes.NewStringValue(marketApp.GetCategory()),
+		Version:  basetypes.NewStringPointerValue(marketApp.Version.Get()),
+		Family:   basetypes.NewStringValue(marketApp.GetFamily()),
+		ImageID:  basetypes.NewStringValue(image.GetId()),
+	}
+}
+
+type marketAppsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (m *marketAppsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"category": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only Market Apps in this category",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Return only Market Apps whose name contains this value, ignoring case",
+			},
+			"market_apps": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Market App ID. Can be used as the `market_app_id` of an instance.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Market App",
+						},
+						"category": schema.StringAttribute{
+							Computed:    true,
+							Description: "The category of the Market App",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The version of the Market App",
+						},
+						"family": schema.StringAttribute{
+							Computed:    true,
+							Description: "The family of the Market App",
+						},
+						"image_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The image the Market App is installed on. Instances using the Market App must be launched with this image.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (m *marketAppsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var marketApps marketAppsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &marketApps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API returns all Market Apps at once and does not support filters.
+	result, httpResponse, err := m.PubliccloudAPI.GetMarketAppList(ctx).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+		return
+	}
+
+	marketApps.MarketApps = []marketAppDataSourceModel{}
+	for _, marketApp := range result.GetMarketApps() {
+		if !marketApps.matches(marketApp) {
+			continue
+		}
+		marketApps.MarketApps = append(
+			marketApps.MarketApps,
+			adaptMarketAppToMarketAppDataSource(marketApp),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, marketApps)...)
+}
+
+func NewMarketAppsDataSource() datasource.DataSource {
+	return &marketAppsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_market_apps",
+		},
+	}
+}
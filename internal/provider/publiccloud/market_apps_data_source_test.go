@@ -0,0 +1,77 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptMarketAppToMarketAppDataSource(t *testing.T) {
+	id := "CPANEL_30"
+	name := "cPanel 30"
+	category := "PANEL"
+	family := "linux"
+	version := "118"
+	sdkMarketApp := publiccloud.MarketApp{
+		Id:       &id,
+		Name:     &name,
+		Category: &category,
+		Version:  *publiccloud.NewNullableString(&version),
+		Family:   &family,
+		Image:    &publiccloud.Image{Id: "ALMALINUX_8_64BIT"},
+	}
+
+	got := adaptMarketAppToMarketAppDataSource(sdkMarketApp)
+
+	assert.Equal(t, "CPANEL_30", got.ID.ValueString())
+	assert.Equal(t, "cPanel 30", got.Name.ValueString())
+	assert.Equal(t, "PANEL", got.Category.ValueString())
+	assert.Equal(t, "118", got.Version.ValueString())
+	assert.Equal(t, "linux", got.Family.ValueString())
+	assert.Equal(t, "ALMALINUX_8_64BIT", got.ImageID.ValueString())
+}
+
+func Test_adaptMarketAppToMarketAppDataSource_withoutVersion(t *testing.T) {
+	got := adaptMarketAppToMarketAppDataSource(publiccloud.MarketApp{})
+
+	assert.True(t, got.Version.IsNull())
+}
+
+func Test_marketAppsDataSourceModel_matches(t *testing.T) {
+	name := "cPanel 30"
+	category := "PANEL"
+	marketApp := publiccloud.MarketApp{Name: &name, Category: &category}
+
+	t.Run("matches without filters", func(t *testing.T) {
+		filters := marketAppsDataSourceModel{
+			Category: basetypes.NewStringNull(),
+			Name:     basetypes.NewStringNull(),
+		}
+
+		assert.True(t, filters.matches(marketApp))
+	})
+
+	t.Run("filters by category", func(t *testing.T) {
+		filters := marketAppsDataSourceModel{
+			Category: basetypes.NewStringValue("PANEL"),
+			Name:     basetypes.NewStringNull(),
+		}
+		assert.True(t, filters.matches(marketApp))
+
+		filters.Category = basetypes.NewStringValue("DATABASE")
+		assert.False(t, filters.matches(marketApp))
+	})
+
+	t.Run("filters by part of the name ignoring case", func(t *testing.T) {
+		filters := marketAppsDataSourceModel{
+			Category: basetypes.NewStringNull(),
+			Name:     basetypes.NewStringValue("cpanel"),
+		}
+		assert.True(t, filters.matches(marketApp))
+
+		filters.Name = basetypes.NewStringValue("plesk")
+		assert.False(t, filters.matches(marketApp))
+	})
+}
@@ -0,0 +1,278 @@
+package publiccloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/client"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &notificationSettingResource{}
+	_ resource.ResourceWithImportState = &notificationSettingResource{}
+)
+
+type notificationSettingResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	Frequency  types.String `tfsdk:"frequency"`
+	Threshold  types.Int32  `tfsdk:"threshold"`
+	Unit       types.String `tfsdk:"unit"`
+	Action     types.String `tfsdk:"action"`
+}
+
+func (n notificationSettingResourceModel) threshold() publiccloud.NotificationSettingThreshold {
+	return *publiccloud.NewNotificationSettingThreshold(
+		n.Threshold.ValueInt32(),
+		publiccloud.Unit(n.Unit.ValueString()),
+	)
+}
+
+func (n notificationSettingResourceModel) action() publiccloud.NullableAction {
+	if n.Action.IsNull() || n.Action.IsUnknown() {
+		return *publiccloud.NewNullableAction(nil)
+	}
+
+	action := publiccloud.Action(n.Action.ValueString())
+	return *publiccloud.NewNullableAction(&action)
+}
+
+func adaptNotificationSettingToNotificationSettingResource(
+	instanceID string,
+	notificationSetting publiccloud.NotificationSetting,
+) notificationSettingResourceModel {
+	threshold := notificationSetting.GetThreshold()
+
+	var action *string
+	if notificationSetting.Action.Get() != nil {
+		value := string(*notificationSetting.Action.Get())
+		action = &value
+	}
+
+	return notificationSettingResourceModel{
+		ID:         basetypes.NewStringValue(notificationSetting.GetId()),
+		InstanceID: basetypes.NewStringValue(instanceID),
+		Frequency:  basetypes.NewStringValue(string(notificationSetting.GetTimePeriod())),
+		Threshold:  basetypes.NewInt32Value(threshold.GetValue()),
+		Unit:       basetypes.NewStringValue(string(threshold.GetUnit())),
+		Action:     basetypes.NewStringPointerValue(action),
+	}
+}
+
+type notificationSettingResource struct {
+	utils.ResourceAPI
+}
+
+func (n *notificationSettingResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"instance_id", "id"},
+		request,
+		response,
+	)
+}
+
+func (n *notificationSettingResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription + " Manages a data traffic notification setting of a Public Cloud instance.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the notification setting.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the instance.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"frequency": schema.StringAttribute{
+				Required: true,
+				Description: "The period the data traffic is measured over. Valid options are " + utils.StringTypeArrayToMarkdown(
+					publiccloud.AllowedTimePeriodEnumValues,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedTimePeriodEnumValues)...),
+				},
+			},
+			"threshold": schema.Int32Attribute{
+				Required:    true,
+				Description: "The threshold of the notification.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"unit": schema.StringAttribute{
+				Required: true,
+				Description: "The unit of the threshold. Valid options are " + utils.StringTypeArrayToMarkdown(
+					publiccloud.AllowedUnitEnumValues,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedUnitEnumValues)...),
+				},
+			},
+			"action": schema.StringAttribute{
+				Optional: true,
+				Description: "The action taken when the threshold is reached. Valid options are " + utils.StringTypeArrayToMarkdown(
+					publiccloud.AllowedActionEnumValues,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedActionEnumValues)...),
+				},
+			},
+		},
+	}
+}
+
+func (n *notificationSettingResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	var plan notificationSettingResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	opts := publiccloud.NewCreateNotificationSettingOpts(
+		plan.threshold(),
+		publiccloud.TimePeriod(plan.Frequency.ValueString()),
+		plan.action(),
+		[]publiccloud.UpdateNotificationSettingOptsChannelsInner{},
+	)
+
+	// The ID is assigned by the API, the SDK still requires one to build the path.
+	notificationSetting, httpResponse, err := n.PubliccloudAPI.CreateNotificationSetting(
+		ctx,
+		plan.InstanceID.ValueString(),
+		"",
+	).CreateNotificationSettingOpts(*opts).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptNotificationSettingToNotificationSettingResource(
+		plan.InstanceID.ValueString(),
+		*notificationSetting,
+	)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (n *notificationSettingResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	var currentState notificationSettingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &currentState)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	notificationSetting, httpResponse, err := n.PubliccloudAPI.GetNotificationSetting(
+		ctx,
+		currentState.InstanceID.ValueString(),
+		currentState.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		// The setting was removed outside of Terraform.
+		if client.ClassifyResponse(httpResponse, err) == client.ErrorClassNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptNotificationSettingToNotificationSettingResource(
+		currentState.InstanceID.ValueString(),
+		*notificationSetting,
+	)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (n *notificationSettingResource) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	var plan notificationSettingResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	threshold := plan.threshold()
+	timePeriod := publiccloud.TimePeriod(plan.Frequency.ValueString())
+	opts := publiccloud.NewUpdateNotificationSettingOpts()
+	opts.Threshold = &threshold
+	opts.TimePeriod = &timePeriod
+	opts.Action = plan.action()
+
+	notificationSetting, httpResponse, err := n.PubliccloudAPI.UpdateNotificationSetting(
+		ctx,
+		plan.InstanceID.ValueString(),
+		plan.ID.ValueString(),
+	).UpdateNotificationSettingOpts(*opts).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := adaptNotificationSettingToNotificationSettingResource(
+		plan.InstanceID.ValueString(),
+		*notificationSetting,
+	)
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (n *notificationSettingResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	var state notificationSettingResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	httpResponse, err := n.PubliccloudAPI.DeleteNotificationSetting(
+		ctx,
+		state.InstanceID.ValueString(),
+		state.ID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+	}
+}
+
+func NewNotificationSettingResource() resource.Resource {
+	return &notificationSettingResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "public_cloud_notification_setting",
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptNotificationSettingToNotificationSettingResource(t *testing.T) {
+	t.Run("expected value is returned if action is set", func(t *testing.T) {
+		action := publiccloud.ACTION_POWER_OFF
+		sdkNotificationSetting := publiccloud.NotificationSetting{
+			Id: "id",
+			Threshold: publiccloud.NotificationSettingThreshold{
+				Value: 10,
+				Unit:  publiccloud.UNIT_GB,
+			},
+			TimePeriod: publiccloud.TIMEPERIOD_WEEK,
+			Action:     *publiccloud.NewNullableAction(&action),
+		}
+
+		got := adaptNotificationSettingToNotificationSettingResource(
+			"instanceId",
+			sdkNotificationSetting,
+		)
+
+		want := notificationSettingResourceModel{
+			ID:         basetypes.NewStringValue("id"),
+			InstanceID: basetypes.NewStringValue("instanceId"),
+			Frequency:  basetypes.NewStringValue("WEEK"),
+			Threshold:  basetypes.NewInt32Value(10),
+			Unit:       basetypes.NewStringValue("GB"),
+			Action:     basetypes.NewStringValue("POWER_OFF"),
+		}
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("action is null if it is not set", func(t *testing.T) {
+		sdkNotificationSetting := publiccloud.NotificationSetting{
+			Action: *publiccloud.NewNullableAction(nil),
+		}
+
+		got := adaptNotificationSettingToNotificationSettingResource(
+			"instanceId",
+			sdkNotificationSetting,
+		)
+
+		assert.True(t, got.Action.IsNull())
+	})
+}
+
+func Test_notificationSettingResourceModel_action(t *testing.T) {
+	t.Run("an explicit null is sent when action is not set", func(t *testing.T) {
+		model := notificationSettingResourceModel{
+			Action: basetypes.NewStringNull(),
+		}
+
+		got := model.action()
+
+		assert.True(t, got.IsSet())
+		assert.Nil(t, got.Get())
+	})
+
+	t.Run("action is sent when set", func(t *testing.T) {
+		model := notificationSettingResourceModel{
+			Action: basetypes.NewStringValue("POWER_OFF"),
+		}
+
+		got := model.action()
+
+		assert.Equal(t, publiccloud.ACTION_POWER_OFF, *got.Get())
+	})
+}
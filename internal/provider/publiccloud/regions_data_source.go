@@ -0,0 +1,125 @@
+package publiccloud
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &regionsDataSource{}
+)
+
+type regionDataSourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Location types.String `tfsdk:"location"`
+}
+
+type regionsDataSourceModel struct {
+	Regions []regionDataSourceModel `tfsdk:"regions"`
+}
+
+func adaptRegionToRegionDataSource(region publiccloud.Region) regionDataSourceModel {
+	return regionDataSourceModel{
+		Name:     basetypes.NewStringValue(string(region.GetName())),
+		Location: basetypes.NewStringValue(region.GetLocation()),
+	}
+}
+
+// listRegions fetches all regions.
+func listRegions(ctx context.Context, api publiccloud.PubliccloudAPI) (
+	[]publiccloud.Region,
+	*http.Response,
+	error,
+) {
+	regions := []publiccloud.Region{}
+	var offset *int32
+
+	request := api.GetRegionList(ctx)
+	for {
+		result, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		regions = append(regions, result.GetRegions()...)
+
+		metadata := result.GetMetadata()
+
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+
+		if offset == nil {
+			return regions, httpResponse, nil
+		}
+
+		request = request.Offset(*offset)
+	}
+}
+
+type regionsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (r *regionsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: "Lists the regions Public Cloud products can be launched in.",
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The region name. Can be used as the `region` of an instance.",
+						},
+						"location": schema.StringAttribute{
+							Computed:    true,
+							Description: "The city the region is located in",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *regionsDataSource) Read(
+	ctx context.Context,
+	_ datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	sdkRegions, httpResponse, err := listRegions(ctx, r.PubliccloudAPI)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+		return
+	}
+
+	regions := regionsDataSourceModel{Regions: []regionDataSourceModel{}}
+	for _, region := range sdkRegions {
+		regions.Regions = append(regions.Regions, adaptRegionToRegionDataSource(region))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, regions)...)
+}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &regionsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_regions",
+		},
+	}
+}
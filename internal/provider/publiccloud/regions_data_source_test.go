@@ -0,0 +1,20 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptRegionToRegionDataSource(t *testing.T) {
+	sdkRegion := publiccloud.Region{
+		Name:     "eu-west-3",
+		Location: "Amsterdam",
+	}
+
+	got := adaptRegionToRegionDataSource(sdkRegion)
+
+	assert.Equal(t, "eu-west-3", got.Name.ValueString())
+	assert.Equal(t, "Amsterdam", got.Location.ValueString())
+}
@@ -0,0 +1,306 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+// snapshotTimeout is how long creating a snapshot may take by default.
+const snapshotTimeout = 30 * time.Minute
+
+var (
+	_ resource.ResourceWithConfigure   = &snapshotResource{}
+	_ resource.ResourceWithImportState = &snapshotResource{}
+)
+
+type snapshotResourceModel struct {
+	InstanceID types.String   `tfsdk:"instance_id"`
+	SnapshotID types.String   `tfsdk:"snapshot_id"`
+	Name       types.String   `tfsdk:"name"`
+	State      types.String   `tfsdk:"state"`
+	CreatedAt  types.String   `tfsdk:"created_at"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func adaptSnapshotToSnapshotResource(
+	snapshot publiccloud.Snapshot,
+	instanceID types.String,
+) snapshotResourceModel {
+	return snapshotResourceModel{
+		InstanceID: instanceID,
+		SnapshotID: basetypes.NewStringValue(snapshot.GetId()),
+		Name:       basetypes.NewStringValue(snapshot.GetDisplayName()),
+		State:      basetypes.NewStringValue(snapshot.GetState()),
+		CreatedAt:  utils.AdaptNullableTimeToStringValue(snapshot.Created),
+		Timeouts:   utils.NewTimeoutsNull(),
+	}
+}
+
+// getSnapshotByName returns the snapshot of the instance with the given
+// name, or nil if the instance has none. The API does not return the id of
+// new snapshots, but it allows only one snapshot per instance.
+func getSnapshotByName(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	instanceID string,
+	name string,
+) (*publiccloud.Snapshot, *http.Response, error) {
+	snapshots, httpResponse, err := listSnapshots(ctx, api, instanceID)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.GetDisplayName() == name {
+			return &snapshot, httpResponse, nil
+		}
+	}
+
+	return nil, httpResponse, nil
+}
+
+// waitForSnapshot polls the snapshot with getSnapshot until it is ready. A
+// nil snapshot means it is not listed yet. The last snapshot seen is returned
+// along with any error, so callers can keep track of it.
+func waitForSnapshot(
+	ctx context.Context,
+	getSnapshot func(ctx context.Context) (*publiccloud.Snapshot, *http.Response, error),
+	interval time.Duration,
+	timeout time.Duration,
+) (*publiccloud.Snapshot, error) {
+	return utils.PollUntilDone(
+		ctx,
+		"snapshot",
+		getSnapshot,
+		func(snapshot publiccloud.Snapshot) (bool, error) {
+			switch snapshot.GetState() {
+			case "READY":
+				return true, nil
+			case "FAILED":
+				return false, fmt.Errorf("snapshot %s has failed", snapshot.GetId())
+			}
+			return false, nil
+		},
+		func(snapshot publiccloud.Snapshot) map[string]any {
+			return map[string]any{
+				"snapshot_id": snapshot.GetId(),
+				"state":       snapshot.GetState(),
+			}
+		},
+		interval,
+		timeout,
+	)
+}
+
+type snapshotResource struct {
+	utils.ResourceAPI
+}
+
+func (s *snapshotResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	warningError := "**WARNING!** Changing this value once running will cause this snapshot to be destroyed and a new one to be created."
+
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription + " Manages the snapshot of a Public Cloud instance. An instance can only have one snapshot and must be running to take it. Creating the snapshot waits until it is ready.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The instance to take the snapshot of.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Snapshot ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "A name to identify the snapshot.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date and time when the snapshot was created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create:            true,
+				Update:            true,
+				Delete:            true,
+				CreateDescription: "How long to wait for the snapshot to be ready. Defaults to \"30m\".",
+				UpdateDescription: "Unused, as snapshots are replaced instead of updated.",
+				DeleteDescription: "How long deleting the snapshot may take. Unbounded by default.",
+			}),
+		},
+	}
+}
+
+func (s *snapshotResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	var plan snapshotResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, snapshotTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := plan.InstanceID.ValueString()
+	name := plan.Name.ValueString()
+
+	httpResponse, err := s.PubliccloudAPI.CreateSnapshot(ctx, instanceID).
+		CreateSnapshotOpts(*publiccloud.NewCreateSnapshotOpts(name)).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	snapshot, err := waitForSnapshot(
+		ctx,
+		func(ctx context.Context) (*publiccloud.Snapshot, *http.Response, error) {
+			return getSnapshotByName(ctx, s.PubliccloudAPI, instanceID, name)
+		},
+		10*time.Second,
+		timeout,
+	)
+	// Keep track of a snapshot that did not become ready, so it is replaced
+	// by the next apply instead of blocking new snapshots of the instance.
+	if snapshot != nil {
+		state := adaptSnapshotToSnapshotResource(*snapshot, plan.InstanceID)
+		state.Timeouts = plan.Timeouts
+		response.Diagnostics.Append(response.State.Set(ctx, state)...)
+	}
+	if err != nil {
+		utils.ReportError(err.Error(), &response.Diagnostics)
+	}
+}
+
+func (s *snapshotResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	var state snapshotResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, httpResponse, err := s.PubliccloudAPI.GetSnapshot(
+		ctx,
+		state.InstanceID.ValueString(),
+		state.SnapshotID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	newState := adaptSnapshotToSnapshotResource(*snapshot, state.InstanceID)
+	newState.Timeouts = state.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, newState)...)
+}
+
+// Update only stores the timeouts, as every other configurable attribute
+// requires replacement.
+func (s *snapshotResource) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	var plan, state snapshotResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (s *snapshotResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	var state snapshotResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 0)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := utils.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	httpResponse, err := s.PubliccloudAPI.DeleteSnapshot(
+		ctx,
+		state.InstanceID.ValueString(),
+		state.SnapshotID.ValueString(),
+	).Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+	}
+}
+
+func (s *snapshotResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"instance_id", "snapshot_id"},
+		request,
+		response,
+	)
+}
+
+func NewSnapshotResource() resource.Resource {
+	return &snapshotResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "public_cloud_snapshot",
+		},
+	}
+}
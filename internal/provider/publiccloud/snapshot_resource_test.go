@@ -0,0 +1,84 @@
+package publiccloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSnapshot(state string) *publiccloud.Snapshot {
+	return &publiccloud.Snapshot{
+		Id:          publiccloud.PtrString("snapshotId"),
+		DisplayName: publiccloud.PtrString("name"),
+		State:       publiccloud.PtrString(state),
+	}
+}
+
+func Test_adaptSnapshotToSnapshotResource(t *testing.T) {
+	created := time.Date(2023, 11, 2, 7, 31, 28, 0, time.UTC)
+	snapshot := newTestSnapshot("READY")
+	snapshot.Created = &created
+
+	got := adaptSnapshotToSnapshotResource(
+		*snapshot,
+		basetypes.NewStringValue("instanceId"),
+	)
+
+	assert.Equal(t, "instanceId", got.InstanceID.ValueString())
+	assert.Equal(t, "snapshotId", got.SnapshotID.ValueString())
+	assert.Equal(t, "name", got.Name.ValueString())
+	assert.Equal(t, "READY", got.State.ValueString())
+	assert.Equal(t, "2023-11-02 07:31:28 +0000 UTC", got.CreatedAt.ValueString())
+	assert.True(t, got.Timeouts.IsNull())
+}
+
+func Test_waitForSnapshot(t *testing.T) {
+	t.Run("waits for the snapshot to be listed and ready", func(t *testing.T) {
+		polls := 0
+		getSnapshot := func(_ context.Context) (*publiccloud.Snapshot, *http.Response, error) {
+			polls++
+			switch {
+			case polls < 3:
+				return nil, nil, nil
+			case polls < 5:
+				return newTestSnapshot("CREATING"), nil, nil
+			}
+			return newTestSnapshot("READY"), nil, nil
+		}
+
+		snapshot, err := waitForSnapshot(context.TODO(), getSnapshot, time.Millisecond, time.Minute)
+
+		require.NoError(t, err)
+		assert.Equal(t, "READY", snapshot.GetState())
+		assert.Equal(t, 5, polls)
+	})
+
+	t.Run("returns the snapshot and an error when it fails", func(t *testing.T) {
+		getSnapshot := func(_ context.Context) (*publiccloud.Snapshot, *http.Response, error) {
+			return newTestSnapshot("FAILED"), nil, nil
+		}
+
+		snapshot, err := waitForSnapshot(context.TODO(), getSnapshot, time.Millisecond, time.Minute)
+
+		assert.ErrorContains(t, err, "snapshot snapshotId has failed")
+		assert.Equal(t, "snapshotId", snapshot.GetId())
+	})
+
+	t.Run("times out if the snapshot is not ready", func(t *testing.T) {
+		getSnapshot := func(_ context.Context) (*publiccloud.Snapshot, *http.Response, error) {
+			return newTestSnapshot("CREATING"), nil, nil
+		}
+
+		snapshot, err := waitForSnapshot(context.TODO(), getSnapshot, time.Millisecond, 10*time.Millisecond)
+
+		assert.ErrorContains(t, err, "timed out waiting for the snapshot after 10ms")
+		assert.Equal(t, "snapshotId", snapshot.GetId())
+	})
+
+}
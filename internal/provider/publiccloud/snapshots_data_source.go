@@ -0,0 +1,153 @@
+package publiccloud
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure = &snapshotsDataSource{}
+)
+
+type snapshotsDataSourceModel struct {
+	InstanceID types.String              `tfsdk:"instance_id"`
+	Snapshots  []snapshotDataSourceModel `tfsdk:"snapshots"`
+}
+
+type snapshotDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	State     types.String `tfsdk:"state"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func adaptSnapshotToSnapshotDataSource(snapshot publiccloud.Snapshot) snapshotDataSourceModel {
+	return snapshotDataSourceModel{
+		ID:        basetypes.NewStringValue(snapshot.GetId()),
+		Name:      basetypes.NewStringValue(snapshot.GetDisplayName()),
+		State:     basetypes.NewStringValue(snapshot.GetState()),
+		CreatedAt: utils.AdaptNullableTimeToStringValue(snapshot.Created),
+	}
+}
+
+func listSnapshots(
+	ctx context.Context,
+	api publiccloud.PubliccloudAPI,
+	instanceID string,
+) ([]publiccloud.Snapshot, *http.Response, error) {
+	snapshots := []publiccloud.Snapshot{}
+	var offset *int32
+
+	request := api.GetSnapshotList(ctx, instanceID)
+	for {
+		result, httpResponse, err := request.Execute()
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		snapshots = append(snapshots, result.GetSnapshots()...)
+
+		metadata := result.GetMetadata()
+		offset = utils.NewOffset(
+			metadata.GetLimit(),
+			metadata.GetOffset(),
+			metadata.GetTotalCount(),
+		)
+		if offset == nil {
+			return snapshots, httpResponse, nil
+		}
+
+		request = request.Offset(*offset)
+	}
+}
+
+type snapshotsDataSource struct {
+	utils.DataSourceAPI
+}
+
+func (s *snapshotsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		Description: utils.BetaDescription,
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Instance ID",
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Snapshot ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name to identify the snapshot",
+						},
+						"state": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date and time when the snapshot was created",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *snapshotsDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	var config snapshotsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	snapshots, httpResponse, err := listSnapshots(
+		ctx,
+		s.PubliccloudAPI,
+		config.InstanceID.ValueString(),
+	)
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	state := snapshotsDataSourceModel{
+		InstanceID: config.InstanceID,
+	}
+	for _, snapshot := range snapshots {
+		state.Snapshots = append(
+			state.Snapshots,
+			adaptSnapshotToSnapshotDataSource(snapshot),
+		)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func NewSnapshotsDataSource() datasource.DataSource {
+	return &snapshotsDataSource{
+		DataSourceAPI: utils.DataSourceAPI{
+			Name: "public_cloud_snapshots",
+		},
+	}
+}
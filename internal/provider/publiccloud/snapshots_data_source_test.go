@@ -0,0 +1,21 @@
+package publiccloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptSnapshotToSnapshotDataSource(t *testing.T) {
+	created := time.Date(2023, 11, 2, 7, 31, 28, 0, time.UTC)
+	snapshot := newTestSnapshot("READY")
+	snapshot.Created = &created
+
+	got := adaptSnapshotToSnapshotDataSource(*snapshot)
+
+	assert.Equal(t, "snapshotId", got.ID.ValueString())
+	assert.Equal(t, "name", got.Name.ValueString())
+	assert.Equal(t, "READY", got.State.ValueString())
+	assert.Equal(t, "2023-11-02 07:31:28 +0000 UTC", got.CreatedAt.ValueString())
+}
@@ -0,0 +1,176 @@
+package publiccloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &targetGroupAttachmentResource{}
+	_ resource.ResourceWithImportState = &targetGroupAttachmentResource{}
+)
+
+type targetGroupAttachmentResourceModel struct {
+	TargetGroupID types.String `tfsdk:"target_group_id"`
+	InstanceID    types.String `tfsdk:"instance_id"`
+}
+
+type targetGroupAttachmentResource struct {
+	utils.ResourceAPI
+}
+
+func (t *targetGroupAttachmentResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	utils.ImportCompositeID(
+		ctx,
+		[]string{"target_group_id", "instance_id"},
+		request,
+		response,
+	)
+}
+
+func (t *targetGroupAttachmentResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	warningError := "**WARNING!** Changing this value once running will cause the instance to be deregistered and a new registration to be created."
+
+	response.Schema = schema.Schema{
+		MarkdownDescription: utils.BetaDescription + " Registers an instance in a target group, so the load balancer forwards traffic to it on the `port` of the target group. Destroying the resource deregisters the instance. Do not combine it with `auto_register` on the same target group, as that deregisters every instance it did not select.",
+		Attributes: map[string]schema.Attribute{
+			"target_group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the target group.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the instance to register. It must be in the region of the target group.\n" + warningError,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (t *targetGroupAttachmentResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	var plan targetGroupAttachmentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	httpResponse, err := t.PubliccloudAPI.
+		RegisterTargets(ctx, plan.TargetGroupID.ValueString()).
+		RequestBody([]string{plan.InstanceID.ValueString()}).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (t *targetGroupAttachmentResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	var state targetGroupAttachmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	registered := t.isRegistered(ctx, state, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	// The instance has been deregistered outside of Terraform.
+	if !registered {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+// Update is never called, as every attribute requires replacement.
+func (t *targetGroupAttachmentResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	_ *resource.UpdateResponse,
+) {
+}
+
+func (t *targetGroupAttachmentResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	var state targetGroupAttachmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	httpResponse, err := t.PubliccloudAPI.
+		DeregisterTargets(ctx, state.TargetGroupID.ValueString()).
+		RequestBody([]string{state.InstanceID.ValueString()}).
+		Execute()
+	if err != nil {
+		utils.SdkError(ctx, &response.Diagnostics, err, httpResponse)
+	}
+}
+
+// isRegistered reports whether the instance is a target of the target group.
+func (t *targetGroupAttachmentResource) isRegistered(
+	ctx context.Context,
+	attachment targetGroupAttachmentResourceModel,
+	diags *diag.Diagnostics,
+) bool {
+	targets, httpResponse, err := listTargets(
+		ctx,
+		t.PubliccloudAPI,
+		attachment.TargetGroupID.ValueString(),
+	)
+	if err != nil {
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return false
+	}
+
+	for _, target := range targets {
+		if target.GetId() == attachment.InstanceID.ValueString() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func NewTargetGroupAttachmentResource() resource.Resource {
+	return &targetGroupAttachmentResource{
+		ResourceAPI: utils.ResourceAPI{
+			Name: "public_cloud_target_group_attachment",
+		},
+	}
+}
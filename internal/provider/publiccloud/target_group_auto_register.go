@@ -0,0 +1,205 @@
+package publiccloud
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/utils"
+)
+
+// matchesInstanceSelector reports whether the instance is in the region of
+// the target group, is not being destroyed and its reference matches the
+// selector.
+func matchesInstanceSelector(
+	instance publiccloud.Instance,
+	region string,
+	selector string,
+) bool {
+	if string(instance.GetRegion()) != region {
+		return false
+	}
+
+	switch instance.GetState() {
+	case publiccloud.STATE_DESTROYING, publiccloud.STATE_DESTROYED:
+		return false
+	}
+
+	// The pattern has been validated by the schema.
+	matched, _ := path.Match(selector, instance.GetReference())
+
+	return matched
+}
+
+func adaptInstanceIDsToSet(ids []string) types.Set {
+	slices.Sort(ids)
+
+	elements := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		elements = append(elements, basetypes.NewStringValue(id))
+	}
+
+	return basetypes.NewSetValueMust(types.StringType, elements)
+}
+
+// selectInstanceIDs returns the ids of the instances that auto_register
+// should register in the target group.
+func (t *targetGroupResource) selectInstanceIDs(
+	ctx context.Context,
+	region string,
+	selector string,
+) (types.Set, *http.Response, error) {
+	instances, httpResponse, err := listInstances(ctx, t.PubliccloudAPI)
+	if err != nil {
+		return types.SetNull(types.StringType), httpResponse, err
+	}
+
+	var ids []string
+	for _, instance := range instances {
+		if matchesInstanceSelector(instance, region, selector) {
+			ids = append(ids, instance.GetId())
+		}
+	}
+
+	return adaptInstanceIDsToSet(ids), httpResponse, nil
+}
+
+// planRegisteredInstances plans the instances matching instance_selector as
+// registered_instance_ids, so instances that were created, renamed or
+// destroyed since the last apply show up as a change.
+func (t *targetGroupResource) planRegisteredInstances(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plan targetGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AutoRegister.IsUnknown() ||
+		plan.InstanceSelector.IsUnknown() ||
+		plan.Region.IsUnknown() {
+		return
+	}
+
+	if !plan.AutoRegister.ValueBool() {
+		plan.RegisteredInstanceIDs = types.SetNull(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+		return
+	}
+
+	// The provider is not configured yet.
+	if t.PubliccloudAPI == nil {
+		return
+	}
+
+	ids, httpResponse, err := t.selectInstanceIDs(
+		ctx,
+		plan.Region.ValueString(),
+		plan.InstanceSelector.ValueString(),
+	)
+	if err != nil {
+		utils.SdkError(ctx, &resp.Diagnostics, err, httpResponse)
+		return
+	}
+
+	plan.RegisteredInstanceIDs = ids
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// readRegisteredInstances returns the ids of all targets of the target
+// group.
+func (t *targetGroupResource) readRegisteredInstances(
+	ctx context.Context,
+	targetGroupID string,
+	diags *diag.Diagnostics,
+) types.Set {
+	targets, httpResponse, err := listTargets(ctx, t.PubliccloudAPI, targetGroupID)
+	if err != nil {
+		utils.SdkError(ctx, diags, err, httpResponse)
+		return types.SetNull(types.StringType)
+	}
+
+	var ids []string
+	for _, target := range targets {
+		ids = append(ids, target.GetId())
+	}
+
+	return adaptInstanceIDsToSet(ids)
+}
+
+// registerInstances makes the targets of the target group match wanted,
+// registering the missing instances and deregistering all others. Nothing
+// changes if wanted is null, as auto_register is disabled.
+func (t *targetGroupResource) registerInstances(
+	ctx context.Context,
+	targetGroupID string,
+	wanted types.Set,
+	diags *diag.Diagnostics,
+) types.Set {
+	if wanted.IsNull() || wanted.IsUnknown() {
+		return types.SetNull(types.StringType)
+	}
+
+	var wantedIDs []string
+	diags.Append(wanted.ElementsAs(ctx, &wantedIDs, false)...)
+	if diags.HasError() {
+		return wanted
+	}
+
+	current := t.readRegisteredInstances(ctx, targetGroupID, diags)
+	if diags.HasError() {
+		return wanted
+	}
+
+	var currentIDs []string
+	diags.Append(current.ElementsAs(ctx, &currentIDs, false)...)
+	if diags.HasError() {
+		return wanted
+	}
+
+	var register, deregister []string
+	for _, id := range wantedIDs {
+		if !slices.Contains(currentIDs, id) {
+			register = append(register, id)
+		}
+	}
+	for _, id := range currentIDs {
+		if !slices.Contains(wantedIDs, id) {
+			deregister = append(deregister, id)
+		}
+	}
+
+	if len(register) > 0 {
+		httpResponse, err := t.PubliccloudAPI.
+			RegisterTargets(ctx, targetGroupID).
+			RequestBody(register).
+			Execute()
+		if err != nil {
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return current
+		}
+	}
+
+	if len(deregister) > 0 {
+		httpResponse, err := t.PubliccloudAPI.
+			DeregisterTargets(ctx, targetGroupID).
+			RequestBody(deregister).
+			Execute()
+		if err != nil {
+			utils.SdkError(ctx, diags, err, httpResponse)
+			return adaptInstanceIDsToSet(append(currentIDs, register...))
+		}
+	}
+
+	return wanted
+}
@@ -0,0 +1,68 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_matchesInstanceSelector(t *testing.T) {
+	newInstance := func(region publiccloud.RegionName, state publiccloud.State, reference string) publiccloud.Instance {
+		return publiccloud.Instance{
+			Region:    region,
+			State:     state,
+			Reference: *publiccloud.NewNullableString(&reference),
+		}
+	}
+
+	t.Run("matching instance is selected", func(t *testing.T) {
+		instance := newInstance(publiccloud.REGIONNAME_EU_WEST_3, publiccloud.STATE_RUNNING, "web-1")
+
+		assert.True(t, matchesInstanceSelector(instance, "eu-west-3", "web-*"))
+	})
+
+	t.Run("instance with another reference is not selected", func(t *testing.T) {
+		instance := newInstance(publiccloud.REGIONNAME_EU_WEST_3, publiccloud.STATE_RUNNING, "db-1")
+
+		assert.False(t, matchesInstanceSelector(instance, "eu-west-3", "web-*"))
+	})
+
+	t.Run("instance in another region is not selected", func(t *testing.T) {
+		instance := newInstance(publiccloud.REGIONNAME_EU_CENTRAL_1, publiccloud.STATE_RUNNING, "web-1")
+
+		assert.False(t, matchesInstanceSelector(instance, "eu-west-3", "web-*"))
+	})
+
+	t.Run("destroyed instance is not selected", func(t *testing.T) {
+		instance := newInstance(publiccloud.REGIONNAME_EU_WEST_3, publiccloud.STATE_DESTROYING, "web-1")
+
+		assert.False(t, matchesInstanceSelector(instance, "eu-west-3", "web-*"))
+	})
+}
+
+func Test_adaptInstanceIDsToSet(t *testing.T) {
+	t.Run("ids are sorted", func(t *testing.T) {
+		got := adaptInstanceIDsToSet([]string{"b", "a"})
+
+		want := basetypes.NewSetValueMust(
+			types.StringType,
+			[]attr.Value{
+				basetypes.NewStringValue("a"),
+				basetypes.NewStringValue("b"),
+			},
+		)
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("no ids result in an empty set", func(t *testing.T) {
+		got := adaptInstanceIDsToSet(nil)
+
+		assert.False(t, got.IsNull())
+		assert.Empty(t, got.Elements())
+	})
+}
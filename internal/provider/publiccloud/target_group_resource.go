@@ -2,6 +2,7 @@ package publiccloud
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -21,8 +22,10 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &targetGroupResource{}
-	_ resource.ResourceWithImportState = &targetGroupResource{}
+	_ resource.ResourceWithConfigure      = &targetGroupResource{}
+	_ resource.ResourceWithImportState    = &targetGroupResource{}
+	_ resource.ResourceWithValidateConfig = &targetGroupResource{}
+	_ resource.ResourceWithModifyPlan     = &targetGroupResource{}
 )
 
 type targetGroupResourceModel struct {
@@ -32,6 +35,10 @@ type targetGroupResourceModel struct {
 	Port        types.Int32  `tfsdk:"port"`
 	Region      types.String `tfsdk:"region"`
 	HealthCheck types.Object `tfsdk:"health_check"`
+
+	AutoRegister          types.Bool   `tfsdk:"auto_register"`
+	InstanceSelector      types.String `tfsdk:"instance_selector"`
+	RegisteredInstanceIDs types.Set    `tfsdk:"registered_instance_ids"`
 }
 
 func adaptTargetGroupToTargetGroupResource(
@@ -45,6 +52,8 @@ func adaptTargetGroupToTargetGroupResource(
 		Protocol: basetypes.NewStringValue(string(sdkTargetGroup.GetProtocol())),
 		Port:     basetypes.NewInt32Value(sdkTargetGroup.GetPort()),
 		Region:   basetypes.NewStringValue(string(sdkTargetGroup.GetRegion())),
+
+		RegisteredInstanceIDs: basetypes.NewSetNull(types.StringType),
 	}
 
 	sdkHealthCheck, _ := sdkTargetGroup.GetHealthCheckOk()
@@ -146,7 +155,7 @@ func (t *targetGroupResource) Schema(
 			},
 			"protocol": schema.StringAttribute{
 				Required:    true,
-				Description: "Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedProtocolEnumValues) + "\n" + warningError,
+				Description: "The protocol the targets are reached with. It is independent of the protocol of the listeners forwarding to the target group, so an `HTTPS` listener can terminate TLS and forward to `HTTP` targets. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedProtocolEnumValues) + "\n" + warningError,
 				Validators: []validator.String{
 					stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedProtocolEnumValues)...),
 				},
@@ -156,7 +165,7 @@ func (t *targetGroupResource) Schema(
 			},
 			"port": schema.Int32Attribute{
 				Required:    true,
-				Description: "The port of the target group",
+				Description: "The port the targets receive traffic on. It is independent of the port of the listeners forwarding to the target group",
 				Validators: []validator.Int32{
 					int32validator.Between(1, 65535),
 				},
@@ -198,7 +207,7 @@ func (t *targetGroupResource) Schema(
 						},
 					},
 					"method": schema.StringAttribute{
-						Description: "Required if `protocol` is `HTTP` or `HTTPS`. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedHttpMethodEnumValues),
+						Description: "Required if `protocol` is `HTTP` or `HTTPS`, cannot be set otherwise. Valid options are " + utils.StringTypeArrayToMarkdown(publiccloud.AllowedHttpMethodEnumValues),
 						Validators: []validator.String{
 							stringvalidator.OneOf(utils.AdaptStringTypeArrayToStringArray(publiccloud.AllowedHttpMethodEnumValues)...),
 						},
@@ -209,7 +218,7 @@ func (t *targetGroupResource) Schema(
 						Description: "URI to check in the target instances",
 					},
 					"host": schema.StringAttribute{
-						Description: "Host for the health check if any",
+						Description: "Host for the health check if any. Can only be set if `protocol` is `HTTP` or `HTTPS`",
 						Optional:    true,
 					},
 					"port": schema.Int32Attribute{
@@ -221,10 +230,108 @@ func (t *targetGroupResource) Schema(
 					},
 				},
 			},
+			"auto_register": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Register the instances matching `instance_selector` as targets. Matching is reconciled whenever Terraform runs: instances created, renamed or destroyed since the last apply show up as a change of `registered_instance_ids`, and applying it registers the new matches and deregisters every other target, including targets registered outside of Terraform. Instances created in the same apply are registered by the next one. Disabling it leaves the targets as they are. Defaults to false.",
+			},
+			"instance_selector": schema.StringAttribute{
+				Optional:    true,
+				Description: "Glob pattern matched against the `reference` of the instances in the `region` of the target group, such as \"web-*\". Required if `auto_register` is true, cannot be set otherwise.",
+				Validators: []validator.String{
+					glob(),
+				},
+			},
+			"registered_instance_ids": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The ids of the instances registered as targets, if `auto_register` is true.",
+			},
 		},
 	}
 }
 
+// ValidateConfig ensures that the HTTP settings of the health check are only
+// set for HTTP and HTTPS health checks, and that instance_selector is set if
+// and only if auto_register is enabled.
+func (t *targetGroupResource) ValidateConfig(
+	ctx context.Context,
+	request resource.ValidateConfigRequest,
+	response *resource.ValidateConfigResponse,
+) {
+	t.validateAutoRegister(ctx, request, response)
+
+	var protocol types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("health_check").AtName("protocol"), &protocol)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if protocol.IsNull() || protocol.IsUnknown() {
+		return
+	}
+	if publiccloud.Protocol(protocol.ValueString()) != publiccloud.PROTOCOL_TCP {
+		return
+	}
+
+	for _, name := range []string{"method", "host"} {
+		var value types.String
+		attributePath := path.Root("health_check").AtName(name)
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, attributePath, &value)...)
+		if value.IsNull() {
+			continue
+		}
+
+		response.Diagnostics.AddAttributeError(
+			attributePath,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("health_check.%s can only be set if health_check.protocol is HTTP or HTTPS.", name),
+		)
+	}
+}
+
+func (t *targetGroupResource) validateAutoRegister(
+	ctx context.Context,
+	request resource.ValidateConfigRequest,
+	response *resource.ValidateConfigResponse,
+) {
+	var autoRegister types.Bool
+	var selector types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("auto_register"), &autoRegister)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("instance_selector"), &selector)...)
+	if response.Diagnostics.HasError() || autoRegister.IsUnknown() {
+		return
+	}
+
+	if autoRegister.ValueBool() && selector.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("instance_selector"),
+			"Missing Attribute Configuration",
+			"instance_selector is required if auto_register is true.",
+		)
+	}
+
+	if !autoRegister.ValueBool() && !selector.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("instance_selector"),
+			"Invalid Attribute Combination",
+			"instance_selector can only be set if auto_register is true.",
+		)
+	}
+}
+
+// ModifyPlan plans the instances auto_register registers.
+func (t *targetGroupResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	// Nothing to do on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	t.planRegisteredInstances(ctx, request, response)
+}
+
 func (t *targetGroupResource) Create(
 	ctx context.Context,
 	request resource.CreateRequest,
@@ -275,6 +382,15 @@ func (t *targetGroupResource) Create(
 		return
 	}
 
+	targetGroup.AutoRegister = plan.AutoRegister
+	targetGroup.InstanceSelector = plan.InstanceSelector
+	targetGroup.RegisteredInstanceIDs = t.registerInstances(
+		ctx,
+		targetGroup.ID.ValueString(),
+		plan.RegisteredInstanceIDs,
+		&response.Diagnostics,
+	)
+
 	response.Diagnostics.Append(response.State.Set(ctx, targetGroup)...)
 }
 
@@ -306,6 +422,19 @@ func (t *targetGroupResource) Read(
 		return
 	}
 
+	targetGroup.AutoRegister = state.AutoRegister
+	targetGroup.InstanceSelector = state.InstanceSelector
+	if state.AutoRegister.ValueBool() {
+		targetGroup.RegisteredInstanceIDs = t.readRegisteredInstances(
+			ctx,
+			targetGroup.ID.ValueString(),
+			&response.Diagnostics,
+		)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, targetGroup)...)
 }
 
@@ -356,6 +485,15 @@ func (t *targetGroupResource) Update(
 		return
 	}
 
+	targetGroup.AutoRegister = plan.AutoRegister
+	targetGroup.InstanceSelector = plan.InstanceSelector
+	targetGroup.RegisteredInstanceIDs = t.registerInstances(
+		ctx,
+		targetGroup.ID.ValueString(),
+		plan.RegisteredInstanceIDs,
+		&response.Diagnostics,
+	)
+
 	response.Diagnostics.Append(response.State.Set(ctx, targetGroup)...)
 }
 
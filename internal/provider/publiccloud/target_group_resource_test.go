@@ -87,6 +87,7 @@ func Test_adaptTargetGroupToTargetGroupResource(t *testing.T) {
 					"port":     types.Int32Type,
 				},
 			),
+			RegisteredInstanceIDs: basetypes.NewSetNull(types.StringType),
 		}
 
 		assert.False(t, diags.HasError())
@@ -28,11 +28,47 @@ type targetGroupsDataSourceModel struct {
 }
 
 type targetGroupDataSourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
+	ID          types.String                `tfsdk:"id"`
+	Name        types.String                `tfsdk:"name"`
+	Protocol    types.String                `tfsdk:"protocol"`
+	Port        types.Int32                 `tfsdk:"port"`
+	Region      types.String                `tfsdk:"region"`
+	HealthCheck *healthCheckDataSourceModel `tfsdk:"health_check"`
+}
+
+type healthCheckDataSourceModel struct {
 	Protocol types.String `tfsdk:"protocol"`
+	Method   types.String `tfsdk:"method"`
+	URI      types.String `tfsdk:"uri"`
+	Host     types.String `tfsdk:"host"`
 	Port     types.Int32  `tfsdk:"port"`
-	Region   types.String `tfsdk:"region"`
+}
+
+func adaptTargetGroupToTargetGroupDataSource(
+	targetGroup publiccloud.TargetGroup,
+) targetGroupDataSourceModel {
+	model := targetGroupDataSourceModel{
+		ID:       basetypes.NewStringValue(targetGroup.GetId()),
+		Name:     basetypes.NewStringValue(targetGroup.GetName()),
+		Protocol: basetypes.NewStringValue(string(targetGroup.GetProtocol())),
+		Port:     basetypes.NewInt32Value(targetGroup.GetPort()),
+		Region:   basetypes.NewStringValue(string(targetGroup.GetRegion())),
+	}
+
+	if healthCheck, _ := targetGroup.GetHealthCheckOk(); healthCheck != nil {
+		method, _ := healthCheck.GetMethodOk()
+		host, _ := healthCheck.GetHostOk()
+
+		model.HealthCheck = &healthCheckDataSourceModel{
+			Protocol: basetypes.NewStringValue(string(healthCheck.GetProtocol())),
+			Method:   basetypes.NewStringPointerValue((*string)(method)),
+			URI:      basetypes.NewStringValue(healthCheck.GetUri()),
+			Host:     basetypes.NewStringPointerValue(host),
+			Port:     basetypes.NewInt32Value(healthCheck.GetPort()),
+		}
+	}
+
+	return model
 }
 
 type targetGroupsDataSource struct {
@@ -99,6 +135,31 @@ func (t *targetGroupsDataSource) Schema(
 							Computed:    true,
 							Description: "Region name",
 						},
+						"health_check": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "How the targets are checked, null if they are not",
+							Attributes: map[string]schema.Attribute{
+								"protocol": schema.StringAttribute{
+									Computed: true,
+								},
+								"method": schema.StringAttribute{
+									Computed:    true,
+									Description: "The HTTP method of HTTP and HTTPS health checks",
+								},
+								"uri": schema.StringAttribute{
+									Computed:    true,
+									Description: "URI to check in the target instances",
+								},
+								"host": schema.StringAttribute{
+									Computed:    true,
+									Description: "Host for the health check if any",
+								},
+								"port": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Port number",
+								},
+							},
+						},
 					},
 				},
 			},
@@ -161,13 +222,7 @@ func (t *targetGroupsDataSource) Read(
 	for _, targetGroup := range targetGroups {
 		state.TargetGroups = append(
 			state.TargetGroups,
-			targetGroupDataSourceModel{
-				ID:       basetypes.NewStringValue(targetGroup.GetId()),
-				Name:     basetypes.NewStringValue(targetGroup.GetName()),
-				Protocol: basetypes.NewStringValue(string(targetGroup.GetProtocol())),
-				Port:     basetypes.NewInt32Value(targetGroup.GetPort()),
-				Region:   basetypes.NewStringValue(string(targetGroup.GetRegion())),
-			},
+			adaptTargetGroupToTargetGroupDataSource(targetGroup),
 		)
 	}
 	state.ID = config.ID
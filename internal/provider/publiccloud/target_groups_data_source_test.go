@@ -0,0 +1,47 @@
+package publiccloud
+
+import (
+	"testing"
+
+	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_adaptTargetGroupToTargetGroupDataSource(t *testing.T) {
+	t.Run("health check is set", func(t *testing.T) {
+		method := publiccloud.HTTPMETHOD_GET
+		host := "example.com"
+		sdkHealthCheck := publiccloud.HealthCheck{
+			Protocol: publiccloud.PROTOCOL_HTTP,
+			Method:   *publiccloud.NewNullableHttpMethod(&method),
+			Uri:      "/health",
+			Host:     *publiccloud.NewNullableString(&host),
+			Port:     8080,
+		}
+		sdkTargetGroup := publiccloud.TargetGroup{
+			Id:          "id",
+			Name:        "name",
+			Protocol:    publiccloud.PROTOCOL_HTTPS,
+			Port:        443,
+			Region:      publiccloud.REGIONNAME_EU_WEST_3,
+			HealthCheck: *publiccloud.NewNullableHealthCheck(&sdkHealthCheck),
+		}
+
+		got := adaptTargetGroupToTargetGroupDataSource(sdkTargetGroup)
+
+		assert.Equal(t, "id", got.ID.ValueString())
+		assert.Equal(t, "HTTPS", got.Protocol.ValueString())
+		assert.Equal(t, int32(443), got.Port.ValueInt32())
+		assert.Equal(t, "HTTP", got.HealthCheck.Protocol.ValueString())
+		assert.Equal(t, "GET", got.HealthCheck.Method.ValueString())
+		assert.Equal(t, "/health", got.HealthCheck.URI.ValueString())
+		assert.Equal(t, "example.com", got.HealthCheck.Host.ValueString())
+		assert.Equal(t, int32(8080), got.HealthCheck.Port.ValueInt32())
+	})
+
+	t.Run("health check is not set", func(t *testing.T) {
+		got := adaptTargetGroupToTargetGroupDataSource(publiccloud.TargetGroup{})
+
+		assert.Nil(t, got.HealthCheck)
+	})
+}
@@ -0,0 +1,119 @@
+package publiccloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ipAddressValidator ensures that the given value is a valid IPv4 or IPv6 address.
+type ipAddressValidator struct{}
+
+func (v ipAddressValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if net.ParseIP(request.ConfigValue.ValueString()) == nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("The value must be a valid IPv4 or IPv6 address, but got %s.", request.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = ipAddressValidator{}
+
+func (v ipAddressValidator) Description(_ context.Context) string {
+	return "Ensures that the value is a valid IPv4 or IPv6 address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ipAddress returns a new instance of the validator.
+func ipAddress() validator.String {
+	return ipAddressValidator{}
+}
+
+// globValidator ensures that the given value is a valid glob pattern.
+type globValidator struct{}
+
+func (v globValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := path.Match(request.ConfigValue.ValueString(), ""); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Pattern",
+			fmt.Sprintf("The value must be a valid glob pattern such as \"web-*\", but got %s.", request.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = globValidator{}
+
+func (v globValidator) Description(_ context.Context) string {
+	return "Ensures that the value is a valid glob pattern"
+}
+
+func (v globValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// glob returns a new instance of the validator.
+func glob() validator.String {
+	return globValidator{}
+}
+
+// userDataBase64Validator ensures that the given value is base64 encoded
+// user data that is not too large once decoded.
+type userDataBase64Validator struct{}
+
+func (v userDataBase64Validator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := decodeUserDataBase64(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid User Data",
+			fmt.Sprintf("The user data is invalid: %s.", err),
+		)
+	}
+}
+
+var _ validator.String = userDataBase64Validator{}
+
+func (v userDataBase64Validator) Description(_ context.Context) string {
+	return "Ensures that the value is base64 encoded user data"
+}
+
+func (v userDataBase64Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// userDataBase64 returns a new instance of the validator.
+func userDataBase64() validator.String {
+	return userDataBase64Validator{}
+}
@@ -0,0 +1,109 @@
+package publiccloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ipAddressValidator_ValidateString(t *testing.T) {
+	t.Run("does not set errors for an IPv4 address", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("1.1.1.1"),
+		}
+		response := validator.StringResponse{}
+
+		ipAddress().ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("does not set errors for an IPv6 address", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("2606:4700:4700::1111"),
+		}
+		response := validator.StringResponse{}
+
+		ipAddress().ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("does not set errors for an unknown value", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringUnknown(),
+		}
+		response := validator.StringResponse{}
+
+		ipAddress().ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("sets errors if the value is not an IP address", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("dns.example.com"),
+		}
+		response := validator.StringResponse{}
+
+		ipAddress().ValidateString(context.TODO(), request, &response)
+
+		assert.Len(t, response.Diagnostics.Errors(), 1)
+		assert.Contains(
+			t,
+			response.Diagnostics.Errors()[0].Detail(),
+			"The value must be a valid IPv4 or IPv6 address, but got dns.example.com.",
+		)
+	})
+}
+
+func Test_globValidator_ValidateString(t *testing.T) {
+	t.Run("does not set errors for a valid pattern", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("web-*"),
+		}
+		response := validator.StringResponse{}
+
+		glob().ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("sets errors for a malformed pattern", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("web-[a"),
+		}
+		response := validator.StringResponse{}
+
+		glob().ValidateString(context.TODO(), request, &response)
+
+		assert.Len(t, response.Diagnostics.Errors(), 1)
+	})
+}
+
+func Test_userDataBase64Validator_ValidateString(t *testing.T) {
+	t.Run("does not set errors for base64 encoded user data", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("I2Nsb3VkLWNvbmZpZwo="),
+		}
+		response := validator.StringResponse{}
+
+		userDataBase64().ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("sets errors for plain text user data", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("#cloud-config"),
+		}
+		response := validator.StringResponse{}
+
+		userDataBase64().ValidateString(context.TODO(), request, &response)
+
+		assert.Len(t, response.Diagnostics.Errors(), 1)
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/leaseweb-go-sdk/dns"
@@ -42,13 +43,39 @@ func getCoreClient(
 	return &coreClient
 }
 
+// checkSubsystem fails with an error if the subsystem the named resource or
+// data source belongs to was unavailable when the provider was configured.
+func checkSubsystem(
+	coreClient *client.Client,
+	name string,
+	diagnostics *diag.Diagnostics,
+) {
+	err := coreClient.SubsystemError(name)
+	if err == nil {
+		return
+	}
+
+	subsystem, _ := client.SubsystemForName(name)
+	diagnostics.AddError(
+		"Leaseweb subsystem unavailable",
+		fmt.Sprintf(
+			"The %s API could not be reached when the provider was configured: %s. Resources of other subsystems proceed as skip_unavailable_subsystems is set.",
+			subsystem,
+			err,
+		),
+	)
+}
+
 // ResourceAPI contains reusable Configure & Metadata functions for resources.
 type ResourceAPI struct {
-	Name               string
-	PubliccloudAPI     publiccloud.PubliccloudAPI
-	DedicatedserverAPI dedicatedserver.DedicatedserverAPI
-	DNSAPI             dns.DnsAPI
-	IPmgmtAPI          ipmgmt.IpmgmtAPI
+	Name                       string
+	PubliccloudAPI             publiccloud.PubliccloudAPI
+	DedicatedserverAPI         dedicatedserver.DedicatedserverAPI
+	DNSAPI                     dns.DnsAPI
+	IPmgmtAPI                  ipmgmt.IpmgmtAPI
+	DefaultDNSTTL              int32
+	DefaultReverseLookupSuffix string
+	InstanceTypes              *client.InstanceTypeCache
 }
 
 func (p *ResourceAPI) Configure(
@@ -61,10 +88,15 @@ func (p *ResourceAPI) Configure(
 		return
 	}
 
+	checkSubsystem(coreClient, p.Name, &response.Diagnostics)
+
 	p.PubliccloudAPI = coreClient.PubliccloudAPI
 	p.DedicatedserverAPI = coreClient.DedicatedserverAPI
 	p.DNSAPI = coreClient.DNSAPI
 	p.IPmgmtAPI = coreClient.IPmgmtAPI
+	p.DefaultDNSTTL = coreClient.DefaultDNSTTL
+	p.DefaultReverseLookupSuffix = coreClient.DefaultReverseLookupSuffix
+	p.InstanceTypes = coreClient.InstanceTypes
 }
 
 func (p *ResourceAPI) Metadata(
@@ -77,11 +109,12 @@ func (p *ResourceAPI) Metadata(
 
 // DataSourceAPI contains reusable Configure & Metadata functions for data sources.
 type DataSourceAPI struct {
-	Name               string
-	PubliccloudAPI     publiccloud.PubliccloudAPI
-	DedicatedserverAPI dedicatedserver.DedicatedserverAPI
-	DNSAPI             dns.DnsAPI
-	IPmgmtAPI          ipmgmt.IpmgmtAPI
+	Name                  string
+	PubliccloudAPI        publiccloud.PubliccloudAPI
+	DedicatedserverAPI    dedicatedserver.DedicatedserverAPI
+	DNSAPI                dns.DnsAPI
+	IPmgmtAPI             ipmgmt.IpmgmtAPI
+	PaginationConcurrency int
 }
 
 func (d *DataSourceAPI) Configure(
@@ -94,10 +127,13 @@ func (d *DataSourceAPI) Configure(
 		return
 	}
 
+	checkSubsystem(coreClient, d.Name, &response.Diagnostics)
+
 	d.DedicatedserverAPI = coreClient.DedicatedserverAPI
 	d.PubliccloudAPI = coreClient.PubliccloudAPI
 	d.DNSAPI = coreClient.DNSAPI
 	d.IPmgmtAPI = coreClient.IPmgmtAPI
+	d.PaginationConcurrency = coreClient.PaginationConcurrency
 }
 
 func (d *DataSourceAPI) Metadata(
@@ -107,3 +143,35 @@ func (d *DataSourceAPI) Metadata(
 ) {
 	response.TypeName = generateTypeName(request.ProviderTypeName, d.Name)
 }
+
+// EphemeralResourceAPI contains reusable Configure & Metadata functions for
+// ephemeral resources.
+type EphemeralResourceAPI struct {
+	Name               string
+	PubliccloudAPI     publiccloud.PubliccloudAPI
+	DedicatedserverAPI dedicatedserver.DedicatedserverAPI
+}
+
+func (e *EphemeralResourceAPI) Configure(
+	_ context.Context,
+	request ephemeral.ConfigureRequest,
+	response *ephemeral.ConfigureResponse,
+) {
+	coreClient := getCoreClient(request.ProviderData, &response.Diagnostics)
+	if coreClient == nil {
+		return
+	}
+
+	checkSubsystem(coreClient, e.Name, &response.Diagnostics)
+
+	e.PubliccloudAPI = coreClient.PubliccloudAPI
+	e.DedicatedserverAPI = coreClient.DedicatedserverAPI
+}
+
+func (e *EphemeralResourceAPI) Metadata(
+	_ context.Context,
+	request ephemeral.MetadataRequest,
+	response *ephemeral.MetadataResponse,
+) {
+	response.TypeName = generateTypeName(request.ProviderTypeName, e.Name)
+}
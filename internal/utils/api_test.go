@@ -2,10 +2,12 @@ package utils
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/leaseweb/leaseweb-go-sdk/publiccloud"
@@ -85,8 +87,10 @@ func TestResourceAPI_Configure(t *testing.T) {
 			context.TODO(),
 			resource.ConfigureRequest{
 				ProviderData: client.Client{
-					PubliccloudAPI:     publiccloudAPI.PubliccloudAPI,
-					DedicatedserverAPI: dedicatedserverAPI.DedicatedserverAPI,
+					PubliccloudAPI:             publiccloudAPI.PubliccloudAPI,
+					DedicatedserverAPI:         dedicatedserverAPI.DedicatedserverAPI,
+					DefaultDNSTTL:              3600,
+					DefaultReverseLookupSuffix: "example.com",
 				},
 			},
 			&response,
@@ -98,6 +102,50 @@ func TestResourceAPI_Configure(t *testing.T) {
 			dedicatedserverAPI.DedicatedserverAPI,
 			api.DedicatedserverAPI,
 		)
+		assert.Equal(t, int32(3600), api.DefaultDNSTTL)
+		assert.Equal(t, "example.com", api.DefaultReverseLookupSuffix)
+	})
+
+	t.Run("an unavailable subsystem fails", func(t *testing.T) {
+		api := ResourceAPI{Name: "dns_resource_record_set"}
+		response := resource.ConfigureResponse{}
+		api.Configure(
+			context.TODO(),
+			resource.ConfigureRequest{
+				ProviderData: client.Client{
+					UnavailableSubsystems: map[client.Subsystem]error{
+						client.SubsystemDNS: errors.New("tralala"),
+					},
+				},
+			},
+			&response,
+		)
+
+		assert.Equal(t, 1, response.Diagnostics.ErrorsCount())
+		assert.Equal(
+			t,
+			"Leaseweb subsystem unavailable",
+			response.Diagnostics[0].Summary(),
+		)
+		assert.Contains(t, response.Diagnostics[0].Detail(), "tralala")
+	})
+
+	t.Run("other subsystems proceed", func(t *testing.T) {
+		api := ResourceAPI{Name: "ipmgmt_ip"}
+		response := resource.ConfigureResponse{}
+		api.Configure(
+			context.TODO(),
+			resource.ConfigureRequest{
+				ProviderData: client.Client{
+					UnavailableSubsystems: map[client.Subsystem]error{
+						client.SubsystemDNS: errors.New("tralala"),
+					},
+				},
+			},
+			&response,
+		)
+
+		assert.False(t, response.Diagnostics.HasError())
 	})
 }
 
@@ -146,6 +194,24 @@ func TestDataSourceAPI_Configure(t *testing.T) {
 			api.DedicatedserverAPI,
 		)
 	})
+
+	t.Run("an unavailable subsystem fails", func(t *testing.T) {
+		api := DataSourceAPI{Name: "public_cloud_instances"}
+		response := datasource.ConfigureResponse{}
+		api.Configure(
+			context.TODO(),
+			datasource.ConfigureRequest{
+				ProviderData: client.Client{
+					UnavailableSubsystems: map[client.Subsystem]error{
+						client.SubsystemPublicCloud: errors.New("tralala"),
+					},
+				},
+			},
+			&response,
+		)
+
+		assert.Equal(t, 1, response.Diagnostics.ErrorsCount())
+	})
 }
 
 func TestDataSourceAPI_Metadata(t *testing.T) {
@@ -160,3 +226,51 @@ func TestDataSourceAPI_Metadata(t *testing.T) {
 
 	assert.Equal(t, "providerTypeName_tralala", response.TypeName)
 }
+
+func TestEphemeralResourceAPI_Configure(t *testing.T) {
+	t.Run("nothing is set if providerData is nil", func(t *testing.T) {
+		api := EphemeralResourceAPI{}
+		response := ephemeral.ConfigureResponse{}
+		api.Configure(context.TODO(), ephemeral.ConfigureRequest{}, &response)
+
+		assert.Nil(t, api.PubliccloudAPI)
+		assert.Nil(t, api.DedicatedserverAPI)
+	})
+
+	t.Run("client is set from ProviderData", func(t *testing.T) {
+		api := EphemeralResourceAPI{}
+		response := ephemeral.ConfigureResponse{}
+		publiccloudAPI := publiccloud.NewAPIClient(publiccloud.NewConfiguration())
+		dedicatedserverAPI := dedicatedserver.NewAPIClient(dedicatedserver.NewConfiguration())
+		api.Configure(
+			context.TODO(),
+			ephemeral.ConfigureRequest{
+				ProviderData: client.Client{
+					PubliccloudAPI:     publiccloudAPI.PubliccloudAPI,
+					DedicatedserverAPI: dedicatedserverAPI.DedicatedserverAPI,
+				},
+			},
+			&response,
+		)
+
+		assert.Equal(t, publiccloudAPI.PubliccloudAPI, api.PubliccloudAPI)
+		assert.Equal(
+			t,
+			dedicatedserverAPI.DedicatedserverAPI,
+			api.DedicatedserverAPI,
+		)
+	})
+}
+
+func TestEphemeralResourceAPI_Metadata(t *testing.T) {
+	api := EphemeralResourceAPI{
+		Name: "tralala",
+	}
+	request := ephemeral.MetadataRequest{
+		ProviderTypeName: "providerTypeName",
+	}
+	response := ephemeral.MetadataResponse{}
+	api.Metadata(context.TODO(), request, &response)
+
+	assert.Equal(t, "providerTypeName_tralala", response.TypeName)
+}
@@ -12,10 +12,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/client"
 )
 
 const defaultErrMsg = "An error has occurred in the program. Please consider opening an issue."
 const errTitle = "Unexpected Error"
+const notRetriedTitle = "Request Not Retried"
+const maintenanceErrMsg = "The Leaseweb API is currently undergoing maintenance. Try again once the maintenance window has ended, or set `wait_for_maintenance` in the provider configuration to wait for it automatically."
 
 // GeneralError should be called when general errors need to be handled.
 func GeneralError(diags *diag.Diagnostics, ctx context.Context, err error) {
@@ -42,6 +45,37 @@ func UnexpectedImportIdentifierError(diags *diag.Diagnostics, format string, got
 	)
 }
 
+// ImportError should be used in Import() functions when looking up the
+// resource to import fails. Missing resources and missing access get a
+// dedicated explanation, other errors are handled by SdkError.
+func ImportError(
+	ctx context.Context,
+	diags *diag.Diagnostics,
+	id string,
+	err error,
+	resp *http.Response,
+) {
+	switch client.ClassifyResponse(resp, err) {
+	case client.ErrorClassNotFound:
+		_ = resp.Body.Close()
+		diags.AddError(
+			"Cannot Import Non-Existent Resource",
+			fmt.Sprintf("No resource with identifier %q exists. Check the identifier and try again.", id),
+		)
+	case client.ErrorClassAuthentication:
+		_ = resp.Body.Close()
+		diags.AddError(
+			"Cannot Import Resource",
+			fmt.Sprintf(
+				"The API token is not allowed to access the resource with identifier %q. Check that the resource belongs to the account of the token.",
+				id,
+			),
+		)
+	default:
+		SdkError(ctx, diags, err, resp)
+	}
+}
+
 // SdkError should be used to handle errors returned by the SDK.
 func SdkError(
 	ctx context.Context,
@@ -78,7 +112,28 @@ func SdkError(
 		}
 	}()
 
+	// Let the user know why a failed request was not retried. Missing
+	// resources are routine while refreshing, maintenance is explained by
+	// the error itself and a successful request failed while handling its
+	// response rather than in the API.
+	errorClass := client.ClassifyResponse(resp, err)
+	if errorClass != client.ErrorClassNone &&
+		errorClass != client.ErrorClassNotFound &&
+		errorClass != client.ErrorClassMaintenance {
+		if reason := client.NotRetriedReason(resp); reason != "" {
+			diags.AddWarning(
+				notRetriedTitle,
+				fmt.Sprintf("The request was not retried because %s.", reason),
+			)
+		}
+	}
+
 	// For certain http responses we don't need to analyze the response body.
+	if errorClass == client.ErrorClassMaintenance {
+		logDebug(fmt.Sprintf("server response: %v", resp.Body), ctx)
+		ReportError(maintenanceErrMsg, diags)
+		return
+	}
 	if resp.StatusCode == 504 {
 		logDebug(fmt.Sprintf("server response: %v", resp.Body), ctx)
 		ReportError("The server took too long to respond.", diags)
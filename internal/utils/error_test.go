@@ -122,6 +122,7 @@ func TestSdkError(t *testing.T) {
 				&http.Response{
 					Body:       io.NopCloser(bytes.NewReader([]byte("tralala"))),
 					StatusCode: 504,
+					Request:    &http.Request{Method: http.MethodGet},
 				},
 			)
 
@@ -135,6 +136,91 @@ func TestSdkError(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"explains why a mutation with a transient error was not retried",
+		func(t *testing.T) {
+			diags := diag.Diagnostics{}
+
+			SdkError(
+				context.TODO(),
+				&diags,
+				errors.New(""),
+				&http.Response{
+					Body:       io.NopCloser(bytes.NewReader([]byte("tralala"))),
+					StatusCode: 504,
+					Request:    &http.Request{Method: http.MethodPost},
+				},
+			)
+
+			want := diag.Diagnostics{}
+			want.AddWarning(
+				notRetriedTitle,
+				"The request was not retried because the API may already have processed the request.",
+			)
+			want.AddError(
+				errTitle,
+				"The server took too long to respond.",
+			)
+
+			assert.Equal(t, want, diags)
+		},
+	)
+
+	t.Run(
+		"explains why a fatal error was not retried",
+		func(t *testing.T) {
+			diags := diag.Diagnostics{}
+
+			SdkError(
+				context.TODO(),
+				&diags,
+				errors.New(""),
+				&http.Response{
+					Body: io.NopCloser(
+						bytes.NewReader(
+							[]byte(`{"errorMessage": "Unauthorized"}`),
+						),
+					),
+					StatusCode: 401,
+				},
+			)
+
+			assert.Len(t, diags.Warnings(), 1)
+			assert.Equal(t, notRetriedTitle, diags.Warnings()[0].Summary())
+			assert.Equal(
+				t,
+				"The request was not retried because the API token is invalid or lacks access.",
+				diags.Warnings()[0].Detail(),
+			)
+		},
+	)
+
+	t.Run(
+		"sets maintenance error if server is in a maintenance window",
+		func(t *testing.T) {
+			diags := diag.Diagnostics{}
+
+			SdkError(
+				context.TODO(),
+				&diags,
+				errors.New(""),
+				&http.Response{
+					Body: io.NopCloser(
+						bytes.NewReader(
+							[]byte(`{"errorMessage": "The API is currently under maintenance"}`),
+						),
+					),
+					StatusCode: 503,
+				},
+			)
+
+			want := diag.Diagnostics{}
+			want.AddError(errTitle, maintenanceErrMsg)
+
+			assert.Equal(t, want, diags)
+		},
+	)
+
 	t.Run(
 		"sets error if response body cannot be mapped to errorResponse",
 		func(t *testing.T) {
@@ -150,6 +236,10 @@ func TestSdkError(t *testing.T) {
 			)
 
 			want := diag.Diagnostics{}
+			want.AddWarning(
+				notRetriedTitle,
+				"The request was not retried because the error is not transient.",
+			)
 			want.AddError(errTitle, defaultErrMsg)
 
 			assert.Equal(t, want, diags)
@@ -178,6 +268,27 @@ func TestSdkError(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"does not warn about retries if a successful response cannot be decoded",
+		func(t *testing.T) {
+			diags := diag.Diagnostics{}
+
+			SdkError(
+				context.TODO(),
+				&diags,
+				errors.New("json: cannot unmarshal string into Go value of type publiccloud.Instance"),
+				&http.Response{
+					Body:       io.NopCloser(bytes.NewReader([]byte(`"instance"`))),
+					StatusCode: 200,
+					Request:    &http.Request{Method: http.MethodPost},
+				},
+			)
+
+			assert.Empty(t, diags.Warnings())
+			assert.True(t, diags.HasError())
+		},
+	)
+
 	t.Run("sets expected path if there are no children", func(t *testing.T) {
 		diags := diag.Diagnostics{}
 
@@ -650,6 +761,44 @@ func ExampleUnexpectedImportIdentifierError() {
 	// Output: [{Expected import identifier with format: "load_balancer_id,listener_id". Got: "f6d09965-c857-4d9b-a17f-c21bf13ddcd4" Unexpected Import Identifier}]
 }
 
+func TestImportError(t *testing.T) {
+	t.Run("missing resources are reported", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		resp := http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(bytes.NewReader([]byte(""))),
+		}
+
+		ImportError(context.TODO(), &diags, "id", errors.New("error"), &resp)
+
+		assert.Len(t, diags.Errors(), 1)
+		assert.Equal(t, "Cannot Import Non-Existent Resource", diags.Errors()[0].Summary())
+		assert.Contains(t, diags.Errors()[0].Detail(), `"id"`)
+	})
+
+	t.Run("missing access is reported", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+		resp := http.Response{
+			StatusCode: http.StatusForbidden,
+			Body:       io.NopCloser(bytes.NewReader([]byte(""))),
+		}
+
+		ImportError(context.TODO(), &diags, "id", errors.New("error"), &resp)
+
+		assert.Len(t, diags.Errors(), 1)
+		assert.Equal(t, "Cannot Import Resource", diags.Errors()[0].Summary())
+	})
+
+	t.Run("other errors are handled by SdkError", func(t *testing.T) {
+		diags := diag.Diagnostics{}
+
+		ImportError(context.TODO(), &diags, "id", errors.New("tralala"), nil)
+
+		assert.Len(t, diags.Errors(), 1)
+		assert.Equal(t, "tralala", diags.Errors()[0].Detail())
+	})
+}
+
 func Test_writeSDKOutput(t *testing.T) {
 	diags := diag.Diagnostics{}
 
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const importIDSeparator = "/"
+
+// legacyImportIDSeparator is still accepted for composite identifiers that
+// were documented before the format was standardized.
+const legacyImportIDSeparator = ","
+
+// ImportCompositeID should be used in Import() functions of resources that
+// are identified by more than one attribute. The identifier has the format
+// "a/b/c" and each part is stored in the attribute with the same position.
+func ImportCompositeID(
+	ctx context.Context,
+	attributes []string,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	separator := importIDSeparator
+	if !strings.Contains(request.ID, importIDSeparator) {
+		separator = legacyImportIDSeparator
+	}
+	idParts := strings.Split(request.ID, separator)
+
+	valid := len(idParts) == len(attributes)
+	for _, idPart := range idParts {
+		if idPart == "" {
+			valid = false
+		}
+	}
+	if !valid {
+		UnexpectedImportIdentifierError(
+			&response.Diagnostics,
+			strings.Join(attributes, importIDSeparator),
+			request.ID,
+		)
+		return
+	}
+
+	for i, attribute := range attributes {
+		response.Diagnostics.Append(response.State.SetAttribute(
+			ctx,
+			path.Root(attribute),
+			idParts[i],
+		)...)
+	}
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newImportStateResponse() *resource.ImportStateResponse {
+	importSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"parent_id": schema.StringAttribute{Required: true},
+			"id":        schema.StringAttribute{Required: true},
+		},
+	}
+
+	return &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: importSchema,
+			Raw: tftypes.NewValue(
+				importSchema.Type().TerraformType(context.TODO()),
+				nil,
+			),
+		},
+	}
+}
+
+func TestImportCompositeID(t *testing.T) {
+	attributes := []string{"parent_id", "id"}
+
+	t.Run("stores all parts of the identifier", func(t *testing.T) {
+		response := newImportStateResponse()
+
+		ImportCompositeID(
+			context.TODO(),
+			attributes,
+			resource.ImportStateRequest{ID: "123/456"},
+			response,
+		)
+		require.False(t, response.Diagnostics.HasError())
+
+		var parentID, id string
+		response.State.GetAttribute(context.TODO(), path.Root("parent_id"), &parentID)
+		response.State.GetAttribute(context.TODO(), path.Root("id"), &id)
+
+		assert.Equal(t, "123", parentID)
+		assert.Equal(t, "456", id)
+	})
+
+	t.Run("accepts legacy comma separated identifiers", func(t *testing.T) {
+		response := newImportStateResponse()
+
+		ImportCompositeID(
+			context.TODO(),
+			attributes,
+			resource.ImportStateRequest{ID: "123,456"},
+			response,
+		)
+		require.False(t, response.Diagnostics.HasError())
+
+		var id string
+		response.State.GetAttribute(context.TODO(), path.Root("id"), &id)
+
+		assert.Equal(t, "456", id)
+	})
+
+	t.Run("reports malformed identifiers", func(t *testing.T) {
+		for _, id := range []string{"123", "123/", "/456", "123/456/789", ""} {
+			response := newImportStateResponse()
+
+			ImportCompositeID(
+				context.TODO(),
+				attributes,
+				resource.ImportStateRequest{ID: id},
+				response,
+			)
+
+			require.Len(t, response.Diagnostics.Errors(), 1, id)
+			assert.Equal(
+				t,
+				`Expected import identifier with format: "parent_id/id". Got: "`+id+`"`,
+				response.Diagnostics.Errors()[0].Detail(),
+			)
+		}
+	})
+}
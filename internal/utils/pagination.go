@@ -1,5 +1,14 @@
 package utils
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
 func NewOffset(limit, offset, totalCount int32) *int32 {
 	newOffset := offset + limit
 	if newOffset >= totalCount {
@@ -8,3 +17,136 @@ func NewOffset(limit, offset, totalCount int32) *int32 {
 
 	return &newOffset
 }
+
+// ApplyLimit truncates items to the configured limit and reports whether the
+// limit has been reached, in which case no further pages need to be fetched.
+// A null or unknown limit leaves items untouched.
+func ApplyLimit[T any](items []T, limit types.Int32) ([]T, bool) {
+	if limit.IsNull() || limit.IsUnknown() {
+		return items, false
+	}
+
+	maxItems := int(limit.ValueInt32())
+	if len(items) < maxItems {
+		return items, false
+	}
+
+	return items[:maxItems], true
+}
+
+// Page is a single page of a list returned by the API, along with the
+// pagination metadata of the response.
+type Page[T any] struct {
+	Items      []T
+	Limit      int32
+	Offset     int32
+	TotalCount int32
+}
+
+// FetchPages fetches all pages of a list. The first page reports the total
+// count, after which the remaining pages are fetched with up to concurrency
+// requests in flight. Pages beyond the limit are not fetched and the first
+// error cancels the pages that did not start yet. The order of the items is
+// kept. A page whose offset differs from the requested one is an error, as
+// the list would otherwise be incomplete.
+func FetchPages[T any](
+	ctx context.Context,
+	concurrency int,
+	limit types.Int32,
+	fetch func(ctx context.Context, offset int32) (*Page[T], *http.Response, error),
+) ([]T, *http.Response, error) {
+	first, httpResponse, err := fetch(ctx, 0)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+	if first.Offset != 0 {
+		return nil, nil, offsetMismatchError(0, first.Offset)
+	}
+
+	items, limitReached := ApplyLimit(first.Items, limit)
+	if limitReached || first.Limit <= 0 {
+		return items, nil, nil
+	}
+
+	offsets := remainingOffsets(*first, limit)
+	if len(offsets) == 0 {
+		return items, nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	var firstResponse *http.Response
+	semaphore := make(chan struct{}, max(concurrency, 1))
+	pages := make([][]T, len(offsets))
+
+	for i, offset := range offsets {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, offset int32) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			page, httpResponse, err := fetch(ctx, offset)
+			if err == nil && page.Offset != offset {
+				err = offsetMismatchError(offset, page.Offset)
+				httpResponse = nil
+			}
+			if err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				if firstErr == nil {
+					firstErr = err
+					firstResponse = httpResponse
+					cancel()
+				}
+				return
+			}
+
+			pages[i] = page.Items
+		}(i, offset)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstResponse, firstErr
+	}
+
+	for _, page := range pages {
+		items = append(items, page...)
+	}
+	items, _ = ApplyLimit(items, limit)
+
+	return items, nil, nil
+}
+
+// remainingOffsets returns the offsets of the pages following the first one,
+// up to the total count or the limit, whichever is reached first.
+func remainingOffsets[T any](first Page[T], limit types.Int32) []int32 {
+	totalCount := first.TotalCount
+	if !limit.IsNull() && !limit.IsUnknown() {
+		totalCount = min(totalCount, limit.ValueInt32())
+	}
+
+	var offsets []int32
+	for offset := NewOffset(first.Limit, first.Offset, totalCount); offset != nil; offset = NewOffset(first.Limit, *offset, totalCount) {
+		offsets = append(offsets, *offset)
+	}
+
+	return offsets
+}
+
+func offsetMismatchError(requested, returned int32) error {
+	return fmt.Errorf(
+		"the API returned the page at offset %d instead of %d, so the list cannot be fetched completely",
+		returned,
+		requested,
+	)
+}
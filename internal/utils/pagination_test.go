@@ -1,10 +1,21 @@
 package utils
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leaseweb/leaseweb-go-sdk/dedicatedserver/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewOffset(t *testing.T) {
@@ -49,3 +60,206 @@ func ExampleNewOffset_second() {
 	// Output:
 	// <nil>
 }
+
+func TestApplyLimit(t *testing.T) {
+	t.Run("items are untouched when limit is not set", func(t *testing.T) {
+		got, reached := ApplyLimit([]int{1, 2, 3}, types.Int32Null())
+
+		assert.Equal(t, []int{1, 2, 3}, got)
+		assert.False(t, reached)
+	})
+
+	t.Run("items are untouched when limit is not reached", func(t *testing.T) {
+		got, reached := ApplyLimit([]int{1, 2}, types.Int32Value(3))
+
+		assert.Equal(t, []int{1, 2}, got)
+		assert.False(t, reached)
+	})
+
+	t.Run("limit is reached when items equal limit", func(t *testing.T) {
+		got, reached := ApplyLimit([]int{1, 2, 3}, types.Int32Value(3))
+
+		assert.Equal(t, []int{1, 2, 3}, got)
+		assert.True(t, reached)
+	})
+
+	t.Run("items are truncated when limit is exceeded", func(t *testing.T) {
+		got, reached := ApplyLimit([]int{1, 2, 3}, types.Int32Value(2))
+
+		assert.Equal(t, []int{1, 2}, got)
+		assert.True(t, reached)
+	})
+
+	t.Run("empty items do not reach the limit", func(t *testing.T) {
+		got, reached := ApplyLimit([]int{}, types.Int32Value(1))
+
+		assert.Empty(t, got)
+		assert.False(t, reached)
+	})
+}
+
+// paginatedServer serves totalCount dedicated servers in pages of the
+// requested limit, slowly enough for requests to overlap.
+type paginatedServer struct {
+	totalCount   int
+	failOffset   int
+	ignoreOffset bool
+	requests     atomic.Int32
+	inFlight     atomic.Int32
+	maxInFlight  atomic.Int32
+}
+
+func (p *paginatedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.requests.Add(1)
+	inFlight := p.inFlight.Add(1)
+	defer p.inFlight.Add(-1)
+	for {
+		maxInFlight := p.maxInFlight.Load()
+		if inFlight <= maxInFlight || p.maxInFlight.CompareAndSwap(maxInFlight, inFlight) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	if p.ignoreOffset {
+		offset = 0
+	}
+	if p.failOffset > 0 && offset == p.failOffset {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	servers := []dedicatedserver.Server{}
+	for i := offset; i < min(offset+limit, p.totalCount); i++ {
+		servers = append(servers, dedicatedserver.Server{Id: dedicatedserver.PtrString(strconv.Itoa(i))})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(dedicatedserver.GetServerListResult{
+		Servers: servers,
+		Metadata: &dedicatedserver.Metadata{
+			Limit:      int32(limit),
+			Offset:     int32(offset),
+			TotalCount: int32(p.totalCount),
+		},
+	})
+}
+
+func fetchServerIDs(
+	t *testing.T,
+	mock *paginatedServer,
+	concurrency int,
+	limit types.Int32,
+) ([]string, error) {
+	t.Helper()
+
+	server := httptest.NewServer(mock)
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	cfg := dedicatedserver.NewConfiguration()
+	cfg.Host = serverURL.Host
+	cfg.Scheme = serverURL.Scheme
+	api := dedicatedserver.NewAPIClient(cfg).DedicatedserverAPI
+
+	servers, _, err := FetchPages(
+		context.TODO(),
+		concurrency,
+		limit,
+		func(ctx context.Context, offset int32) (*Page[dedicatedserver.Server], *http.Response, error) {
+			result, httpResponse, err := api.GetServerList(ctx).Limit(5).Offset(offset).Execute()
+			if err != nil {
+				return nil, httpResponse, err
+			}
+
+			metadata := result.GetMetadata()
+			return &Page[dedicatedserver.Server]{
+				Items:      result.GetServers(),
+				Limit:      metadata.GetLimit(),
+				Offset:     metadata.GetOffset(),
+				TotalCount: metadata.GetTotalCount(),
+			}, httpResponse, nil
+		},
+	)
+
+	var ids []string
+	for _, server := range servers {
+		ids = append(ids, server.GetId())
+	}
+
+	return ids, err
+}
+
+func TestFetchPages(t *testing.T) {
+	t.Run("all pages are fetched in order", func(t *testing.T) {
+		mock := &paginatedServer{totalCount: 23}
+
+		got, err := fetchServerIDs(t, mock, 3, types.Int32Null())
+
+		require.NoError(t, err)
+		require.Len(t, got, 23)
+		for i, id := range got {
+			assert.Equal(t, strconv.Itoa(i), id)
+		}
+		assert.Equal(t, int32(5), mock.requests.Load())
+	})
+
+	t.Run("pages are fetched in parallel up to the concurrency", func(t *testing.T) {
+		mock := &paginatedServer{totalCount: 50}
+
+		_, err := fetchServerIDs(t, mock, 3, types.Int32Null())
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), mock.maxInFlight.Load())
+	})
+
+	t.Run("pages are fetched one by one with a concurrency of 1", func(t *testing.T) {
+		mock := &paginatedServer{totalCount: 20}
+
+		_, err := fetchServerIDs(t, mock, 1, types.Int32Null())
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), mock.maxInFlight.Load())
+	})
+
+	t.Run("pages beyond the limit are not fetched", func(t *testing.T) {
+		mock := &paginatedServer{totalCount: 23}
+
+		got, err := fetchServerIDs(t, mock, 3, types.Int32Value(7))
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0", "1", "2", "3", "4", "5", "6"}, got)
+		assert.Equal(t, int32(2), mock.requests.Load())
+	})
+
+	t.Run("a single page is fetched once", func(t *testing.T) {
+		mock := &paginatedServer{totalCount: 3}
+
+		got, err := fetchServerIDs(t, mock, 3, types.Int32Null())
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0", "1", "2"}, got)
+		assert.Equal(t, int32(1), mock.requests.Load())
+	})
+
+	t.Run("a failing page returns an error", func(t *testing.T) {
+		mock := &paginatedServer{totalCount: 23, failOffset: 10}
+
+		got, err := fetchServerIDs(t, mock, 3, types.Int32Null())
+
+		assert.Error(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("an ignored offset returns an error", func(t *testing.T) {
+		mock := &paginatedServer{totalCount: 12, ignoreOffset: true}
+
+		got, err := fetchServerIDs(t, mock, 3, types.Int32Null())
+
+		assert.ErrorContains(t, err, "cannot be fetched completely")
+		assert.Empty(t, got)
+	})
+}
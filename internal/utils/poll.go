@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/client"
+)
+
+// PollUntilDone polls a value with get every interval until done reports that
+// it has reached its final state, or returns the error of a failed one. A nil
+// value means it does not exist yet. Each check is logged with the fields of
+// logFields. Polls that fail with a gateway, network or maintenance error are
+// retried, other errors end the wait. The last value seen is returned along
+// with any error, so callers can keep track of it. The name of the value is
+// used in logs and in the timeout error.
+func PollUntilDone[T any](
+	ctx context.Context,
+	name string,
+	get func(ctx context.Context) (*T, *http.Response, error),
+	done func(value T) (bool, error),
+	logFields func(value T) map[string]any,
+	interval time.Duration,
+	timeout time.Duration,
+) (*T, error) {
+	// Create a constant backoff with the configured retry interval
+	bo := backoff.NewConstantBackOff(interval)
+	deadline := time.Now().Add(timeout)
+
+	var value *T
+	for {
+		current, response, err := get(ctx)
+		if err != nil {
+			switch client.ClassifyResponse(response, err) {
+			case client.ErrorClassTransient, client.ErrorClassMaintenance:
+				tflog.Warn(ctx, fmt.Sprintf("Failed to check the %s, retrying", name), map[string]any{
+					"error": err.Error(),
+				})
+			default:
+				return value, err
+			}
+		} else if current != nil {
+			value = current
+			tflog.Info(ctx, fmt.Sprintf("Checked the %s", name), logFields(*value))
+
+			finished, err := done(*value)
+			if err != nil {
+				return value, err
+			}
+			if finished {
+				return value, nil
+			}
+		}
+
+		wait := bo.NextBackOff()
+		if time.Now().Add(wait).After(deadline) {
+			return value, fmt.Errorf("timed out waiting for the %s after %s", name, timeout)
+		}
+
+		// Sleep for the backoff interval before retrying
+		time.Sleep(wait)
+	}
+}
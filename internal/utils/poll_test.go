@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testJob struct {
+	status string
+}
+
+func pollTestJob(
+	get func(ctx context.Context) (*testJob, *http.Response, error),
+	timeout time.Duration,
+) (*testJob, error) {
+	return PollUntilDone(
+		context.TODO(),
+		"job",
+		get,
+		func(job testJob) (bool, error) {
+			switch job.status {
+			case "FINISHED":
+				return true, nil
+			case "FAILED":
+				return false, errors.New("job has failed")
+			}
+			return false, nil
+		},
+		func(job testJob) map[string]any {
+			return map[string]any{"status": job.status}
+		},
+		time.Millisecond,
+		timeout,
+	)
+}
+
+func TestPollUntilDone(t *testing.T) {
+	t.Run("waits for the value to exist and be done", func(t *testing.T) {
+		polls := 0
+		get := func(_ context.Context) (*testJob, *http.Response, error) {
+			polls++
+			switch {
+			case polls < 3:
+				return nil, nil, nil
+			case polls < 5:
+				return &testJob{status: "ACTIVE"}, nil, nil
+			}
+			return &testJob{status: "FINISHED"}, nil, nil
+		}
+
+		job, err := pollTestJob(get, time.Minute)
+
+		require.NoError(t, err)
+		assert.Equal(t, "FINISHED", job.status)
+		assert.Equal(t, 5, polls)
+	})
+
+	t.Run("returns the value and the error of a failed value", func(t *testing.T) {
+		get := func(_ context.Context) (*testJob, *http.Response, error) {
+			return &testJob{status: "FAILED"}, nil, nil
+		}
+
+		job, err := pollTestJob(get, time.Minute)
+
+		assert.EqualError(t, err, "job has failed")
+		assert.Equal(t, "FAILED", job.status)
+	})
+
+	t.Run("returns the last value seen on a timeout", func(t *testing.T) {
+		get := func(_ context.Context) (*testJob, *http.Response, error) {
+			return &testJob{status: "ACTIVE"}, nil, nil
+		}
+
+		job, err := pollTestJob(get, 10*time.Millisecond)
+
+		assert.EqualError(t, err, "timed out waiting for the job after 10ms")
+		assert.Equal(t, "ACTIVE", job.status)
+	})
+
+	t.Run("retries gateway errors", func(t *testing.T) {
+		polls := 0
+		get := func(_ context.Context) (*testJob, *http.Response, error) {
+			polls++
+			if polls == 1 {
+				return nil, &http.Response{StatusCode: http.StatusBadGateway}, errors.New("bad gateway")
+			}
+			return &testJob{status: "FINISHED"}, nil, nil
+		}
+
+		job, err := pollTestJob(get, time.Minute)
+
+		require.NoError(t, err)
+		assert.Equal(t, "FINISHED", job.status)
+		assert.Equal(t, 2, polls)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		polls := 0
+		get := func(_ context.Context) (*testJob, *http.Response, error) {
+			polls++
+			return nil, &http.Response{StatusCode: http.StatusNotFound}, errors.New("not found")
+		}
+
+		_, err := pollTestJob(get, time.Minute)
+
+		assert.ErrorContains(t, err, "not found")
+		assert.Equal(t, 1, polls)
+	})
+}
@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// NewStateUpgrader returns an upgrader for state written with priorSchema.
+// The prior state is read into a Prior model, and whatever upgrade returns
+// is stored as the state of the current schema. Models of the current
+// schema must set attributes added since to null, as they are when left
+// out of the configuration, so that the upgrade does not cause a diff.
+func NewStateUpgrader[Prior any, Current any](
+	priorSchema *schema.Schema,
+	upgrade func(prior Prior) Current,
+) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		PriorSchema: priorSchema,
+		StateUpgrader: func(
+			ctx context.Context,
+			request resource.UpgradeStateRequest,
+			response *resource.UpgradeStateResponse,
+		) {
+			var prior Prior
+			response.Diagnostics.Append(request.State.Get(ctx, &prior)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			response.Diagnostics.Append(response.State.Set(ctx, upgrade(prior))...)
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type priorModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type currentModel struct {
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func TestNewStateUpgrader(t *testing.T) {
+	ctx := context.TODO()
+	priorSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+	currentSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"display_name": schema.StringAttribute{Required: true},
+			"description":  schema.StringAttribute{Optional: true},
+		},
+	}
+
+	upgrader := NewStateUpgrader(
+		&priorSchema,
+		func(prior priorModel) currentModel {
+			return currentModel{
+				DisplayName: prior.Name,
+				Description: types.StringNull(),
+			}
+		},
+	)
+	assert.Equal(t, &priorSchema, upgrader.PriorSchema)
+
+	request := resource.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Schema: priorSchema,
+			Raw: tftypes.NewValue(
+				priorSchema.Type().TerraformType(ctx),
+				map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "web"),
+				},
+			),
+		},
+	}
+	response := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: currentSchema,
+			Raw:    tftypes.NewValue(currentSchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, request, &response)
+	require.False(t, response.Diagnostics.HasError(), response.Diagnostics)
+
+	var got currentModel
+	require.False(t, response.State.Get(ctx, &got).HasError())
+	assert.Equal(t, "web", got.DisplayName.ValueString())
+	assert.True(t, got.Description.IsNull())
+}
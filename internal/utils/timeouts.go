@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NewTimeoutsNull returns the value of a `timeouts` block with create, update
+// and delete timeouts that is not set.
+func NewTimeoutsNull() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+// WithTimeout bounds ctx by timeout, a timeout of 0 leaves it unbounded.
+// Requests sent with ctx are aborted once timeout is reached, or earlier by
+// the timeout of the provider.
+func WithTimeout(
+	ctx context.Context,
+	timeout time.Duration,
+) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
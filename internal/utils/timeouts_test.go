@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/leaseweb/terraform-provider-leaseweb/internal/provider/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimeoutsNull(t *testing.T) {
+	got, diags := NewTimeoutsNull().Delete(context.TODO(), time.Minute)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, time.Minute, got)
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("context gets a deadline", func(t *testing.T) {
+		ctx, cancel := WithTimeout(context.TODO(), time.Minute)
+		defer cancel()
+
+		_, ok := ctx.Deadline()
+		assert.True(t, ok)
+	})
+
+	t.Run("a shorter provider timeout still aborts requests", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		defer server.Close()
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		maxRetries := 0
+		coreClient := client.NewClient(
+			"token",
+			client.Optional{
+				Host:       &serverURL.Host,
+				Scheme:     &serverURL.Scheme,
+				MaxRetries: &maxRetries,
+				Timeout:    10 * time.Millisecond,
+			},
+			"test",
+		)
+
+		ctx, cancel := WithTimeout(context.TODO(), time.Minute)
+		defer cancel()
+		start := time.Now()
+		_, _, err = coreClient.PubliccloudAPI.GetRegionList(ctx).Execute()
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		assert.NoError(t, ctx.Err(), "the operation itself has not timed out")
+	})
+
+	t.Run("requests are aborted once the timeout is reached", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}))
+		defer server.Close()
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		maxRetries := 0
+		coreClient := client.NewClient(
+			"token",
+			client.Optional{
+				Host:       &serverURL.Host,
+				Scheme:     &serverURL.Scheme,
+				MaxRetries: &maxRetries,
+			},
+			"test",
+		)
+
+		ctx, cancel := WithTimeout(context.TODO(), 10*time.Millisecond)
+		defer cancel()
+		_, _, err = coreClient.PubliccloudAPI.GetRegionList(ctx).Execute()
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("context is left unbounded without a timeout", func(t *testing.T) {
+		ctx, cancel := WithTimeout(context.TODO(), 0)
+		defer cancel()
+
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// durationValidator ensures that the given value is a positive duration string.
+type durationValidator struct{}
+
+func (v durationValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	parsed, err := time.ParseDuration(request.ConfigValue.ValueString())
+	if err != nil || parsed <= 0 {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The value must be a positive duration such as \"10m\", but got %s.", request.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = durationValidator{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "Ensures that the value is a positive duration"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// DurationValidator returns a validator for positive duration strings such
+// as "10m", as parsed by time.ParseDuration.
+func DurationValidator() validator.String {
+	return durationValidator{}
+}
+
+// timestampValidator ensures that the given value is an RFC 3339 timestamp.
+type timestampValidator struct{}
+
+func (v timestampValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("The value must be an RFC 3339 timestamp such as \"2024-01-01T00:00:00Z\", but got %s.", request.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = timestampValidator{}
+
+func (v timestampValidator) Description(_ context.Context) string {
+	return "Ensures that the value is an RFC 3339 timestamp"
+}
+
+func (v timestampValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// TimestampValidator returns a validator for RFC 3339 timestamps.
+func TimestampValidator() validator.String {
+	return timestampValidator{}
+}
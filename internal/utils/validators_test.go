@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationValidator(t *testing.T) {
+	t.Run("does not set errors for a positive duration", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("10m"),
+		}
+		response := validator.StringResponse{}
+
+		DurationValidator().ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("does not set errors for an unknown value", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringUnknown(),
+		}
+		response := validator.StringResponse{}
+
+		DurationValidator().ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("sets errors for a value without unit", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("10"),
+		}
+		response := validator.StringResponse{}
+
+		DurationValidator().ValidateString(context.TODO(), request, &response)
+
+		assert.Len(t, response.Diagnostics.Errors(), 1)
+		assert.Contains(
+			t,
+			response.Diagnostics.Errors()[0].Detail(),
+			"The value must be a positive duration such as \"10m\", but got 10.",
+		)
+	})
+
+	t.Run("sets errors for a negative duration", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("-5m"),
+		}
+		response := validator.StringResponse{}
+
+		DurationValidator().ValidateString(context.TODO(), request, &response)
+
+		assert.Len(t, response.Diagnostics.Errors(), 1)
+	})
+
+	t.Run("sets errors for a zero duration", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("0s"),
+		}
+		response := validator.StringResponse{}
+
+		DurationValidator().ValidateString(context.TODO(), request, &response)
+
+		assert.Len(t, response.Diagnostics.Errors(), 1)
+	})
+}
+
+func TestTimestampValidator(t *testing.T) {
+	t.Run("does not set errors for an RFC 3339 timestamp", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("2024-01-01T00:00:00Z"),
+		}
+		response := validator.StringResponse{}
+
+		TimestampValidator().ValidateString(context.TODO(), request, &response)
+
+		assert.Empty(t, response.Diagnostics.Errors())
+	})
+
+	t.Run("sets errors for a date without time", func(t *testing.T) {
+		request := validator.StringRequest{
+			ConfigValue: basetypes.NewStringValue("2024-01-01"),
+		}
+		response := validator.StringResponse{}
+
+		TimestampValidator().ValidateString(context.TODO(), request, &response)
+
+		assert.Len(t, response.Diagnostics.Errors(), 1)
+	})
+}